@@ -0,0 +1,107 @@
+package gophercloud
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultIdentityFailoverCooldown is how long an unreachable identity endpoint
+// is tried last, when IdentityFailover.Cooldown is not set.
+const DefaultIdentityFailoverCooldown = 30 * time.Second
+
+// IdentityFailover is an ordered list of identity endpoints, for example the
+// Keystone replicas of each region, used to fail over authentication and
+// catalog discovery when an endpoint is unreachable.
+//
+// Endpoints are sticky: once an endpoint has been used successfully, it is
+// tried first until it becomes unreachable. An unreachable endpoint is then
+// tried last until its cooldown expires.
+//
+// An IdentityFailover is safe for concurrent use.
+type IdentityFailover struct {
+	// Cooldown is how long an unreachable endpoint is tried last. Defaults to
+	// DefaultIdentityFailoverCooldown.
+	Cooldown time.Duration
+
+	mut       sync.Mutex
+	endpoints []string
+	current   string
+	failedAt  map[string]time.Time
+}
+
+// NewIdentityFailover returns an IdentityFailover for the given identity
+// endpoints, in order of preference.
+func NewIdentityFailover(endpoints ...string) *IdentityFailover {
+	f := &IdentityFailover{
+		failedAt: make(map[string]time.Time),
+	}
+	for _, endpoint := range endpoints {
+		f.endpoints = append(f.endpoints, NormalizeURL(endpoint))
+	}
+	return f
+}
+
+// Endpoints returns the identity endpoints in the order in which they should
+// be tried: the current endpoint first if it is healthy, then the healthy
+// endpoints in order of preference, then the endpoints which were recently
+// unreachable, least recently failed first.
+func (f *IdentityFailover) Endpoints() []string {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+
+	cooldown := f.Cooldown
+	if cooldown == 0 {
+		cooldown = DefaultIdentityFailoverCooldown
+	}
+
+	var healthy, unhealthy []string
+	for _, endpoint := range f.endpoints {
+		if failedAt, ok := f.failedAt[endpoint]; ok && time.Since(failedAt) < cooldown {
+			unhealthy = append(unhealthy, endpoint)
+			continue
+		}
+		if endpoint == f.current {
+			healthy = append([]string{endpoint}, healthy...)
+			continue
+		}
+		healthy = append(healthy, endpoint)
+	}
+
+	sort.SliceStable(unhealthy, func(i, j int) bool {
+		return f.failedAt[unhealthy[i]].Before(f.failedAt[unhealthy[j]])
+	})
+
+	return append(healthy, unhealthy...)
+}
+
+// Current returns the endpoint which was last used successfully, or an empty
+// string if no endpoint has been used yet.
+func (f *IdentityFailover) Current() string {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	return f.current
+}
+
+// MarkHealthy records that the endpoint was used successfully. It becomes the
+// current endpoint.
+func (f *IdentityFailover) MarkHealthy(endpoint string) {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+
+	endpoint = NormalizeURL(endpoint)
+	delete(f.failedAt, endpoint)
+	f.current = endpoint
+}
+
+// MarkUnhealthy records that the endpoint was unreachable.
+func (f *IdentityFailover) MarkUnhealthy(endpoint string) {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+
+	endpoint = NormalizeURL(endpoint)
+	f.failedAt[endpoint] = time.Now()
+	if f.current == endpoint {
+		f.current = ""
+	}
+}
@@ -121,7 +121,7 @@ func DeployFakeNode(t *testing.T, client *gophercloud.ServiceClient, node *nodes
 
 	currentState := node.ProvisionState
 
-	if currentState == string(nodes.Enroll) {
+	if currentState == nodes.Enroll {
 		t.Logf("moving fake node %s to manageable", node.UUID)
 		err := nodes.ChangeProvisionState(ctx, client, node.UUID, nodes.ProvisionStateOpts{
 			Target: nodes.TargetManage,
@@ -135,10 +135,10 @@ func DeployFakeNode(t *testing.T, client *gophercloud.ServiceClient, node *nodes
 			return node, err
 		}
 
-		currentState = string(nodes.Manageable)
+		currentState = nodes.Manageable
 	}
 
-	if currentState == string(nodes.Manageable) {
+	if currentState == nodes.Manageable {
 		t.Logf("moving fake node %s to available", node.UUID)
 		err := nodes.ChangeProvisionState(ctx, client, node.UUID, nodes.ProvisionStateOpts{
 			Target: nodes.TargetProvide,
@@ -152,7 +152,7 @@ func DeployFakeNode(t *testing.T, client *gophercloud.ServiceClient, node *nodes
 			return node, err
 		}
 
-		currentState = string(nodes.Available)
+		currentState = nodes.Available
 	}
 
 	t.Logf("deploying fake node %s", node.UUID)
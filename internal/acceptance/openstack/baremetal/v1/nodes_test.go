@@ -44,7 +44,7 @@ func TestNodesCreateDestroy(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.AssertEquals(t, found, true)
 
-	th.AssertEquals(t, node.ProvisionState, string(nodes.Enroll))
+	th.AssertEquals(t, node.ProvisionState, nodes.Enroll)
 
 	err = nodes.ChangeProvisionState(context.TODO(), client, node.UUID, nodes.ProvisionStateOpts{
 		Target: nodes.TargetManage,
@@ -44,10 +44,12 @@ func TestBGPSpeakerCRUD(t *testing.T) {
 	defer networking.DeleteNetwork(t, client, network.ID)
 
 	// Update BGP Speaker
+	iFalse := false
+	iTrue := true
 	opts := speakers.UpdateOpts{
 		Name:                          tools.RandomString("TESTACC-BGPSPEAKER-", 10),
-		AdvertiseTenantNetworks:       false,
-		AdvertiseFloatingIPHostRoutes: true,
+		AdvertiseTenantNetworks:       &iFalse,
+		AdvertiseFloatingIPHostRoutes: &iTrue,
 	}
 	speakerUpdated, err := speakers.Update(context.TODO(), client, bgpSpeaker.ID, opts).Extract()
 	th.AssertNoErr(t, err)
@@ -0,0 +1,71 @@
+/*
+Package alarms provides the ability to list, get, create and delete alarms
+through the OpenStack alarming (Aodh) service.
+
+Composite alarms evaluate a tree of Gnocchi threshold rules combined with
+And and Or. The tree is validated when building the request, so a missing
+metric or an unknown comparison operator is reported before calling the API.
+
+Example to List Alarms
+
+	allPages, err := alarms.List(alarmingClient, nil).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allAlarms, err := alarms.ExtractAlarms(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, alarm := range allAlarms {
+		fmt.Printf("%+v\n", alarm)
+	}
+
+Example to Create a Composite Alarm
+
+	cpuHigh := alarms.ThresholdRule{
+		Type:               alarms.TypeGnocchiResourcesThreshold,
+		Metric:             "cpu",
+		ResourceID:         "2a4d1a83-2f3a-4b4c-9f2b-3a6ff2a8e5f1",
+		ResourceType:       "instance",
+		AggregationMethod:  "rate:mean",
+		Granularity:        300,
+		Threshold:          8e9,
+		ComparisonOperator: alarms.OperatorGreaterThan,
+	}
+
+	memoryHigh := alarms.ThresholdRule{
+		Type:               alarms.TypeGnocchiResourcesThreshold,
+		Metric:             "memory.usage",
+		ResourceID:         "2a4d1a83-2f3a-4b4c-9f2b-3a6ff2a8e5f1",
+		ResourceType:       "instance",
+		AggregationMethod:  "mean",
+		Granularity:        300,
+		Threshold:          3800,
+		ComparisonOperator: alarms.OperatorGreaterThan,
+	}
+
+	createOpts := alarms.CreateOpts{
+		Name:         "instance_overloaded",
+		AlarmActions: []string{"http://example.com/scale_up"},
+		Rule: alarms.Or{
+			cpuHigh,
+			alarms.And{memoryHigh, cpuHigh},
+		},
+	}
+
+	alarm, err := alarms.Create(context.TODO(), alarmingClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Alarm
+
+	alarmID := "0f3d9cd4-c4c5-4b52-8e6a-3e9a1b5d0a7b"
+	err := alarms.Delete(context.TODO(), alarmingClient, alarmID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package alarms
@@ -0,0 +1,328 @@
+package alarms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// AlarmType is the type of an alarm.
+type AlarmType string
+
+const (
+	// TypeComposite is the type of alarms combining several threshold rules
+	// with "and" and "or" operators.
+	TypeComposite AlarmType = "composite"
+
+	// TypeGnocchiResourcesThreshold is the type of alarms evaluating a metric
+	// of a single Gnocchi resource.
+	TypeGnocchiResourcesThreshold AlarmType = "gnocchi_resources_threshold"
+
+	// TypeGnocchiAggregationByMetricsThreshold is the type of alarms
+	// evaluating an aggregation of several Gnocchi metrics.
+	TypeGnocchiAggregationByMetricsThreshold AlarmType = "gnocchi_aggregation_by_metrics_threshold"
+
+	// TypeGnocchiAggregationByResourcesThreshold is the type of alarms
+	// evaluating a metric aggregated across the Gnocchi resources matching a
+	// query.
+	TypeGnocchiAggregationByResourcesThreshold AlarmType = "gnocchi_aggregation_by_resources_threshold"
+)
+
+// ComparisonOperator is the operator comparing the aggregated metric
+// value to the threshold of a rule.
+type ComparisonOperator string
+
+const (
+	OperatorLessThan       ComparisonOperator = "lt"
+	OperatorLessOrEqual    ComparisonOperator = "le"
+	OperatorEqual          ComparisonOperator = "eq"
+	OperatorNotEqual       ComparisonOperator = "ne"
+	OperatorGreaterOrEqual ComparisonOperator = "ge"
+	OperatorGreaterThan    ComparisonOperator = "gt"
+)
+
+// Rule is a node of a composite alarm rule tree. It is implemented by
+// ThresholdRule, And and Or.
+type Rule interface {
+	ToAlarmRuleMap() (map[string]any, error)
+}
+
+// ThresholdRule is a leaf of a composite alarm rule tree, evaluating Gnocchi
+// measures against a threshold.
+type ThresholdRule struct {
+	// Type is the type of the rule. It must be one of the Gnocchi threshold
+	// alarm types.
+	Type AlarmType `json:"type" required:"true"`
+
+	// Metric is the name of the evaluated metric. It is required by the
+	// gnocchi_resources_threshold and
+	// gnocchi_aggregation_by_resources_threshold types.
+	Metric string `json:"metric,omitempty"`
+
+	// Metrics are the IDs of the aggregated metrics. They are required by the
+	// gnocchi_aggregation_by_metrics_threshold type.
+	Metrics []string `json:"metrics,omitempty"`
+
+	// ResourceID is the ID of the evaluated resource. It is required by the
+	// gnocchi_resources_threshold type.
+	ResourceID string `json:"resource_id,omitempty"`
+
+	// ResourceType is the Gnocchi type of the evaluated resources. It is
+	// required by the gnocchi_resources_threshold and
+	// gnocchi_aggregation_by_resources_threshold types.
+	ResourceType string `json:"resource_type,omitempty"`
+
+	// Query is the Gnocchi search query selecting the aggregated resources.
+	// It is required by the gnocchi_aggregation_by_resources_threshold type.
+	Query string `json:"query,omitempty"`
+
+	// AggregationMethod is the Gnocchi aggregation method, e.g. "mean".
+	AggregationMethod string `json:"aggregation_method" required:"true"`
+
+	// Granularity is the time range in seconds of the evaluated measures.
+	Granularity int `json:"granularity,omitempty"`
+
+	// EvaluationPeriods is the number of periods to evaluate over.
+	EvaluationPeriods int `json:"evaluation_periods,omitempty"`
+
+	// Threshold is the value the aggregated measures are compared to.
+	Threshold float64 `json:"threshold"`
+
+	// ComparisonOperator is the operator comparing the measures to the
+	// Threshold. It defaults to "eq".
+	ComparisonOperator ComparisonOperator `json:"comparison_operator,omitempty"`
+}
+
+// ToAlarmRuleMap validates a ThresholdRule against the fields required by
+// its type and builds its request body.
+func (r ThresholdRule) ToAlarmRuleMap() (map[string]any, error) {
+	b, err := gophercloud.BuildRequestBody(r, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var missing string
+	switch r.Type {
+	case TypeGnocchiResourcesThreshold:
+		switch {
+		case r.Metric == "":
+			missing = "Metric"
+		case r.ResourceID == "":
+			missing = "ResourceID"
+		case r.ResourceType == "":
+			missing = "ResourceType"
+		}
+	case TypeGnocchiAggregationByMetricsThreshold:
+		if len(r.Metrics) == 0 {
+			missing = "Metrics"
+		}
+	case TypeGnocchiAggregationByResourcesThreshold:
+		switch {
+		case r.Metric == "":
+			missing = "Metric"
+		case r.ResourceType == "":
+			missing = "ResourceType"
+		case r.Query == "":
+			missing = "Query"
+		}
+	default:
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "alarms.ThresholdRule.Type"
+		err.Value = r.Type
+		err.Info = "not a Gnocchi threshold alarm type"
+		return nil, err
+	}
+	if missing != "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "alarms.ThresholdRule." + missing}
+	}
+
+	switch r.ComparisonOperator {
+	case "", OperatorLessThan, OperatorLessOrEqual, OperatorEqual,
+		OperatorNotEqual, OperatorGreaterOrEqual, OperatorGreaterThan:
+	default:
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "alarms.ThresholdRule.ComparisonOperator"
+		err.Value = r.ComparisonOperator
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// And is a composite alarm rule matching when all of its rules match.
+type And []Rule
+
+// ToAlarmRuleMap builds the request body of an And rule and of its nested
+// rules.
+func (r And) ToAlarmRuleMap() (map[string]any, error) {
+	return buildOperator("and", r)
+}
+
+// Or is a composite alarm rule matching when any of its rules matches.
+type Or []Rule
+
+// ToAlarmRuleMap builds the request body of an Or rule and of its nested
+// rules.
+func (r Or) ToAlarmRuleMap() (map[string]any, error) {
+	return buildOperator("or", r)
+}
+
+func buildOperator(operator string, rules []Rule) (map[string]any, error) {
+	if len(rules) == 0 {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "alarms." + operator
+		err.Info = "at least one rule is required"
+		return nil, err
+	}
+
+	operands := make([]map[string]any, 0, len(rules))
+	for i, rule := range rules {
+		if rule == nil {
+			return nil, gophercloud.ErrMissingInput{Argument: fmt.Sprintf("alarms.%s[%d]", operator, i)}
+		}
+
+		b, err := rule.ToAlarmRuleMap()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, b)
+	}
+
+	return map[string]any{operator: operands}, nil
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToAlarmListQuery() (string, error)
+}
+
+// ListOpts allows to sort and paginate the alarms returned by List.
+type ListOpts struct {
+	// Limit is the maximum number of alarms to return.
+	Limit int `q:"limit"`
+
+	// Marker is the ID of the last alarm of the previous page.
+	Marker string `q:"marker"`
+
+	// Sort is a list of "key:direction" pairs to sort the alarms by.
+	Sort []string `q:"sort"`
+}
+
+// ToAlarmListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToAlarmListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// alarms.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToAlarmListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return AlarmPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get retrieves a specific alarm based on its unique ID.
+func Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := client.Get(ctx, getURL(client, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToAlarmCreateMap() (map[string]any, error)
+}
+
+// CreateOpts specifies the parameters of a new alarm.
+type CreateOpts struct {
+	// Name is the name of the alarm. It must be unique in the project.
+	Name string `json:"name" required:"true"`
+
+	// Description is the description of the alarm.
+	Description string `json:"description,omitempty"`
+
+	// Enabled sets whether the alarm is evaluated.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Severity is the severity of the alarm: "low", "moderate" or
+	// "critical".
+	Severity string `json:"severity,omitempty"`
+
+	// AlarmActions are the URLs notified when the alarm goes to the alarm
+	// state.
+	AlarmActions []string `json:"alarm_actions,omitempty"`
+
+	// OKActions are the URLs notified when the alarm goes to the ok state.
+	OKActions []string `json:"ok_actions,omitempty"`
+
+	// InsufficientDataActions are the URLs notified when the alarm goes to
+	// the insufficient data state.
+	InsufficientDataActions []string `json:"insufficient_data_actions,omitempty"`
+
+	// RepeatActions sets whether the actions are repeated at each evaluation
+	// while the alarm stays in the same state.
+	RepeatActions *bool `json:"repeat_actions,omitempty"`
+
+	// Rule is the rule of the alarm. A ThresholdRule creates an alarm of the
+	// rule type, while And and Or create a composite alarm.
+	Rule Rule `json:"-" required:"true"`
+}
+
+// ToAlarmCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToAlarmCreateMap() (map[string]any, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := opts.Rule.ToAlarmRuleMap()
+	if err != nil {
+		return nil, err
+	}
+
+	switch r := opts.Rule.(type) {
+	case ThresholdRule:
+		delete(rule, "type")
+		b["type"] = r.Type
+		b[string(r.Type)+"_rule"] = rule
+	default:
+		b["type"] = TypeComposite
+		b["composite_rule"] = rule
+	}
+
+	return b, nil
+}
+
+// Create requests the creation of a new alarm.
+func Create(ctx context.Context, client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToAlarmCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete deletes the specified alarm.
+func Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := client.Delete(ctx, deleteURL(client, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
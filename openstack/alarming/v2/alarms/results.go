@@ -0,0 +1,139 @@
+package alarms
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as an Alarm.
+func (r commonResult) Extract() (*Alarm, error) {
+	var s Alarm
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// GetResult is the response of a Get operation. Call its Extract method to
+// interpret it as an Alarm.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult is the response of a Create operation. Call its Extract
+// method to interpret it as an Alarm.
+type CreateResult struct {
+	commonResult
+}
+
+// DeleteResult is the response of a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// Alarm represents an alarm of the OpenStack alarming service.
+type Alarm struct {
+	// AlarmID is the unique ID of the alarm.
+	AlarmID string `json:"alarm_id"`
+
+	// Name is the name of the alarm.
+	Name string `json:"name"`
+
+	// Description is the description of the alarm.
+	Description string `json:"description"`
+
+	// Type is the type of the alarm.
+	Type string `json:"type"`
+
+	// Enabled indicates whether the alarm is evaluated.
+	Enabled bool `json:"enabled"`
+
+	// State is the state of the alarm: "ok", "alarm" or
+	// "insufficient data".
+	State string `json:"state"`
+
+	// StateReason explains the current state of the alarm.
+	StateReason string `json:"state_reason"`
+
+	// Severity is the severity of the alarm.
+	Severity string `json:"severity"`
+
+	// AlarmActions are the URLs notified when the alarm goes to the alarm
+	// state.
+	AlarmActions []string `json:"alarm_actions"`
+
+	// OKActions are the URLs notified when the alarm goes to the ok state.
+	OKActions []string `json:"ok_actions"`
+
+	// InsufficientDataActions are the URLs notified when the alarm goes to
+	// the insufficient data state.
+	InsufficientDataActions []string `json:"insufficient_data_actions"`
+
+	// RepeatActions indicates whether the actions are repeated at each
+	// evaluation.
+	RepeatActions bool `json:"repeat_actions"`
+
+	// CompositeRule is the rule tree of a composite alarm.
+	CompositeRule map[string]any `json:"composite_rule"`
+
+	// ProjectID is the ID of the project owning the alarm.
+	ProjectID string `json:"project_id"`
+
+	// UserID is the ID of the user who created the alarm.
+	UserID string `json:"user_id"`
+
+	// Timestamp is the date of the last update of the alarm.
+	Timestamp time.Time `json:"-"`
+
+	// StateTimestamp is the date of the last state change of the alarm.
+	StateTimestamp time.Time `json:"-"`
+}
+
+// UnmarshalJSON helps to unmarshal Alarm fields into needed values.
+func (r *Alarm) UnmarshalJSON(b []byte) error {
+	type tmp Alarm
+	var s struct {
+		tmp
+		Timestamp      gophercloud.JSONRFC3339MilliNoZ `json:"timestamp"`
+		StateTimestamp gophercloud.JSONRFC3339MilliNoZ `json:"state_timestamp"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Alarm(s.tmp)
+
+	r.Timestamp = time.Time(s.Timestamp)
+	r.StateTimestamp = time.Time(s.StateTimestamp)
+
+	return nil
+}
+
+// AlarmPage contains a single page of all alarms from a List call.
+type AlarmPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines if an AlarmPage contains any results.
+func (r AlarmPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	alarms, err := ExtractAlarms(r)
+	return len(alarms) == 0, err
+}
+
+// ExtractAlarms returns a slice of Alarms contained in a single page of
+// results.
+func ExtractAlarms(r pagination.Page) ([]Alarm, error) {
+	var s []Alarm
+	err := (r.(AlarmPage)).ExtractIntoSlicePtr(&s, "")
+	return s, err
+}
@@ -0,0 +1,219 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/alarming/v2/alarms"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+// CompositeAlarmBody is a composite alarm as returned by the API.
+const CompositeAlarmBody = `
+{
+    "alarm_id": "0f3d9cd4-c4c5-4b52-8e6a-3e9a1b5d0a7b",
+    "name": "instance_overloaded",
+    "description": "",
+    "type": "composite",
+    "enabled": true,
+    "state": "insufficient data",
+    "state_reason": "Not evaluated yet",
+    "severity": "low",
+    "alarm_actions": ["http://example.com/scale_up"],
+    "ok_actions": [],
+    "insufficient_data_actions": [],
+    "repeat_actions": false,
+    "composite_rule": {
+        "or": [
+            {
+                "type": "gnocchi_resources_threshold",
+                "metric": "cpu",
+                "resource_id": "2a4d1a83-2f3a-4b4c-9f2b-3a6ff2a8e5f1",
+                "resource_type": "instance",
+                "aggregation_method": "rate:mean",
+                "granularity": 300,
+                "threshold": 8000000000,
+                "comparison_operator": "gt"
+            },
+            {
+                "and": [
+                    {
+                        "type": "gnocchi_resources_threshold",
+                        "metric": "memory.usage",
+                        "resource_id": "2a4d1a83-2f3a-4b4c-9f2b-3a6ff2a8e5f1",
+                        "resource_type": "instance",
+                        "aggregation_method": "mean",
+                        "granularity": 300,
+                        "threshold": 3800,
+                        "comparison_operator": "gt"
+                    },
+                    {
+                        "type": "gnocchi_aggregation_by_metrics_threshold",
+                        "metrics": ["5b7a6e41-5e4b-4b35-9a69-0b1c0f3a9c1d"],
+                        "aggregation_method": "mean",
+                        "threshold": 100
+                    }
+                ]
+            }
+        ]
+    },
+    "project_id": "9d5a3b4a1c8e4f0fa2b6c1d7e8f90a1b",
+    "user_id": "6c0e3b3c1e514a1e9c8f6e2a7b5d4c3b",
+    "timestamp": "2024-03-01T10:00:00.123456",
+    "state_timestamp": "2024-03-01T10:00:00.123456"
+}
+`
+
+// CompositeAlarmCreateRequest is the request body expected when creating
+// CompositeAlarm.
+const CompositeAlarmCreateRequest = `
+{
+    "name": "instance_overloaded",
+    "alarm_actions": ["http://example.com/scale_up"],
+    "type": "composite",
+    "composite_rule": {
+        "or": [
+            {
+                "type": "gnocchi_resources_threshold",
+                "metric": "cpu",
+                "resource_id": "2a4d1a83-2f3a-4b4c-9f2b-3a6ff2a8e5f1",
+                "resource_type": "instance",
+                "aggregation_method": "rate:mean",
+                "granularity": 300,
+                "threshold": 8000000000,
+                "comparison_operator": "gt"
+            },
+            {
+                "and": [
+                    {
+                        "type": "gnocchi_resources_threshold",
+                        "metric": "memory.usage",
+                        "resource_id": "2a4d1a83-2f3a-4b4c-9f2b-3a6ff2a8e5f1",
+                        "resource_type": "instance",
+                        "aggregation_method": "mean",
+                        "granularity": 300,
+                        "threshold": 3800,
+                        "comparison_operator": "gt"
+                    },
+                    {
+                        "type": "gnocchi_aggregation_by_metrics_threshold",
+                        "metrics": ["5b7a6e41-5e4b-4b35-9a69-0b1c0f3a9c1d"],
+                        "aggregation_method": "mean",
+                        "threshold": 100
+                    }
+                ]
+            }
+        ]
+    }
+}
+`
+
+// ThresholdAlarmCreateRequest is the request body expected when creating an
+// alarm with a single threshold rule.
+const ThresholdAlarmCreateRequest = `
+{
+    "name": "cpu_high",
+    "type": "gnocchi_resources_threshold",
+    "gnocchi_resources_threshold_rule": {
+        "metric": "cpu",
+        "resource_id": "2a4d1a83-2f3a-4b4c-9f2b-3a6ff2a8e5f1",
+        "resource_type": "instance",
+        "aggregation_method": "rate:mean",
+        "granularity": 300,
+        "threshold": 8000000000,
+        "comparison_operator": "gt"
+    }
+}
+`
+
+var (
+	// CPUHighRule is the CPU threshold rule of CompositeAlarm.
+	CPUHighRule = alarms.ThresholdRule{
+		Type:               alarms.TypeGnocchiResourcesThreshold,
+		Metric:             "cpu",
+		ResourceID:         "2a4d1a83-2f3a-4b4c-9f2b-3a6ff2a8e5f1",
+		ResourceType:       "instance",
+		AggregationMethod:  "rate:mean",
+		Granularity:        300,
+		Threshold:          8e9,
+		ComparisonOperator: alarms.OperatorGreaterThan,
+	}
+
+	// CompositeRule is the rule tree of CompositeAlarm.
+	CompositeRule = alarms.Or{
+		CPUHighRule,
+		alarms.And{
+			alarms.ThresholdRule{
+				Type:               alarms.TypeGnocchiResourcesThreshold,
+				Metric:             "memory.usage",
+				ResourceID:         "2a4d1a83-2f3a-4b4c-9f2b-3a6ff2a8e5f1",
+				ResourceType:       "instance",
+				AggregationMethod:  "mean",
+				Granularity:        300,
+				Threshold:          3800,
+				ComparisonOperator: alarms.OperatorGreaterThan,
+			},
+			alarms.ThresholdRule{
+				Type:              alarms.TypeGnocchiAggregationByMetricsThreshold,
+				Metrics:           []string{"5b7a6e41-5e4b-4b35-9a69-0b1c0f3a9c1d"},
+				AggregationMethod: "mean",
+				Threshold:         100,
+			},
+		},
+	}
+
+	alarmTimestamp = time.Date(2024, 3, 1, 10, 0, 0, 123456000, time.UTC)
+)
+
+// HandleAlarmListSuccessfully configures the test server to respond to a
+// List request.
+func HandleAlarmListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/alarms", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"limit": "10"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, "[%s]", CompositeAlarmBody)
+	})
+}
+
+// HandleAlarmGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleAlarmGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/alarms/0f3d9cd4-c4c5-4b52-8e6a-3e9a1b5d0a7b", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, CompositeAlarmBody)
+	})
+}
+
+// HandleAlarmCreateSuccessfully configures the test server to respond to a
+// Create request with the given body.
+func HandleAlarmCreateSuccessfully(t *testing.T, request string) {
+	th.Mux.HandleFunc("/alarms", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, request)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, CompositeAlarmBody)
+	})
+}
+
+// HandleAlarmDeleteSuccessfully configures the test server to respond to a
+// Delete request.
+func HandleAlarmDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/alarms/0f3d9cd4-c4c5-4b52-8e6a-3e9a1b5d0a7b", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
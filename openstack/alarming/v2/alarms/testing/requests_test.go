@@ -0,0 +1,130 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/alarming/v2/alarms"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAlarmListSuccessfully(t)
+
+	count := 0
+	err := alarms.List(client.ServiceClient(), alarms.ListOpts{Limit: 10}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := alarms.ExtractAlarms(page)
+		th.AssertNoErr(t, err)
+
+		th.AssertEquals(t, 1, len(actual))
+		th.AssertEquals(t, "instance_overloaded", actual[0].Name)
+		th.AssertEquals(t, "composite", actual[0].Type)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAlarmGetSuccessfully(t)
+
+	actual, err := alarms.Get(context.TODO(), client.ServiceClient(), "0f3d9cd4-c4c5-4b52-8e6a-3e9a1b5d0a7b").Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "0f3d9cd4-c4c5-4b52-8e6a-3e9a1b5d0a7b", actual.AlarmID)
+	th.AssertEquals(t, "insufficient data", actual.State)
+	th.AssertEquals(t, true, actual.Enabled)
+	th.AssertDeepEquals(t, []string{"http://example.com/scale_up"}, actual.AlarmActions)
+	th.AssertEquals(t, 2, len(actual.CompositeRule["or"].([]any)))
+	th.AssertEquals(t, alarmTimestamp, actual.Timestamp)
+	th.AssertEquals(t, alarmTimestamp, actual.StateTimestamp)
+}
+
+func TestCreateComposite(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAlarmCreateSuccessfully(t, CompositeAlarmCreateRequest)
+
+	createOpts := alarms.CreateOpts{
+		Name:         "instance_overloaded",
+		AlarmActions: []string{"http://example.com/scale_up"},
+		Rule:         CompositeRule,
+	}
+
+	actual, err := alarms.Create(context.TODO(), client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "0f3d9cd4-c4c5-4b52-8e6a-3e9a1b5d0a7b", actual.AlarmID)
+}
+
+func TestCreateThreshold(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAlarmCreateSuccessfully(t, ThresholdAlarmCreateRequest)
+
+	createOpts := alarms.CreateOpts{
+		Name: "cpu_high",
+		Rule: CPUHighRule,
+	}
+
+	_, err := alarms.Create(context.TODO(), client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+}
+
+func TestCreateMissingRule(t *testing.T) {
+	_, err := alarms.CreateOpts{Name: "cpu_high"}.ToAlarmCreateMap()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("Expected ErrMissingInput but got %v", err)
+	}
+}
+
+func TestRuleValidation(t *testing.T) {
+	missingQuery := alarms.ThresholdRule{
+		Type:              alarms.TypeGnocchiAggregationByResourcesThreshold,
+		Metric:            "cpu",
+		ResourceType:      "instance",
+		AggregationMethod: "mean",
+	}
+	_, err := alarms.Or{CPUHighRule, alarms.And{missingQuery}}.ToAlarmRuleMap()
+	th.AssertEquals(t, "Missing input for argument [alarms.ThresholdRule.Query]", err.Error())
+
+	badOperator := CPUHighRule
+	badOperator.ComparisonOperator = ">"
+	_, err = alarms.And{CPUHighRule, badOperator}.ToAlarmRuleMap()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("Expected ErrInvalidInput but got %v", err)
+	}
+
+	badType := CPUHighRule
+	badType.Type = alarms.TypeComposite
+	_, err = badType.ToAlarmRuleMap()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("Expected ErrInvalidInput but got %v", err)
+	}
+
+	_, err = alarms.Or{}.ToAlarmRuleMap()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("Expected ErrInvalidInput but got %v", err)
+	}
+
+	_, err = alarms.And{CPUHighRule, nil}.ToAlarmRuleMap()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("Expected ErrMissingInput but got %v", err)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAlarmDeleteSuccessfully(t)
+
+	err := alarms.Delete(context.TODO(), client.ServiceClient(), "0f3d9cd4-c4c5-4b52-8e6a-3e9a1b5d0a7b").ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,78 @@
+/*
+Package chassis contains the functionality to Listing, Searching, Creating,
+Updating, and Deleting of bare metal Chassis resources, and to list the Nodes
+of a Chassis.
+
+API reference: https://docs.openstack.org/api-ref/baremetal/#chassis-chassis
+
+Example to List Chassis with Detail
+
+	err := chassis.ListDetail(client, nil).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		chassisList, err := chassis.ExtractChassis(page)
+		if err != nil {
+			return false, err
+		}
+
+		for _, c := range chassisList {
+			// Do something
+		}
+
+		return true, nil
+	})
+
+Example to Create a Chassis
+
+	createOpts := chassis.CreateOpts{
+		Description: "Rack 42, enclosure 3",
+		Extra: map[string]any{
+			"location": "dc1",
+		},
+	}
+
+	createChassis, err := chassis.Create(context.TODO(), client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get a Chassis
+
+	showChassis, err := chassis.Get(context.TODO(), client, "dff29d23-1ded-43b4-8ae1-5eebb3e30de1").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Chassis
+
+	updateOpts := chassis.UpdateOpts{
+		chassis.UpdateOperation{
+			Op:    chassis.ReplaceOp,
+			Path:  "/description",
+			Value: "Rack 43, enclosure 1",
+		},
+	}
+
+	updateChassis, err := chassis.Update(context.TODO(), client, "dff29d23-1ded-43b4-8ae1-5eebb3e30de1", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the Nodes of a Chassis
+
+	allPages, err := chassis.ListNodes(client, "dff29d23-1ded-43b4-8ae1-5eebb3e30de1", nil).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	chassisNodes, err := nodes.ExtractNodes(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Chassis
+
+	err = chassis.Delete(context.TODO(), client, "dff29d23-1ded-43b4-8ae1-5eebb3e30de1").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package chassis
@@ -0,0 +1,112 @@
+package chassis
+
+import (
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+type chassisResult struct {
+	gophercloud.Result
+}
+
+func (r chassisResult) Extract() (*Chassis, error) {
+	var s Chassis
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r chassisResult) ExtractInto(v any) error {
+	return r.Result.ExtractIntoStructPtr(v, "")
+}
+
+func ExtractChassisInto(r pagination.Page, v any) error {
+	return r.(ChassisPage).Result.ExtractIntoSlicePtr(v, "chassis")
+}
+
+// Chassis represents a chassis in the OpenStack Bare Metal API. A chassis
+// groups nodes, typically the ones sharing a physical enclosure.
+type Chassis struct {
+	// UUID for the resource.
+	UUID string `json:"uuid"`
+
+	// Descriptive text about the chassis.
+	Description string `json:"description"`
+
+	// A set of one or more arbitrary metadata key and value pairs.
+	Extra map[string]any `json:"extra"`
+
+	// The UTC date and time when the resource was created, ISO 8601 format.
+	CreatedAt time.Time `json:"created_at"`
+
+	// The UTC date and time when the resource was updated, ISO 8601 format.
+	// May be “null”.
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// A list of relative links. Includes the self and bookmark links.
+	Links []any `json:"links"`
+
+	// Links to the collection of nodes contained in this chassis.
+	Nodes []any `json:"nodes"`
+}
+
+// ChassisPage abstracts the raw results of making a List() request against
+// the API.
+type ChassisPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a page contains no Chassis results.
+func (r ChassisPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	s, err := ExtractChassis(r)
+	return len(s) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (r ChassisPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"chassis_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// ExtractChassis interprets the results of a single page from a List() call,
+// producing a slice of Chassis entities.
+func ExtractChassis(r pagination.Page) ([]Chassis, error) {
+	var s []Chassis
+	err := ExtractChassisInto(r, &s)
+	return s, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract
+// method to interpret it as a Chassis.
+type GetResult struct {
+	chassisResult
+}
+
+// CreateResult is the response from a Create operation.
+type CreateResult struct {
+	chassisResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a Chassis.
+type UpdateResult struct {
+	chassisResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,2 @@
+// chassis unit tests
+package testing
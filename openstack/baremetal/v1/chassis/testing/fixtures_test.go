@@ -0,0 +1,216 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/baremetal/v1/chassis"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+// ChassisListBody contains the canned body of a chassis.List response, without detail.
+const ChassisListBody = `
+{
+  "chassis": [
+    {
+      "description": "Rack 42, enclosure 3",
+      "links": [
+        {
+          "href": "http://127.0.0.1:6385/v1/chassis/dff29d23-1ded-43b4-8ae1-5eebb3e30de1",
+          "rel": "self"
+        },
+        {
+          "href": "http://127.0.0.1:6385/chassis/dff29d23-1ded-43b4-8ae1-5eebb3e30de1",
+          "rel": "bookmark"
+        }
+      ],
+      "uuid": "dff29d23-1ded-43b4-8ae1-5eebb3e30de1"
+    }
+  ]
+}
+`
+
+// SingleChassisBody is the canned body of a Get request on an existing chassis.
+const SingleChassisBody = `
+{
+  "created_at": "2019-01-31T19:59:28+00:00",
+  "description": "Rack 42, enclosure 3",
+  "extra": {
+    "location": "dc1"
+  },
+  "links": [
+    {
+      "href": "http://127.0.0.1:6385/v1/chassis/dff29d23-1ded-43b4-8ae1-5eebb3e30de1",
+      "rel": "self"
+    },
+    {
+      "href": "http://127.0.0.1:6385/chassis/dff29d23-1ded-43b4-8ae1-5eebb3e30de1",
+      "rel": "bookmark"
+    }
+  ],
+  "nodes": [
+    {
+      "href": "http://127.0.0.1:6385/v1/chassis/dff29d23-1ded-43b4-8ae1-5eebb3e30de1/nodes",
+      "rel": "self"
+    },
+    {
+      "href": "http://127.0.0.1:6385/chassis/dff29d23-1ded-43b4-8ae1-5eebb3e30de1/nodes",
+      "rel": "bookmark"
+    }
+  ],
+  "updated_at": "2019-02-15T19:59:29+00:00",
+  "uuid": "dff29d23-1ded-43b4-8ae1-5eebb3e30de1"
+}
+`
+
+// ChassisListDetailBody contains the canned body of a chassis.ListDetail response.
+var ChassisListDetailBody = fmt.Sprintf(`
+{
+  "chassis": [%s]
+}
+`, SingleChassisBody)
+
+// ChassisNodesListBody contains the canned body of a chassis.ListNodes response.
+const ChassisNodesListBody = `
+{
+  "nodes": [
+    {
+      "instance_uuid": null,
+      "links": [
+        {
+          "href": "http://127.0.0.1:6385/v1/nodes/d2630783-6ec8-4836-b556-ab427c4b581e",
+          "rel": "self"
+        }
+      ],
+      "maintenance": false,
+      "name": "foo",
+      "power_state": null,
+      "provision_state": "enroll",
+      "uuid": "d2630783-6ec8-4836-b556-ab427c4b581e"
+    }
+  ]
+}
+`
+
+var (
+	chassisCreated, _ = time.Parse(time.RFC3339, "2019-01-31T19:59:28+00:00")
+	chassisUpdated, _ = time.Parse(time.RFC3339, "2019-02-15T19:59:29+00:00")
+
+	ChassisFoo = chassis.Chassis{
+		UUID:        "dff29d23-1ded-43b4-8ae1-5eebb3e30de1",
+		Description: "Rack 42, enclosure 3",
+		Extra:       map[string]any{"location": "dc1"},
+		CreatedAt:   chassisCreated,
+		UpdatedAt:   chassisUpdated,
+		Links: []any{
+			map[string]any{"href": "http://127.0.0.1:6385/v1/chassis/dff29d23-1ded-43b4-8ae1-5eebb3e30de1", "rel": "self"},
+			map[string]any{"href": "http://127.0.0.1:6385/chassis/dff29d23-1ded-43b4-8ae1-5eebb3e30de1", "rel": "bookmark"},
+		},
+		Nodes: []any{
+			map[string]any{"href": "http://127.0.0.1:6385/v1/chassis/dff29d23-1ded-43b4-8ae1-5eebb3e30de1/nodes", "rel": "self"},
+			map[string]any{"href": "http://127.0.0.1:6385/chassis/dff29d23-1ded-43b4-8ae1-5eebb3e30de1/nodes", "rel": "bookmark"},
+		},
+	}
+)
+
+// HandleChassisListSuccessfully sets up the test server to respond to a chassis List request.
+func HandleChassisListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/chassis", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			fmt.Fprint(w, ChassisListBody)
+
+		case "dff29d23-1ded-43b4-8ae1-5eebb3e30de1":
+			fmt.Fprint(w, `{ "chassis": [] }`)
+		default:
+			t.Fatalf("/chassis invoked with unexpected marker=[%s]", marker)
+		}
+	})
+}
+
+// HandleChassisListDetailSuccessfully sets up the test server to respond to a chassis ListDetail request.
+func HandleChassisListDetailSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/chassis/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+
+		fmt.Fprint(w, ChassisListDetailBody)
+	})
+}
+
+// HandleChassisCreationSuccessfully sets up the test server to respond to a chassis creation request
+// with a given response.
+func HandleChassisCreationSuccessfully(t *testing.T, response string) {
+	th.Mux.HandleFunc("/chassis", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{
+          "description": "Rack 42, enclosure 3",
+          "extra": {
+            "location": "dc1"
+          }
+        }`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, response)
+	})
+}
+
+// HandleChassisDeletionSuccessfully sets up the test server to respond to a chassis deletion request.
+func HandleChassisDeletionSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/chassis/dff29d23-1ded-43b4-8ae1-5eebb3e30de1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleChassisGetSuccessfully sets up the test server to respond to a chassis Get request.
+func HandleChassisGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/chassis/dff29d23-1ded-43b4-8ae1-5eebb3e30de1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+
+		fmt.Fprint(w, SingleChassisBody)
+	})
+}
+
+// HandleChassisUpdateSuccessfully sets up the test server to respond to a chassis Update request.
+func HandleChassisUpdateSuccessfully(t *testing.T, response string) {
+	th.Mux.HandleFunc("/chassis/dff29d23-1ded-43b4-8ae1-5eebb3e30de1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestJSONRequest(t, r, `[{"op": "replace", "path": "/description", "value": "Rack 42, enclosure 3"}]`)
+
+		fmt.Fprint(w, response)
+	})
+}
+
+// HandleChassisListNodesSuccessfully sets up the test server to respond to a chassis ListNodes request.
+func HandleChassisListNodesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/chassis/dff29d23-1ded-43b4-8ae1-5eebb3e30de1/nodes", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.AssertEquals(t, "enroll", r.URL.Query().Get("provision_state"))
+		w.Header().Add("Content-Type", "application/json")
+
+		fmt.Fprint(w, ChassisNodesListBody)
+	})
+}
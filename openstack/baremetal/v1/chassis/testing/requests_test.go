@@ -0,0 +1,164 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/baremetal/v1/chassis"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/baremetal/v1/nodes"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestListDetailChassis(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleChassisListDetailSuccessfully(t)
+
+	pages := 0
+	err := chassis.ListDetail(client.ServiceClient(), chassis.ListOpts{}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		pages++
+
+		actual, err := chassis.ExtractChassis(page)
+		if err != nil {
+			return false, err
+		}
+
+		if len(actual) != 1 {
+			t.Fatalf("Expected 1 chassis, got %d", len(actual))
+		}
+		th.CheckDeepEquals(t, ChassisFoo, actual[0])
+
+		return true, nil
+	})
+
+	th.AssertNoErr(t, err)
+
+	if pages != 1 {
+		t.Errorf("Expected 1 page, saw %d", pages)
+	}
+}
+
+func TestListChassis(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleChassisListSuccessfully(t)
+
+	pages := 0
+	err := chassis.List(client.ServiceClient(), chassis.ListOpts{}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		pages++
+
+		actual, err := chassis.ExtractChassis(page)
+		if err != nil {
+			return false, err
+		}
+
+		if len(actual) != 1 {
+			t.Fatalf("Expected 1 chassis, got %d", len(actual))
+		}
+		th.AssertEquals(t, "dff29d23-1ded-43b4-8ae1-5eebb3e30de1", actual[0].UUID)
+		th.AssertEquals(t, "Rack 42, enclosure 3", actual[0].Description)
+
+		return true, nil
+	})
+
+	th.AssertNoErr(t, err)
+
+	if pages != 1 {
+		t.Errorf("Expected 1 page, saw %d", pages)
+	}
+}
+
+func TestListOpts(t *testing.T) {
+	// Detail cannot take Fields
+	opts := chassis.ListOpts{
+		Fields: []string{"uuid", "description"},
+	}
+
+	_, err := opts.ToChassisListDetailQuery()
+	th.AssertEquals(t, err.Error(), "fields is not a valid option when getting a detailed listing of chassis")
+
+	// Regular ListOpts can
+	query, err := opts.ToChassisListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "?fields=uuid%2Cdescription", query)
+}
+
+func TestCreateChassis(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleChassisCreationSuccessfully(t, SingleChassisBody)
+
+	actual, err := chassis.Create(context.TODO(), client.ServiceClient(), chassis.CreateOpts{
+		Description: "Rack 42, enclosure 3",
+		Extra: map[string]any{
+			"location": "dc1",
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, ChassisFoo, *actual)
+}
+
+func TestDeleteChassis(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleChassisDeletionSuccessfully(t)
+
+	res := chassis.Delete(context.TODO(), client.ServiceClient(), "dff29d23-1ded-43b4-8ae1-5eebb3e30de1")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestGetChassis(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleChassisGetSuccessfully(t)
+
+	c := client.ServiceClient()
+	actual, err := chassis.Get(context.TODO(), c, "dff29d23-1ded-43b4-8ae1-5eebb3e30de1").Extract()
+	if err != nil {
+		t.Fatalf("Unexpected Get error: %v", err)
+	}
+
+	th.CheckDeepEquals(t, ChassisFoo, *actual)
+}
+
+func TestUpdateChassis(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleChassisUpdateSuccessfully(t, SingleChassisBody)
+
+	c := client.ServiceClient()
+	actual, err := chassis.Update(context.TODO(), c, "dff29d23-1ded-43b4-8ae1-5eebb3e30de1", chassis.UpdateOpts{
+		chassis.UpdateOperation{
+			Op:    chassis.ReplaceOp,
+			Path:  "/description",
+			Value: "Rack 42, enclosure 3",
+		},
+	}).Extract()
+	if err != nil {
+		t.Fatalf("Unexpected Update error: %v", err)
+	}
+
+	th.CheckDeepEquals(t, ChassisFoo, *actual)
+}
+
+func TestListChassisNodes(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleChassisListNodesSuccessfully(t)
+
+	listOpts := nodes.ListOpts{
+		ProvisionState: nodes.Enroll,
+	}
+
+	allPages, err := chassis.ListNodes(client.ServiceClient(), "dff29d23-1ded-43b4-8ae1-5eebb3e30de1", listOpts).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := nodes.ExtractNodes(allPages)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(actual))
+	th.AssertEquals(t, "d2630783-6ec8-4836-b556-ab427c4b581e", actual[0].UUID)
+	th.AssertEquals(t, "foo", actual[0].Name)
+}
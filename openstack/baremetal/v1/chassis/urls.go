@@ -0,0 +1,39 @@
+package chassis
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+func createURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("chassis")
+}
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return createURL(client)
+}
+
+func listDetailURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("chassis", "detail")
+}
+
+func resourceURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("chassis", id)
+}
+
+func deleteURL(client *gophercloud.ServiceClient, id string) string {
+	return resourceURL(client, id)
+}
+
+func getURL(client *gophercloud.ServiceClient, id string) string {
+	return resourceURL(client, id)
+}
+
+func updateURL(client *gophercloud.ServiceClient, id string) string {
+	return resourceURL(client, id)
+}
+
+func listNodesURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("chassis", id, "nodes")
+}
+
+func listNodesDetailURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("chassis", id, "nodes", "detail")
+}
@@ -0,0 +1,43 @@
+/*
+Package configdrive builds config drive images for the nodes of the
+OpenStack Bare Metal service, laid out as expected by cloud-init and Ironic.
+
+Building an image requires one of genisoimage, mkisofs or xorrisofs to be
+available in the PATH.
+
+Example to Deploy a Node with a Config Drive
+
+	provisionOpts := nodes.ProvisionStateOpts{
+		Target: nodes.TargetActive,
+	}
+
+	err := configdrive.Apply(context.TODO(), &provisionOpts, nodes.ConfigDrive{
+		MetaData: map[string]any{
+			"uuid":     "c9afd385-5d89-4ecb-9e1c-68194da6b474",
+			"hostname": "node-0",
+		},
+		UserData: "#cloud-config\n",
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = nodes.ChangeProvisionState(context.TODO(), client, "c9afd385-5d89-4ecb-9e1c-68194da6b474", provisionOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Build a Config Drive Image
+
+	iso, err := configdrive.BuildISO(context.TODO(), nodes.ConfigDrive{
+		UserData: "#cloud-config\n",
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	if err := os.WriteFile("configdrive.iso", iso, 0o600); err != nil {
+		panic(err)
+	}
+*/
+package configdrive
@@ -0,0 +1,37 @@
+package configdrive
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// ErrNoISOTool is returned by BuildISO when none of the tools able to build
+// an ISO9660 image is available.
+type ErrNoISOTool struct {
+	gophercloud.BaseError
+	Tools []string
+}
+
+func (e ErrNoISOTool) Error() string {
+	return fmt.Sprintf("Unable to build a config drive, none of %s is available", strings.Join(e.Tools, ", "))
+}
+
+// ErrISOBuild is returned by BuildISO when the tool building the ISO9660
+// image fails.
+type ErrISOBuild struct {
+	gophercloud.BaseError
+	Tool   string
+	Output string
+	Err    error
+}
+
+func (e ErrISOBuild) Error() string {
+	return fmt.Sprintf("Unable to build a config drive with %s: %s: %s", e.Tool, e.Err, strings.TrimSpace(e.Output))
+}
+
+// Unwrap returns the error of the tool.
+func (e ErrISOBuild) Unwrap() error {
+	return e.Err
+}
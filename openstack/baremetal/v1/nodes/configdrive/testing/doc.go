@@ -0,0 +1,2 @@
+// configdrive unit tests
+package testing
@@ -0,0 +1,77 @@
+package testing
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/baremetal/v1/nodes"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/baremetal/v1/nodes/configdrive"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func TestFiles(t *testing.T) {
+	files, err := configdrive.Files(nodes.ConfigDrive{
+		MetaData: map[string]any{
+			"uuid": "1234asdf",
+		},
+		NetworkData: map[string]any{
+			"links": []any{},
+		},
+		UserData: "#cloud-config\n",
+	})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, map[string][]byte{
+		"openstack/latest/meta_data.json":    []byte(`{"uuid":"1234asdf"}`),
+		"openstack/latest/network_data.json": []byte(`{"links":[]}`),
+		"openstack/latest/user_data":         []byte("#cloud-config\n"),
+	}, files)
+
+	files, err = configdrive.Files(nodes.ConfigDrive{
+		UserData: map[string]any{
+			"ignition": map[string]string{
+				"version": "2.2.0",
+			},
+		},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(files))
+	th.AssertJSONEquals(t, `{"ignition": {"version": "2.2.0"}}`, json.RawMessage(files["openstack/latest/user_data"]))
+}
+
+func TestApply(t *testing.T) {
+	opts := nodes.ProvisionStateOpts{
+		Target: nodes.TargetActive,
+	}
+	err := configdrive.Apply(context.TODO(), &opts, nodes.ConfigDrive{UserData: "#cloud-config\n"})
+
+	var noTool configdrive.ErrNoISOTool
+	if errors.As(err, &noTool) {
+		t.Skip(err)
+	}
+	th.AssertNoErr(t, err)
+
+	encoded, ok := opts.ConfigDrive.(string)
+	if !ok {
+		t.Fatalf("Expected an encoded config drive but got %T", opts.ConfigDrive)
+	}
+
+	compressed, err := base64.StdEncoding.DecodeString(encoded)
+	th.AssertNoErr(t, err)
+
+	r, err := gzip.NewReader(bytes.NewReader(compressed))
+	th.AssertNoErr(t, err)
+
+	iso, err := io.ReadAll(r)
+	th.AssertNoErr(t, err)
+
+	// The primary volume descriptor starts at sector 16.
+	th.AssertEquals(t, "CD001", string(iso[32769:32774]))
+	th.AssertEquals(t, "config-2", strings.TrimSpace(string(iso[32808:32840])))
+}
@@ -0,0 +1,138 @@
+package configdrive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/baremetal/v1/nodes"
+)
+
+// isoTools are the tools which may be used to build a config drive image, in
+// order of preference.
+var isoTools = []string{"genisoimage", "mkisofs", "xorrisofs"}
+
+// Files returns the content of the config drive, keyed by path, laid out as
+// expected by cloud-init and Ironic: openstack/latest/meta_data.json,
+// openstack/latest/network_data.json and openstack/latest/user_data. Files
+// without content are omitted. UserData is written as is if it is a string or
+// a []byte, and encoded in JSON otherwise.
+func Files(cd nodes.ConfigDrive) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+
+	if cd.MetaData != nil {
+		b, err := json.Marshal(cd.MetaData)
+		if err != nil {
+			return nil, err
+		}
+		files["openstack/latest/meta_data.json"] = b
+	}
+
+	if cd.NetworkData != nil {
+		b, err := json.Marshal(cd.NetworkData)
+		if err != nil {
+			return nil, err
+		}
+		files["openstack/latest/network_data.json"] = b
+	}
+
+	switch v := cd.UserData.(type) {
+	case nil:
+	case string:
+		files["openstack/latest/user_data"] = []byte(v)
+	case []byte:
+		files["openstack/latest/user_data"] = v
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		files["openstack/latest/user_data"] = b
+	}
+
+	return files, nil
+}
+
+// BuildISO builds the ISO9660 image of the config drive, labelled config-2,
+// in the same way as python-ironicclient. One of genisoimage, mkisofs or
+// xorrisofs must be available in the PATH, otherwise an ErrNoISOTool error is
+// returned.
+func BuildISO(ctx context.Context, cd nodes.ConfigDrive) ([]byte, error) {
+	tool := ""
+	for _, name := range isoTools {
+		if path, err := exec.LookPath(name); err == nil {
+			tool = path
+			break
+		}
+	}
+	if tool == "" {
+		return nil, ErrNoISOTool{Tools: isoTools}
+	}
+
+	files, err := Files(cd)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "configdrive")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	root := filepath.Join(dir, "root")
+	if err := os.MkdirAll(root, 0o700); err != nil {
+		return nil, err
+	}
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(path, content, 0o600); err != nil {
+			return nil, err
+		}
+	}
+
+	image := filepath.Join(dir, "configdrive.iso")
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, tool,
+		"-o", image,
+		"-ldots", "-allow-lowercase", "-allow-multidot", "-l",
+		"-publisher", "gophercloud-configdrive 0.1",
+		"-quiet", "-J", "-r",
+		"-V", "config-2",
+		root)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, ErrISOBuild{Tool: tool, Output: stderr.String(), Err: err}
+	}
+
+	return os.ReadFile(image)
+}
+
+// Build builds the ISO9660 image of the config drive with BuildISO and
+// encodes it with nodes.EncodeConfigDrive. The result can be used as
+// nodes.ProvisionStateOpts.ConfigDrive with any API version, while a
+// nodes.ConfigDrive may only be passed as is starting with API version 1.56.
+func Build(ctx context.Context, cd nodes.ConfigDrive) (string, error) {
+	iso, err := BuildISO(ctx, cd)
+	if err != nil {
+		return "", err
+	}
+	return nodes.EncodeConfigDrive(iso)
+}
+
+// Apply builds the config drive with Build and sets it as the ConfigDrive of
+// opts.
+func Apply(ctx context.Context, opts *nodes.ProvisionStateOpts, cd nodes.ConfigDrive) error {
+	configDrive, err := Build(ctx, cd)
+	if err != nil {
+		return err
+	}
+	opts.ConfigDrive = configDrive
+	return nil
+}
@@ -37,6 +37,34 @@ Example to List Nodes
 		return true, nil
 	})
 
+Example to List Only Selected Fields of Nodes
+
+	type nodeState struct {
+		UUID           string               `json:"uuid"`
+		ProvisionState nodes.ProvisionState `json:"provision_state"`
+	}
+
+	listOpts := nodes.ListOpts{
+		Lessee: "d3b07384d113edec49eaa6238ad5ff00",
+		Fields: []string{"uuid", "provision_state"},
+	}
+
+	allPages, err := nodes.List(client, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	var states []nodeState
+	if err := nodes.ExtractNodesInto(allPages, &states); err != nil {
+		panic(err)
+	}
+
+	for _, s := range states {
+		if s.ProvisionState == nodes.Available {
+			// Do something
+		}
+	}
+
 Example to Create Node
 
 	createOpts := nodes.CreateOpts
@@ -94,6 +122,48 @@ Example to Validate Node
 		panic(err)
 	}
 
+Example to Set and Unset Maintenance Mode
+
+	err := nodes.SetMaintenance(context.TODO(), client, "c9afd385-5d89-4ecb-9e1c-68194da6b474", nodes.MaintenanceOpts{
+		Reason: "Replacing disk",
+	}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = nodes.UnsetMaintenance(context.TODO(), client, "c9afd385-5d89-4ecb-9e1c-68194da6b474").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Clean a Node Unless it is in Maintenance Mode
+
+	err := nodes.ChangeProvisionStateUnlessMaintenance(context.TODO(), client, "c9afd385-5d89-4ecb-9e1c-68194da6b474", nodes.ProvisionStateOpts{
+		Target: nodes.TargetClean,
+	}, false).ExtractErr()
+	if _, ok := err.(nodes.ErrNodeInMaintenance); ok {
+		// the node is in maintenance mode, pass force to clean it anyway
+	} else if err != nil {
+		panic(err)
+	}
+
+Example to Deploy a Node with a Config Drive
+
+	configDrive, err := nodes.EncodeConfigDrive(iso)
+	if err != nil {
+		panic(err)
+	}
+
+	err = nodes.ChangeProvisionState(context.TODO(), client, "c9afd385-5d89-4ecb-9e1c-68194da6b474", nodes.ProvisionStateOpts{
+		Target:      nodes.TargetActive,
+		ConfigDrive: configDrive,
+	}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+The configdrive package builds config drive images from a ConfigDrive.
+
 Example to inject non-masking interrupts
 
 	err := nodes.InjectNMI(context.TODO(), client, "a62b8495-52e2-407b-b3cb-62775d04c2b8").ExtractErr()
@@ -0,0 +1,24 @@
+package nodes
+
+import (
+	"fmt"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// ErrNodeInMaintenance is returned by ChangeProvisionStateUnlessMaintenance
+// when a destructive provision action is requested on a node in maintenance
+// mode.
+type ErrNodeInMaintenance struct {
+	gophercloud.BaseError
+	NodeID string
+	Reason string
+	Target TargetProvisionState
+}
+
+func (e ErrNodeInMaintenance) Error() string {
+	if e.Reason == "" {
+		return fmt.Sprintf("Node [%s] is in maintenance, refusing provision action [%s]", e.NodeID, e.Target)
+	}
+	return fmt.Sprintf("Node [%s] is in maintenance (%s), refusing provision action [%s]", e.NodeID, e.Reason, e.Target)
+}
@@ -15,7 +15,9 @@ type ListOptsBuilder interface {
 	ToNodeListDetailQuery() (string, error)
 }
 
-// Provision state reports the current provision state of the node, these are only used in filtering
+// Provision state reports the current provision state of the node. It is the
+// type of the ProvisionState and TargetProvisionState fields of a Node, and
+// can be used in the partial structs decoded by ExtractNodesInto.
 type ProvisionState string
 
 const (
@@ -130,6 +132,9 @@ type ListOpts struct {
 
 	// A string or UUID of the tenant who owns the baremetal node.
 	Owner string `q:"owner"`
+
+	// A string or UUID of the tenant who is leasing the baremetal node.
+	Lessee string `q:"lessee"`
 }
 
 // ToNodeListQuery formats a ListOpts into a query string.
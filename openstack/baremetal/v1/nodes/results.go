@@ -118,13 +118,13 @@ type Node struct {
 	TargetPowerState string `json:"target_power_state"`
 
 	// Current provisioning state of this Node.
-	ProvisionState string `json:"provision_state"`
+	ProvisionState ProvisionState `json:"provision_state"`
 
 	// A provisioning action has been requested, this field represents the requested (ie, “target”) state. Note
 	// that a Node may go through several states during its transition to this target state. For instance, when
 	// requesting an instance be deployed to an AVAILABLE Node, the Node may go through the following state
 	// change progression: AVAILABLE -> DEPLOYING -> DEPLOYWAIT -> DEPLOYING -> ACTIVE
-	TargetProvisionState string `json:"target_provision_state"`
+	TargetProvisionState ProvisionState `json:"target_provision_state"`
 
 	// Whether or not this Node is currently in “maintenance mode”. Setting a Node into maintenance mode removes it
 	// from the available resource pool and halts some internal automation. This can happen manually (eg, via an API
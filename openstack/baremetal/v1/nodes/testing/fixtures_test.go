@@ -3,6 +3,7 @@ package testing
 import (
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -1804,6 +1805,21 @@ func HandleUnsetNodeMaintenanceSuccessfully(t *testing.T) {
 	})
 }
 
+// HandleNodeGetInMaintenanceSuccessfully sets up the test server to respond
+// to a node Get request with a node in maintenance mode.
+func HandleNodeGetInMaintenanceSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/nodes/1234asdf", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		fmt.Fprint(w, strings.Replace(SingleNodeBody,
+			`"maintenance": false,
+  "maintenance_reason": null,`,
+			`"maintenance": true,
+  "maintenance_reason": "Replacing disk",`, 1))
+	})
+}
+
 // HandleGetInventorySuccessfully sets up the test server to respond to a get inventory request for a node
 func HandleGetInventorySuccessfully(t *testing.T) {
 	th.Mux.HandleFunc("/nodes/1234asdf/inventory", func(w http.ResponseWriter, r *http.Request) {
@@ -1849,3 +1865,30 @@ func HandleDetachVirtualMediaSuccessfully(t *testing.T, withType bool) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 }
+
+// HandleNodeListFieldsSuccessfully sets up the test server to respond to a
+// node List request projected on the name and provision states of the nodes.
+func HandleNodeListFieldsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/nodes", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"fields": "name,provision_state,target_provision_state"})
+		w.Header().Add("Content-Type", "application/json")
+
+		fmt.Fprint(w, `
+{
+  "nodes": [
+    {
+      "name": "foo",
+      "provision_state": "deploying",
+      "target_provision_state": "active"
+    },
+    {
+      "name": "bar",
+      "provision_state": "available",
+      "target_provision_state": null
+    }
+  ]
+}`)
+	})
+}
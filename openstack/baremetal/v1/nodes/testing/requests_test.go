@@ -1,7 +1,11 @@
 package testing
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
+	"io"
 	"net/http"
 	"testing"
 
@@ -74,6 +78,56 @@ func TestListNodes(t *testing.T) {
 	}
 }
 
+func TestListNodesIntoPartialStruct(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleNodeListSuccessfully(t)
+
+	type partialNode struct {
+		Name           string               `json:"name"`
+		ProvisionState nodes.ProvisionState `json:"provision_state"`
+	}
+
+	listOpts := nodes.ListOpts{
+		Fields: []string{"name", "provision_state"},
+	}
+
+	allPages, err := nodes.List(client.ServiceClient(), listOpts).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	var actual []partialNode
+	err = nodes.ExtractNodesInto(allPages, &actual)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 3, len(actual))
+	th.AssertEquals(t, "foo", actual[0].Name)
+	th.AssertEquals(t, nodes.Enroll, actual[0].ProvisionState)
+}
+
+func TestListNodesWithFields(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleNodeListFieldsSuccessfully(t)
+
+	listOpts := nodes.ListOpts{
+		Fields: []string{"name", "provision_state", "target_provision_state"},
+	}
+
+	allPages, err := nodes.List(client.ServiceClient(), listOpts).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	actual, err := nodes.ExtractNodes(allPages)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(actual))
+	th.AssertEquals(t, "foo", actual[0].Name)
+	th.AssertEquals(t, nodes.Deploying, actual[0].ProvisionState)
+	th.AssertEquals(t, nodes.Active, actual[0].TargetProvisionState)
+	th.AssertEquals(t, "", actual[0].UUID)
+	th.AssertEquals(t, nodes.Available, actual[1].ProvisionState)
+	th.AssertEquals(t, nodes.ProvisionState(""), actual[1].TargetProvisionState)
+}
+
 func TestListOpts(t *testing.T) {
 	// Detail cannot take Fields
 	opts := nodes.ListOpts{
@@ -87,6 +141,17 @@ func TestListOpts(t *testing.T) {
 	query, err := opts.ToNodeListQuery()
 	th.AssertEquals(t, "?fields=name%2Cuuid", query)
 	th.AssertNoErr(t, err)
+
+	// Ownership and conductor group filters
+	opts = nodes.ListOpts{
+		ConductorGroup: "rack1",
+		Owner:          "owner-project",
+		Lessee:         "lessee-project",
+	}
+
+	query, err = opts.ToNodeListDetailQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "?conductor_group=rack1&lessee=lessee-project&owner=owner-project", query)
 }
 
 func TestCreateNode(t *testing.T) {
@@ -829,3 +894,83 @@ func TestVirtualMediaDetachWithTypes(t *testing.T) {
 	err := nodes.DetachVirtualMedia(context.TODO(), c, "1234asdf", opts).ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestNodeChangeProvisionStateUnlessMaintenance(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleNodeGetSuccessfully(t)
+	HandleNodeChangeProvisionStateClean(t)
+
+	c := client.ServiceClient()
+	err := nodes.ChangeProvisionStateUnlessMaintenance(context.TODO(), c, "1234asdf", nodes.ProvisionStateOpts{
+		Target: nodes.TargetClean,
+		CleanSteps: []nodes.CleanStep{
+			{
+				Interface: nodes.InterfaceDeploy,
+				Step:      "upgrade_firmware",
+				Args: map[string]any{
+					"force": "True",
+				},
+			},
+		},
+	}, false).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestNodeChangeProvisionStateUnlessMaintenanceRefused(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleNodeGetInMaintenanceSuccessfully(t)
+	th.Mux.HandleFunc("/nodes/1234asdf/states/provision", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Provision state should not have been changed")
+	})
+
+	c := client.ServiceClient()
+	err := nodes.ChangeProvisionStateUnlessMaintenance(context.TODO(), c, "1234asdf", nodes.ProvisionStateOpts{
+		Target: nodes.TargetClean,
+	}, false).ExtractErr()
+
+	e, ok := err.(nodes.ErrNodeInMaintenance)
+	if !ok {
+		t.Fatalf("Expected nodes.ErrNodeInMaintenance, got %T", err)
+	}
+	th.AssertEquals(t, "Replacing disk", e.Reason)
+	th.AssertEquals(t, nodes.TargetClean, e.Target)
+}
+
+func TestNodeChangeProvisionStateUnlessMaintenanceForced(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleNodeGetInMaintenanceSuccessfully(t)
+	HandleNodeChangeProvisionStateClean(t)
+
+	c := client.ServiceClient()
+	err := nodes.ChangeProvisionStateUnlessMaintenance(context.TODO(), c, "1234asdf", nodes.ProvisionStateOpts{
+		Target: nodes.TargetClean,
+		CleanSteps: []nodes.CleanStep{
+			{
+				Interface: nodes.InterfaceDeploy,
+				Step:      "upgrade_firmware",
+				Args: map[string]any{
+					"force": "True",
+				},
+			},
+		},
+	}, true).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestEncodeConfigDrive(t *testing.T) {
+	encoded, err := nodes.EncodeConfigDrive([]byte("config drive image"))
+	th.AssertNoErr(t, err)
+
+	compressed, err := base64.StdEncoding.DecodeString(encoded)
+	th.AssertNoErr(t, err)
+
+	r, err := gzip.NewReader(bytes.NewReader(compressed))
+	th.AssertNoErr(t, err)
+
+	decoded, err := io.ReadAll(r)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "config drive image", string(decoded))
+}
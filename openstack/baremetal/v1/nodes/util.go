@@ -1,7 +1,10 @@
 package nodes
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
 )
@@ -16,10 +19,59 @@ func WaitForProvisionState(ctx context.Context, c *gophercloud.ServiceClient, id
 			return false, err
 		}
 
-		if current.ProvisionState == string(state) {
+		if current.ProvisionState == state {
 			return true, nil
 		}
 
 		return false, nil
 	})
 }
+
+// IsDestructiveTarget reports whether moving a node to the given target
+// provision state may erase the data on its disks.
+func IsDestructiveTarget(target TargetProvisionState) bool {
+	switch target {
+	case TargetDeleted, TargetClean, TargetRebuild:
+		return true
+	}
+	return false
+}
+
+// ChangeProvisionStateUnlessMaintenance behaves like ChangeProvisionState,
+// but first checks whether the node is in maintenance mode. Destructive
+// provision actions (see IsDestructiveTarget) are refused with an
+// ErrNodeInMaintenance error while maintenance is set, unless force is true.
+func ChangeProvisionStateUnlessMaintenance(ctx context.Context, c *gophercloud.ServiceClient, id string, opts ProvisionStateOpts, force bool) (r ChangeStateResult) {
+	if !force && IsDestructiveTarget(opts.Target) {
+		node, err := Get(ctx, c, id).Extract()
+		if err != nil {
+			r.Err = err
+			return
+		}
+
+		if node.Maintenance {
+			r.Err = ErrNodeInMaintenance{
+				NodeID: id,
+				Reason: node.MaintenanceReason,
+				Target: opts.Target,
+			}
+			return
+		}
+	}
+
+	return ChangeProvisionState(ctx, c, id, opts)
+}
+
+// EncodeConfigDrive gzips and base64-encodes a config drive image, as
+// expected by Ironic. Images can be built with the configdrive package.
+func EncodeConfigDrive(iso []byte) (string, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(iso); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
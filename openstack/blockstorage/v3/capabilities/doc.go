@@ -0,0 +1,19 @@
+/*
+Package capabilities provides the ability to get the capabilities of a
+volume backend, such as its storage protocol and the volume type extra
+specs it supports. Capabilities are retrieved by host name, as listed by
+the services or schedulerstats packages.
+
+Example of Getting the Capabilities of a Backend
+
+	host := "devstack@lvmdriver-1"
+	capabilities, err := capabilities.Get(context.TODO(), volumeClient, host).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for name, property := range capabilities.Properties {
+		fmt.Printf("%s (%s): %s\n", name, property.Type, property.Description)
+	}
+*/
+package capabilities
@@ -0,0 +1,15 @@
+package capabilities
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// Get retrieves the capabilities of the volume backend running on the given
+// host, such as "devstack@lvmdriver-1". It requires admin privileges.
+func Get(ctx context.Context, client *gophercloud.ServiceClient, host string) (r GetResult) {
+	resp, err := client.Get(ctx, getURL(client, host), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
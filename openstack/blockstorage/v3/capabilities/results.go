@@ -0,0 +1,69 @@
+package capabilities
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// Property describes a capability which can be requested with a volume type
+// extra spec.
+type Property struct {
+	// Title is the human-readable name of the property.
+	Title string `json:"title"`
+
+	// Description is the human-readable description of the property.
+	Description string `json:"description"`
+
+	// Type is the type of the value of the property, such as boolean or
+	// string.
+	Type string `json:"type"`
+}
+
+// Capabilities contains the capabilities of a volume backend.
+type Capabilities struct {
+	// Namespace is the metadata namespace of the capabilities.
+	Namespace string `json:"namespace"`
+
+	// VendorName is the vendor of the backend.
+	VendorName string `json:"vendor_name"`
+
+	// VolumeBackendName is the name of the backend.
+	VolumeBackendName string `json:"volume_backend_name"`
+
+	// PoolName is the name of the pool, if the backend reports one.
+	PoolName string `json:"pool_name"`
+
+	// DriverVersion is the version of the volume driver.
+	DriverVersion string `json:"driver_version"`
+
+	// StorageProtocol is the protocol used to attach volumes, such as iSCSI
+	// or FC.
+	StorageProtocol string `json:"storage_protocol"`
+
+	// DisplayName is the human-readable name of the capabilities.
+	DisplayName string `json:"display_name"`
+
+	// Description is the human-readable description of the capabilities.
+	Description string `json:"description"`
+
+	// Visibility is the visibility of the capabilities.
+	Visibility string `json:"visibility"`
+
+	// ReplicationTargets are the replication targets of the backend.
+	ReplicationTargets []any `json:"replication_targets"`
+
+	// Properties are the capabilities which can be requested with volume
+	// type extra specs, by name.
+	Properties map[string]Property `json:"properties"`
+}
+
+// GetResult contains the response body and error from a Get request.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as Capabilities.
+func (r GetResult) Extract() (*Capabilities, error) {
+	var s Capabilities
+	err := r.ExtractInto(&s)
+	return &s, err
+}
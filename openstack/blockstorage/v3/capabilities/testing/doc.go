@@ -0,0 +1,2 @@
+// capabilities unittests
+package testing
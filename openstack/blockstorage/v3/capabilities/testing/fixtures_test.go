@@ -0,0 +1,73 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/capabilities"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+const GetOutput = `
+{
+    "namespace": "OS::Storage::Capabilities::devstack@lvmdriver-1",
+    "vendor_name": "Open Source",
+    "volume_backend_name": "lvmdriver-1",
+    "pool_name": null,
+    "driver_version": "3.0.0",
+    "storage_protocol": "iSCSI",
+    "display_name": "Capabilities of Cinder LVM driver",
+    "description": "These are volume type options provided by Cinder LVM driver.",
+    "visibility": "public",
+    "replication_targets": [],
+    "properties": {
+        "compression": {
+            "title": "Compression",
+            "description": "Enables compression.",
+            "type": "boolean"
+        },
+        "thin_provisioning": {
+            "title": "Thin Provisioning",
+            "description": "Sets thin provisioning.",
+            "type": "boolean"
+        }
+    }
+}`
+
+var GetResult = capabilities.Capabilities{
+	Namespace:          "OS::Storage::Capabilities::devstack@lvmdriver-1",
+	VendorName:         "Open Source",
+	VolumeBackendName:  "lvmdriver-1",
+	DriverVersion:      "3.0.0",
+	StorageProtocol:    "iSCSI",
+	DisplayName:        "Capabilities of Cinder LVM driver",
+	Description:        "These are volume type options provided by Cinder LVM driver.",
+	Visibility:         "public",
+	ReplicationTargets: []any{},
+	Properties: map[string]capabilities.Property{
+		"compression": {
+			Title:       "Compression",
+			Description: "Enables compression.",
+			Type:        "boolean",
+		},
+		"thin_provisioning": {
+			Title:       "Thin Provisioning",
+			Description: "Sets thin provisioning.",
+			Type:        "boolean",
+		},
+	},
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get request
+// for the capabilities of a backend.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/capabilities/devstack@lvmdriver-1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetOutput)
+	})
+}
@@ -0,0 +1,22 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/capabilities"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+// Verifies that the capabilities of a backend can be retrieved correctly
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleGetSuccessfully(t)
+
+	actual, err := capabilities.Get(context.TODO(), client.ServiceClient(), "devstack@lvmdriver-1").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, GetResult, *actual)
+}
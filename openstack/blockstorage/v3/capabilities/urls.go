@@ -0,0 +1,11 @@
+package capabilities
+
+import (
+	"net/url"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+func getURL(c *gophercloud.ServiceClient, host string) string {
+	return c.ServiceURL("capabilities", url.PathEscape(host))
+}
@@ -124,6 +124,15 @@ Example of Setting a Volume's Bootable status
 		panic(err)
 	}
 
+Example of Retrieving a Volume's Encryption Metadata
+
+	metadata, err := volumes.GetEncryptionMetadata(context.TODO(), client, volume.ID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("volume %s is protected by key %s (%s)\n", volume.ID, metadata.EncryptionKeyID, metadata.Cipher)
+
 Example of Changing Type of a Volume
 
 	changeTypeOpts := volumes.ChangeTypeOpts{
@@ -209,6 +209,16 @@ func Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (r G
 	return
 }
 
+// GetEncryptionMetadata retrieves the encryption metadata of the Volume with
+// the provided ID, such as its encryption key ID and cipher. To extract the
+// metadata from the response, call the Extract method on the
+// GetEncryptionMetadataResult.
+func GetEncryptionMetadata(ctx context.Context, client *gophercloud.ServiceClient, id string) (r GetEncryptionMetadataResult) {
+	resp, err := client.Get(ctx, encryptionURL(client, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // ListOptsBuilder allows extensions to add additional parameters to the List
 // request.
 type ListOptsBuilder interface {
@@ -763,3 +773,22 @@ func ResetStatus(ctx context.Context, client *gophercloud.ServiceClient, id stri
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// IDFromName is a convenience function that returns a volume's ID given its
+// name. It returns a gophercloud.ErrResourceNotFound if no volume matches the
+// name, and a gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	pages, err := List(client, ListOpts{Name: name}).AllPages(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	all, err := ExtractVolumes(pages)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.IDFromName(all, name, "volume", func(r Volume) (string, string) {
+		return r.Name, r.ID
+	})
+}
@@ -399,3 +399,36 @@ type ReImageResult struct {
 type ResetStatusResult struct {
 	gophercloud.ErrResult
 }
+
+// EncryptionMetadata contains the encryption details of a volume.
+type EncryptionMetadata struct {
+	// EncryptionKeyID is the ID of the key protecting the volume in the key
+	// manager. It is empty for volumes which are not encrypted.
+	EncryptionKeyID string `json:"encryption_key_id"`
+
+	// Provider is the class that provides encryption support, e.g. "luks".
+	Provider string `json:"provider"`
+
+	// Cipher is the encryption algorithm or mode, e.g. "aes-xts-plain64".
+	Cipher string `json:"cipher"`
+
+	// KeySize is the size of the encryption key, in bits.
+	KeySize int `json:"key_size"`
+
+	// ControlLocation is the notional service where encryption is performed,
+	// either "front-end" or "back-end".
+	ControlLocation string `json:"control_location"`
+}
+
+// GetEncryptionMetadataResult contains the response body and error from a
+// GetEncryptionMetadata request.
+type GetEncryptionMetadataResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetEncryptionMetadataResult as an EncryptionMetadata.
+func (r GetEncryptionMetadataResult) Extract() (*EncryptionMetadata, error) {
+	var s EncryptionMetadata
+	err := r.ExtractInto(&s)
+	return &s, err
+}
@@ -2,6 +2,8 @@ package testing
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
@@ -538,3 +540,48 @@ func TestResetStatus(t *testing.T) {
 	err := volumes.ResetStatus(context.TODO(), client.ServiceClient(), "cd281d77-8217-4830-be95-9528227c105c", options).ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestGetEncryptionMetadata(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockGetEncryptionMetadataResponse(t)
+
+	expected := volumes.EncryptionMetadata{
+		EncryptionKeyID: "6d6c1c8b-5c0d-4c8a-9a52-2d7dc5c2d2e1",
+		Provider:        "luks",
+		Cipher:          "aes-xts-plain64",
+		KeySize:         256,
+		ControlLocation: "front-end",
+	}
+
+	actual, err := volumes.GetEncryptionMetadata(context.TODO(), client.ServiceClient(), "d32019d3-bc6e-4319-9c1d-6722fc136a22").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/volumes/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, `
+{
+	"volumes": [
+		{"id": "2bc7a2a9-7c2a-4a8c-9f0e-5d4b7a2b1c01", "name": "volume-1"},
+		{"id": "7f3c8e1d-51b4-4a8e-8a1e-3d9c0e6f4b02", "name": "volume-2"},
+		{"id": "c4d1f0e2-9b7a-4f6c-8d3e-1a2b5c6d7e03", "name": "volume-2"}
+	]
+}`)
+	})
+
+	id, err := volumes.IDFromName(context.TODO(), client.ServiceClient(), "volume-1")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2bc7a2a9-7c2a-4a8c-9f0e-5d4b7a2b1c01", id)
+}
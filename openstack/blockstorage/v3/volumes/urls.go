@@ -25,3 +25,7 @@ func updateURL(c *gophercloud.ServiceClient, id string) string {
 func actionURL(c *gophercloud.ServiceClient, id string) string {
 	return c.ServiceURL("volumes", id, "action")
 }
+
+func encryptionURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("volumes", id, "encryption")
+}
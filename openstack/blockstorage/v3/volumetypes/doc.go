@@ -216,5 +216,20 @@ Example to Show an Encryption Spec of a Volume Type
 		panic(err)
 	}
 	fmt.Println(volumeType)
+
+Example to List Volume Types with their Encryption Specs
+
+	allTypes, err := volumetypes.ListWithEncryption(context.TODO(), client, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, volumeType := range allTypes {
+		if volumeType.Encryption == nil {
+			fmt.Printf("%s is not encrypted\n", volumeType.Name)
+			continue
+		}
+		fmt.Printf("%s uses %s\n", volumeType.Name, volumeType.Encryption.Cipher)
+	}
 */
 package volumetypes
@@ -297,3 +297,13 @@ func (r encryptionShowSpecResult) Extract() (map[string]any, error) {
 type GetEncryptionSpecResult struct {
 	encryptionShowSpecResult
 }
+
+// VolumeTypeEncryption is a volume type along with its encryption specs, as
+// returned by ListWithEncryption.
+type VolumeTypeEncryption struct {
+	VolumeType
+
+	// Encryption holds the encryption specs of the volume type. It is nil
+	// if the volume type is not encrypted.
+	Encryption *GetEncryptionType
+}
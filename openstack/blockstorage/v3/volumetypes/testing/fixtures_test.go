@@ -377,3 +377,40 @@ func MockEncryptionGetSpecResponse(t *testing.T) {
     `)
 	})
 }
+
+func MockListWithEncryptionResponse(t *testing.T) {
+	MockListResponse(t)
+
+	th.Mux.HandleFunc("/types/6685584b-1eac-4da6-b5c3-555430cf68ff/encryption", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, `
+{
+    "volume_type_id": "6685584b-1eac-4da6-b5c3-555430cf68ff",
+    "control_location": "front-end",
+    "deleted": false,
+    "created_at": "2016-12-28T02:32:25.000000",
+    "updated_at": null,
+    "encryption_id": "81e069c6-7394-4856-8df7-3b237ca61f74",
+    "key_size": 256,
+    "provider": "luks",
+    "deleted_at": null,
+    "cipher": "aes-xts-plain64"
+}
+    `)
+	})
+
+	th.Mux.HandleFunc("/types/8eb69a46-df97-4e41-9586-9a40a7533803/encryption", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, `{}`)
+	})
+}
@@ -373,3 +373,29 @@ func TestGetEncryptionSpec(t *testing.T) {
 		t.Fatalf("Key %s does not exist in map.", key)
 	}
 }
+
+func TestListWithEncryption(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockListWithEncryptionResponse(t)
+
+	actual, err := volumetypes.ListWithEncryption(context.TODO(), client.ServiceClient(), nil)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(actual))
+	th.AssertEquals(t, "SSD", actual[0].Name)
+	th.AssertDeepEquals(t, &volumetypes.GetEncryptionType{
+		VolumeTypeID:    "6685584b-1eac-4da6-b5c3-555430cf68ff",
+		ControlLocation: "front-end",
+		CreatedAt:       "2016-12-28T02:32:25.000000",
+		EncryptionID:    "81e069c6-7394-4856-8df7-3b237ca61f74",
+		KeySize:         256,
+		Provider:        "luks",
+		Cipher:          "aes-xts-plain64",
+	}, actual[0].Encryption)
+	th.AssertEquals(t, "SATA", actual[1].Name)
+	if actual[1].Encryption != nil {
+		t.Fatalf("Expected no encryption for SATA, got %+v", actual[1].Encryption)
+	}
+}
@@ -0,0 +1,63 @@
+package volumetypes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// encryptionConcurrency is the maximum number of encryption specs fetched in
+// parallel by ListWithEncryption.
+const encryptionConcurrency = 8
+
+// ListWithEncryption lists all the volume types matching opts and fetches the
+// encryption specs of each of them concurrently. The result preserves the
+// order of the listing.
+func ListWithEncryption(ctx context.Context, client *gophercloud.ServiceClient, opts ListOptsBuilder) ([]VolumeTypeEncryption, error) {
+	allPages, err := List(client, opts).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allTypes, err := ExtractVolumeTypes(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]VolumeTypeEncryption, len(allTypes))
+	errs := make([]error, len(allTypes))
+	sem := make(chan struct{}, encryptionConcurrency)
+
+	var wg sync.WaitGroup
+	for i, vt := range allTypes {
+		result[i].VolumeType = vt
+
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			encryption, err := GetEncryption(ctx, client, id).Extract()
+			if err != nil {
+				errs[i] = err
+				return
+			}
+
+			// Volume types without encryption return an empty object.
+			if encryption.EncryptionID != "" {
+				result[i].Encryption = encryption
+			}
+		}(i, vt.ID)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
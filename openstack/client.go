@@ -2,13 +2,17 @@ package openstack
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
+	"net/url"
 	"reflect"
 	"strings"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
 	tokens2 "github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v2/tokens"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/ec2tokens"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/federation"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/oauth1"
 	tokens3 "github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/tokens"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils"
@@ -53,6 +57,38 @@ func NewClient(endpoint string) (*gophercloud.ProviderClient, error) {
 	return p, nil
 }
 
+// NewFailoverClient prepares an unauthenticated ProviderClient instance which
+// fails over between the given identity endpoints, in order of preference.
+// The first endpoint is used as IdentityEndpoint until authentication selects
+// another one.
+//
+// Example:
+//
+//	provider, err := openstack.NewFailoverClient(
+//		"https://keystone.region-one.example.com:5000/",
+//		"https://keystone.region-two.example.com:5000/",
+//	)
+//	err = openstack.Authenticate(ctx, provider, ao)
+func NewFailoverClient(endpoints ...string) (*gophercloud.ProviderClient, error) {
+	if len(endpoints) == 0 {
+		return nil, gophercloud.ErrMissingInput{Argument: "endpoints"}
+	}
+
+	for _, endpoint := range endpoints {
+		if _, err := utils.BaseEndpoint(endpoint); err != nil {
+			return nil, err
+		}
+	}
+
+	p, err := NewClient(endpoints[0])
+	if err != nil {
+		return nil, err
+	}
+	p.IdentityFailover = gophercloud.NewIdentityFailover(endpoints...)
+
+	return p, nil
+}
+
 // AuthenticatedClient logs in to an OpenStack cloud found at the identity endpoint
 // specified by the options, acquires a token, and returns a Provider Client
 // instance that's ready to operate.
@@ -86,7 +122,18 @@ func AuthenticatedClient(ctx context.Context, options gophercloud.AuthOptions) (
 
 // Authenticate authenticates or re-authenticates against the most
 // recent identity service supported at the provided endpoint.
+//
+// If the client has an IdentityFailover, its endpoints are tried in turn
+// until one of them is reachable.
 func Authenticate(ctx context.Context, client *gophercloud.ProviderClient, options gophercloud.AuthOptions) error {
+	if client.IdentityFailover != nil {
+		return failoverAuth(ctx, client, options)
+	}
+
+	return authenticate(ctx, client, options)
+}
+
+func authenticate(ctx context.Context, client *gophercloud.ProviderClient, options gophercloud.AuthOptions) error {
 	versions := []*utils.Version{
 		{ID: v2, Priority: 20, Suffix: "/v2.0/"},
 		{ID: v3, Priority: 30, Suffix: "/v3/"},
@@ -108,6 +155,81 @@ func Authenticate(ctx context.Context, client *gophercloud.ProviderClient, optio
 	}
 }
 
+func failoverAuth(ctx context.Context, client *gophercloud.ProviderClient, options gophercloud.AuthOptions) error {
+	failover := client.IdentityFailover
+
+	var errs []error
+	authenticated := false
+	for _, endpoint := range failover.Endpoints() {
+		base, err := utils.BaseEndpoint(endpoint)
+		if err != nil {
+			return err
+		}
+		client.IdentityBase = gophercloud.NormalizeURL(base)
+		client.IdentityEndpoint = endpoint
+
+		err = authenticate(ctx, client, options)
+		if err == nil {
+			failover.MarkHealthy(endpoint)
+			authenticated = true
+			break
+		}
+		if !isUnreachable(ctx, err) {
+			return err
+		}
+		failover.MarkUnhealthy(endpoint)
+		errs = append(errs, err)
+	}
+	if !authenticated {
+		return ErrIdentityUnreachable{Errors: errs}
+	}
+
+	if options.AllowReauth {
+		// re-authentication goes through the failover endpoints as well,
+		// using a throw-away client as in v2auth and v3auth
+		tac := *client
+		tac.SetThrowaway(true)
+		tac.ReauthFunc = nil
+		err := tac.SetTokenAndAuthResult(nil)
+		if err != nil {
+			return err
+		}
+		tao := options
+		tao.AllowReauth = false
+		client.ReauthFunc = func(ctx context.Context) error {
+			err := failoverAuth(ctx, &tac, tao)
+			if err != nil {
+				return err
+			}
+			client.CopyTokenFrom(&tac)
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// isUnreachable reports whether err means that an identity endpoint could
+// not be reached or is unavailable, so that another endpoint should be tried.
+func isUnreachable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var respErr gophercloud.ErrUnexpectedResponseCode
+	if errors.As(err, &respErr) {
+		return respErr.Actual >= 500
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
 // AuthenticateV2 explicitly authenticates against the identity v2 endpoint.
 func AuthenticateV2(ctx context.Context, client *gophercloud.ProviderClient, options tokens2.AuthOptionsBuilder, eo gophercloud.EndpointOpts) error {
 	return v2auth(ctx, client, "", options, eo)
@@ -221,11 +343,13 @@ func v3auth(ctx context.Context, client *gophercloud.ProviderClient, endpoint st
 		}
 	} else {
 		var result tokens3.CreateResult
-		switch opts.(type) {
+		switch v := opts.(type) {
 		case *ec2tokens.AuthOptions:
 			result = ec2tokens.Create(ctx, v3Client, opts)
 		case *oauth1.AuthOptions:
 			result = oauth1.Create(ctx, v3Client, opts)
+		case *federation.AuthOptions:
+			result = federation.Create(ctx, v3Client, v)
 		default:
 			result = tokens3.Create(ctx, v3Client, opts)
 		}
@@ -270,6 +394,10 @@ func v3auth(ctx context.Context, client *gophercloud.ProviderClient, endpoint st
 			o := *ot
 			o.AllowReauth = false
 			tao = &o
+		case *federation.AuthOptions:
+			o := *ot
+			o.AllowReauth = false
+			tao = &o
 		default:
 			tao = opts
 		}
@@ -486,6 +614,14 @@ func NewWorkflowV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOp
 	return initClientOpts(client, eo, "workflowv2")
 }
 
+// NewAlarmingV2 creates a ServiceClient that may be used with the v2 alarming
+// package.
+func NewAlarmingV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
+	sc, err := initClientOpts(client, eo, "alarming")
+	sc.ResourceBase = sc.Endpoint + "v2/"
+	return sc, err
+}
+
 // NewPlacementV1 creates a ServiceClient that may be used with the placement package.
 func NewPlacementV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	return initClientOpts(client, eo, "placement")
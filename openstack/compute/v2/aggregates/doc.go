@@ -91,7 +91,7 @@ Example of Remove Host
 Example of Create or Update Metadata
 
 	aggregateID := 22
-	opts := aggregates.SetMetadata{
+	opts := aggregates.SetMetadataOpts{
 		Metadata: map[string]string{"key": "value"},
 	}
 
@@ -100,5 +100,19 @@ Example of Create or Update Metadata
 		panic(err)
 	}
 	fmt.Printf("%+v\n", aggregate)
+
+Example of Pre-caching Images on the Hosts of an Aggregate
+
+	computeClient.Microversion = "2.81"
+
+	aggregateID := 22
+	opts := aggregates.CacheImagesOpts{
+		ImageIDs: []string{"70a599e0-31e7-49b7-b260-868f441e862b"},
+	}
+
+	err := aggregates.CacheImages(context.TODO(), computeClient, aggregateID, opts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
 */
 package aggregates
@@ -168,3 +168,48 @@ func SetMetadata(ctx context.Context, client *gophercloud.ServiceClient, aggrega
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// CacheImagesOptsBuilder allows extensions to add additional parameters to
+// the CacheImages request.
+type CacheImagesOptsBuilder interface {
+	ToAggregatesCacheImagesMap() (map[string]any, error)
+}
+
+// CacheImagesOpts specifies the images to pre-cache on the hosts of an
+// aggregate.
+type CacheImagesOpts struct {
+	// ImageIDs are the IDs of the images to cache.
+	ImageIDs []string
+}
+
+// ToAggregatesCacheImagesMap builds a request body from CacheImagesOpts.
+func (opts CacheImagesOpts) ToAggregatesCacheImagesMap() (map[string]any, error) {
+	if len(opts.ImageIDs) == 0 {
+		return nil, gophercloud.ErrMissingInput{Argument: "aggregates.CacheImagesOpts.ImageIDs"}
+	}
+
+	cache := make([]map[string]string, len(opts.ImageIDs))
+	for i, id := range opts.ImageIDs {
+		cache[i] = map[string]string{"id": id}
+	}
+
+	return map[string]any{"cache": cache}, nil
+}
+
+// CacheImages makes a request against the API to pre-cache images on the
+// hosts of a specific aggregate. The caching is done asynchronously.
+// This requires microversion 2.81 or later.
+func CacheImages(ctx context.Context, client *gophercloud.ServiceClient, aggregateID int, opts CacheImagesOptsBuilder) (r CacheImagesResult) {
+	v := strconv.Itoa(aggregateID)
+
+	b, err := opts.ToAggregatesCacheImagesMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, aggregatesCacheImagesURL(client, v), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
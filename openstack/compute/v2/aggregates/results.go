@@ -119,3 +119,9 @@ type UpdateResult struct {
 type ActionResult struct {
 	aggregatesResult
 }
+
+// CacheImagesResult is the response from a CacheImages operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type CacheImagesResult struct {
+	gophercloud.ErrResult
+}
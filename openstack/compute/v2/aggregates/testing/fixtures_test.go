@@ -342,3 +342,14 @@ func HandleSetMetadataSuccessfully(t *testing.T) {
 		fmt.Fprint(w, AggregateSetMetadataBody)
 	})
 }
+
+func HandleCacheImagesSuccessfully(t *testing.T) {
+	v := strconv.Itoa(AggregateIDtoGet)
+	th.Mux.HandleFunc("/os-aggregates/"+v+"/images", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"cache": [{"id": "70a599e0-31e7-49b7-b260-868f441e862b"}, {"id": "155d900f-4e14-4e4c-a73d-069cbf4541e6"}]}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/aggregates"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
@@ -148,3 +149,21 @@ func TestSetMetadataAggregate(t *testing.T) {
 
 	th.AssertDeepEquals(t, &expected, actual)
 }
+
+func TestCacheImagesAggregate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCacheImagesSuccessfully(t)
+
+	opts := aggregates.CacheImagesOpts{
+		ImageIDs: []string{"70a599e0-31e7-49b7-b260-868f441e862b", "155d900f-4e14-4e4c-a73d-069cbf4541e6"},
+	}
+
+	err := aggregates.CacheImages(context.TODO(), client.ServiceClient(), AggregateIDtoGet, opts).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	err = aggregates.CacheImages(context.TODO(), client.ServiceClient(), AggregateIDtoGet, aggregates.CacheImagesOpts{}).ExtractErr()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("Expected ErrMissingInput but got %v", err)
+	}
+}
@@ -33,3 +33,7 @@ func aggregatesRemoveHostURL(c *gophercloud.ServiceClient, aggregateID string) s
 func aggregatesSetMetadataURL(c *gophercloud.ServiceClient, aggregateID string) string {
 	return c.ServiceURL("os-aggregates", aggregateID, "action")
 }
+
+func aggregatesCacheImagesURL(c *gophercloud.ServiceClient, aggregateID string) string {
+	return c.ServiceURL("os-aggregates", aggregateID, "images")
+}
@@ -0,0 +1,36 @@
+/*
+Package assistedvolumesnapshots provides the ability to create and delete
+snapshots of volumes attached to servers with the help of the Compute service.
+
+This API is meant to be used by the Block Storage service for volume drivers
+relying on files, such as NFS, and requires administrative privileges.
+
+Example to Create an Assisted Volume Snapshot
+
+	createOpts := assistedvolumesnapshots.CreateOpts{
+		VolumeID: "521752a6-acf6-4b2d-bc7a-119f9148cd8c",
+		CreateInfo: assistedvolumesnapshots.CreateInfo{
+			SnapshotID: "421752a6-acf6-4b2d-bc7a-119f9148cd8c",
+			Type:       "qcow2",
+			NewFile:    "new_file_name",
+		},
+	}
+
+	snapshot, err := assistedvolumesnapshots.Create(context.TODO(), computeClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Assisted Volume Snapshot
+
+	deleteOpts := assistedvolumesnapshots.DeleteOpts{
+		VolumeID: "521752a6-acf6-4b2d-bc7a-119f9148cd8c",
+	}
+
+	snapshotID := "421752a6-acf6-4b2d-bc7a-119f9148cd8c"
+	err := assistedvolumesnapshots.Delete(context.TODO(), computeClient, snapshotID, deleteOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package assistedvolumesnapshots
@@ -0,0 +1,120 @@
+package assistedvolumesnapshots
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// CreateOptsBuilder allows extensions to add parameters to the Create request.
+type CreateOptsBuilder interface {
+	ToAssistedVolumeSnapshotCreateMap() (map[string]any, error)
+}
+
+// CreateInfo describes the snapshot file created by the Block Storage
+// service.
+type CreateInfo struct {
+	// SnapshotID is the ID of the volume snapshot.
+	SnapshotID string `json:"snapshot_id" required:"true"`
+
+	// Type is the format of the snapshot file. Only "qcow2" is supported.
+	Type string `json:"type" required:"true"`
+
+	// NewFile is the name of the file the snapshot is written to.
+	NewFile string `json:"new_file" required:"true"`
+
+	// ID is an optional identifier of the snapshot in the volume driver.
+	ID string `json:"id,omitempty"`
+}
+
+// CreateOpts specifies assisted volume snapshot creation parameters.
+type CreateOpts struct {
+	// VolumeID is the ID of the attached volume to snapshot.
+	VolumeID string `json:"volume_id" required:"true"`
+
+	// CreateInfo describes the snapshot file.
+	CreateInfo CreateInfo `json:"create_info" required:"true"`
+}
+
+// ToAssistedVolumeSnapshotCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToAssistedVolumeSnapshotCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "snapshot")
+}
+
+// Create requests the creation of a snapshot of an attached volume by the
+// Compute service.
+func Create(ctx context.Context, client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToAssistedVolumeSnapshotCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteOptsBuilder allows extensions to add parameters to the Delete request.
+type DeleteOptsBuilder interface {
+	ToAssistedVolumeSnapshotDeleteQuery() (string, error)
+}
+
+// DeleteOpts specifies assisted volume snapshot deletion parameters. They
+// are sent JSON encoded in the delete_info query parameter.
+type DeleteOpts struct {
+	// VolumeID is the ID of the attached volume the snapshot belongs to.
+	VolumeID string `json:"volume_id" required:"true"`
+
+	// Type is the format of the snapshot file. Only "qcow2" is supported,
+	// which is the default.
+	Type string `json:"type"`
+
+	// FileToMerge is the name of the snapshot file to merge.
+	FileToMerge string `json:"file_to_merge,omitempty"`
+
+	// MergeTargetFile is the name of the file the snapshot is merged into.
+	// It is sent as null when not set, to merge the snapshot into the active
+	// image.
+	MergeTargetFile *string `json:"merge_target_file"`
+}
+
+// ToAssistedVolumeSnapshotDeleteQuery formats a DeleteOpts into a query
+// string.
+func (opts DeleteOpts) ToAssistedVolumeSnapshotDeleteQuery() (string, error) {
+	if opts.Type == "" {
+		opts.Type = "qcow2"
+	}
+
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", err
+	}
+
+	info, err := json.Marshal(b)
+	if err != nil {
+		return "", err
+	}
+
+	q := url.Values{}
+	q.Set("delete_info", string(info))
+	return "?" + q.Encode(), nil
+}
+
+// Delete requests the deletion of a snapshot of an attached volume by the
+// Compute service.
+func Delete(ctx context.Context, client *gophercloud.ServiceClient, snapshotID string, opts DeleteOptsBuilder) (r DeleteResult) {
+	url := deleteURL(client, snapshotID)
+	query, err := opts.ToAssistedVolumeSnapshotDeleteQuery()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	url += query
+	resp, err := client.Delete(ctx, url, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,33 @@
+package assistedvolumesnapshots
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+// Snapshot is an assisted volume snapshot.
+type Snapshot struct {
+	// ID is the ID of the volume snapshot.
+	ID string `json:"id"`
+
+	// VolumeID is the ID of the snapshotted volume.
+	VolumeID string `json:"volumeId"`
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a Snapshot.
+type CreateResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a CreateResult as a Snapshot.
+func (r CreateResult) Extract() (*Snapshot, error) {
+	var s struct {
+		Snapshot *Snapshot `json:"snapshot"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Snapshot, err
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,2 @@
+// assistedvolumesnapshots unit tests
+package testing
@@ -0,0 +1,75 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+// CreateRequest is a sample request to create an assisted volume snapshot.
+const CreateRequest = `
+{
+  "snapshot": {
+    "volume_id": "521752a6-acf6-4b2d-bc7a-119f9148cd8c",
+    "create_info": {
+      "snapshot_id": "421752a6-acf6-4b2d-bc7a-119f9148cd8c",
+      "type": "qcow2",
+      "new_file": "new_file_name"
+    }
+  }
+}
+`
+
+// CreateOutput is a sample response to a Create call.
+const CreateOutput = `
+{
+  "snapshot": {
+    "id": "421752a6-acf6-4b2d-bc7a-119f9148cd8c",
+    "volumeId": "521752a6-acf6-4b2d-bc7a-119f9148cd8c"
+  }
+}
+`
+
+// HandleCreateSuccessfully configures the test server to respond to a Create
+// request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-assisted-volume-snapshots", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, CreateOutput)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete
+// request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-assisted-volume-snapshots/421752a6-acf6-4b2d-bc7a-119f9148cd8c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"delete_info": `{"merge_target_file":null,"type":"qcow2","volume_id":"521752a6-acf6-4b2d-bc7a-119f9148cd8c"}`,
+		})
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleDeleteMergeSuccessfully configures the test server to respond to a
+// Delete request merging the snapshot into a target file.
+func HandleDeleteMergeSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-assisted-volume-snapshots/421752a6-acf6-4b2d-bc7a-119f9148cd8c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"delete_info": `{"file_to_merge":"volume-521752a6.421752a6","merge_target_file":"volume-521752a6","type":"qcow2","volume_id":"521752a6-acf6-4b2d-bc7a-119f9148cd8c"}`,
+		})
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,74 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/assistedvolumesnapshots"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := assistedvolumesnapshots.CreateOpts{
+		VolumeID: "521752a6-acf6-4b2d-bc7a-119f9148cd8c",
+		CreateInfo: assistedvolumesnapshots.CreateInfo{
+			SnapshotID: "421752a6-acf6-4b2d-bc7a-119f9148cd8c",
+			Type:       "qcow2",
+			NewFile:    "new_file_name",
+		},
+	}
+
+	actual, err := assistedvolumesnapshots.Create(context.TODO(), client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := &assistedvolumesnapshots.Snapshot{
+		ID:       "421752a6-acf6-4b2d-bc7a-119f9148cd8c",
+		VolumeID: "521752a6-acf6-4b2d-bc7a-119f9148cd8c",
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestCreateMissingCreateInfo(t *testing.T) {
+	createOpts := assistedvolumesnapshots.CreateOpts{
+		VolumeID: "521752a6-acf6-4b2d-bc7a-119f9148cd8c",
+	}
+
+	_, err := createOpts.ToAssistedVolumeSnapshotCreateMap()
+	if err == nil {
+		t.Fatal("expected an error for a missing create_info")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	deleteOpts := assistedvolumesnapshots.DeleteOpts{
+		VolumeID: "521752a6-acf6-4b2d-bc7a-119f9148cd8c",
+	}
+
+	err := assistedvolumesnapshots.Delete(context.TODO(), client.ServiceClient(), "421752a6-acf6-4b2d-bc7a-119f9148cd8c", deleteOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestDeleteMerge(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteMergeSuccessfully(t)
+
+	mergeTargetFile := "volume-521752a6"
+	deleteOpts := assistedvolumesnapshots.DeleteOpts{
+		VolumeID:        "521752a6-acf6-4b2d-bc7a-119f9148cd8c",
+		FileToMerge:     "volume-521752a6.421752a6",
+		MergeTargetFile: &mergeTargetFile,
+	}
+
+	err := assistedvolumesnapshots.Delete(context.TODO(), client.ServiceClient(), "421752a6-acf6-4b2d-bc7a-119f9148cd8c", deleteOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -47,6 +47,10 @@ type CreateOpts struct {
 	// NetworkID, the request returns a Bad Request (400) response code.
 	// Note: this uses the FixedIP struct, but only the IPAddress field can be used.
 	FixedIPs []FixedIP `json:"fixed_ips,omitempty"`
+
+	// Tag is a device role tag that can be applied to the interface.
+	// Requires microversion 2.49 or later.
+	Tag string `json:"tag,omitempty"`
 }
 
 // ToAttachInterfacesCreateMap constructs a request body from CreateOpts.
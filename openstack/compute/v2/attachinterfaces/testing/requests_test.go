@@ -2,6 +2,8 @@ package testing
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/attachinterfaces"
@@ -88,3 +90,37 @@ func TestDeleteInterface(t *testing.T) {
 	err := attachinterfaces.Delete(context.TODO(), client.ServiceClient(), serverID, portID).ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestCreateInterfaceWithTag(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/b07e7a3b-d951-4efc-a4f9-ac9f001afb7f/os-interface", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{
+			"interfaceAttachment": {
+				"net_id": "8a5fe506-7e9f-4091-899b-96336909d93c",
+				"fixed_ips": [
+					{
+						"ip_address": "10.0.0.7"
+					}
+				],
+				"tag": "data"
+			}
+		}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"interfaceAttachment": {"port_id": "0dde1598-b374-474e-986f-5b8dd1df1d4e"}}`)
+	})
+
+	actual, err := attachinterfaces.Create(context.TODO(), client.ServiceClient(), "b07e7a3b-d951-4efc-a4f9-ac9f001afb7f", attachinterfaces.CreateOpts{
+		NetworkID: "8a5fe506-7e9f-4091-899b-96336909d93c",
+		FixedIPs: []attachinterfaces.FixedIP{
+			{IPAddress: "10.0.0.7"},
+		},
+		Tag: "data",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "0dde1598-b374-474e-986f-5b8dd1df1d4e", actual.PortID)
+}
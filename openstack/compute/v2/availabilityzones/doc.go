@@ -34,5 +34,12 @@ Example of Get Detailed Availability Zone Information
 		for _, zoneInfo := range availabilityZoneInfo {
 	  		fmt.Printf("%+v\n", zoneInfo)
 		}
+
+Example of Finding Compute Hosts in Each Availability Zone
+
+	for _, zoneInfo := range availabilityZoneInfo {
+		hosts := zoneInfo.HostsWithService("nova-compute")
+		fmt.Printf("%s: %v\n", zoneInfo.ZoneName, hosts)
+	}
 */
 package availabilityzones
@@ -2,6 +2,7 @@ package availabilityzones
 
 import (
 	"encoding/json"
+	"sort"
 	"time"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
@@ -55,6 +56,20 @@ type AvailabilityZone struct {
 	ZoneState ZoneState `json:"zoneState"`
 }
 
+// HostsWithService returns the sorted names of the hosts in the zone on which
+// the given service (for example "nova-compute") is both active and
+// available. Hosts are only populated by ListDetail.
+func (r AvailabilityZone) HostsWithService(service string) []string {
+	var hosts []string
+	for host, services := range r.Hosts {
+		if state, ok := services[service]; ok && state.Active && state.Available {
+			hosts = append(hosts, host)
+		}
+	}
+	sort.Strings(hosts)
+	return hosts
+}
+
 type AvailabilityZonePage struct {
 	pagination.SinglePageBase
 }
@@ -40,3 +40,11 @@ func TestListDetail(t *testing.T) {
 
 	th.CheckDeepEquals(t, AZDetailResult, actual)
 }
+
+// Verifies that hosts running an available service can be looked up
+func TestHostsWithService(t *testing.T) {
+	th.CheckDeepEquals(t, []string{"openstack-acc-tests.novalocal"}, AZDetailResult[0].HostsWithService("nova-scheduler"))
+	th.CheckDeepEquals(t, []string{"openstack-acc-tests.novalocal"}, AZDetailResult[1].HostsWithService("nova-compute"))
+	th.AssertEquals(t, 0, len(AZDetailResult[0].HostsWithService("nova-compute")))
+	th.AssertEquals(t, 0, len(AZResult[0].HostsWithService("nova-compute")))
+}
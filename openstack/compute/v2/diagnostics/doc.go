@@ -9,5 +9,18 @@ Example of Show Diagnostics
 	}
 
 	fmt.Printf("%+v\n", diags)
+
+Example of Show Standardized Diagnostics
+
+	computeClient.Microversion = "2.48"
+
+	diags, err := diagnostics.Get(context.TODO(), computeClient, serverId).ExtractDiagnostics()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, nic := range diags.NICDetails {
+		fmt.Printf("%s: %d bytes received\n", nic.MACAddress, nic.RxOctets)
+	}
 */
 package diagnostics
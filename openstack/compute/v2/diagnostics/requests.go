@@ -6,8 +6,10 @@ import (
 	"github.com/vnpaycloud-console/gophercloud/v2"
 )
 
-// Diagnostics
-func Get(ctx context.Context, client *gophercloud.ServiceClient, serverId string) (r serverDiagnosticsResult) {
+// Get retrieves the diagnostics of a server. Use Extract to read them as a raw
+// map, or ExtractDiagnostics with microversion 2.48 or later to read them as
+// Diagnostics.
+func Get(ctx context.Context, client *gophercloud.ServiceClient, serverId string) (r GetResult) {
 	resp, err := client.Get(ctx, serverDiagnosticsURL(client, serverId), &r.Body, nil)
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
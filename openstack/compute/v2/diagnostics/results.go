@@ -4,13 +4,109 @@ import (
 	"github.com/vnpaycloud-console/gophercloud/v2"
 )
 
-type serverDiagnosticsResult struct {
+// GetResult is the response of a Get operation. Call its Extract method to
+// interpret it as a raw map, or its ExtractDiagnostics method to interpret it
+// as Diagnostics.
+type GetResult struct {
 	gophercloud.Result
 }
 
 // Extract interprets any diagnostic response as a map
-func (r serverDiagnosticsResult) Extract() (map[string]any, error) {
+func (r GetResult) Extract() (map[string]any, error) {
 	var s map[string]any
 	err := r.ExtractInto(&s)
 	return s, err
 }
+
+// ExtractDiagnostics interprets a diagnostic response as Diagnostics. The
+// response is only standardized starting with microversion 2.48; older
+// responses are hypervisor specific and should be read with Extract instead.
+func (r GetResult) ExtractDiagnostics() (*Diagnostics, error) {
+	var s Diagnostics
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// Diagnostics represents the standardized diagnostics of a server.
+type Diagnostics struct {
+	// State is the power state of the server, e.g. "running".
+	State string `json:"state"`
+
+	// Driver is the name of the virtualization driver, e.g. "libvirt".
+	Driver string `json:"driver"`
+
+	// Hypervisor is the type of the hypervisor, e.g. "kvm".
+	Hypervisor string `json:"hypervisor"`
+
+	// HypervisorOS is the operating system of the hypervisor.
+	HypervisorOS string `json:"hypervisor_os"`
+
+	// Uptime is the number of seconds since the server was started.
+	Uptime int `json:"uptime"`
+
+	// ConfigDrive indicates whether the server has a config drive.
+	ConfigDrive bool `json:"config_drive"`
+
+	// NumCPUs is the number of vCPUs of the server.
+	NumCPUs int `json:"num_cpus"`
+
+	// NumNICs is the number of network interfaces of the server.
+	NumNICs int `json:"num_nics"`
+
+	// NumDisks is the number of disks of the server.
+	NumDisks int `json:"num_disks"`
+
+	// CPUDetails are the diagnostics of each vCPU.
+	CPUDetails []CPUDetails `json:"cpu_details"`
+
+	// NICDetails are the diagnostics of each network interface.
+	NICDetails []NICDetails `json:"nic_details"`
+
+	// DiskDetails are the diagnostics of each disk.
+	DiskDetails []DiskDetails `json:"disk_details"`
+
+	// MemoryDetails are the memory diagnostics.
+	MemoryDetails MemoryDetails `json:"memory_details"`
+}
+
+// CPUDetails represents the diagnostics of a vCPU.
+type CPUDetails struct {
+	// ID is the index of the vCPU.
+	ID int `json:"id"`
+
+	// Time is the CPU time used by the vCPU, in nanoseconds.
+	Time int64 `json:"time"`
+
+	// Utilisation is the CPU utilisation in percent, if known.
+	Utilisation *int `json:"utilisation"`
+}
+
+// NICDetails represents the diagnostics of a network interface.
+type NICDetails struct {
+	MACAddress string `json:"mac_address"`
+	RxOctets   int64  `json:"rx_octets"`
+	RxErrors   int64  `json:"rx_errors"`
+	RxDrop     int64  `json:"rx_drop"`
+	RxPackets  int64  `json:"rx_packets"`
+	RxRate     *int64 `json:"rx_rate"`
+	TxOctets   int64  `json:"tx_octets"`
+	TxErrors   int64  `json:"tx_errors"`
+	TxDrop     int64  `json:"tx_drop"`
+	TxPackets  int64  `json:"tx_packets"`
+	TxRate     *int64 `json:"tx_rate"`
+}
+
+// DiskDetails represents the diagnostics of a disk.
+type DiskDetails struct {
+	ReadBytes     int64 `json:"read_bytes"`
+	ReadRequests  int64 `json:"read_requests"`
+	WriteBytes    int64 `json:"write_bytes"`
+	WriteRequests int64 `json:"write_requests"`
+	ErrorsCount   int64 `json:"errors_count"`
+}
+
+// MemoryDetails represents the memory diagnostics of a server, in MiB.
+type MemoryDetails struct {
+	Maximum int `json:"maximum"`
+	Used    int `json:"used"`
+}
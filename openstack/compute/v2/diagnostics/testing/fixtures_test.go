@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -20,3 +21,66 @@ func HandleDiagnosticGetSuccessfully(t *testing.T) {
 		th.AssertNoErr(t, err)
 	})
 }
+
+// DiagnosticsBody is the standardized diagnostics returned with microversion
+// 2.48 or later.
+const DiagnosticsBody = `
+{
+    "state": "running",
+    "driver": "libvirt",
+    "hypervisor": "kvm",
+    "hypervisor_os": "ubuntu",
+    "uptime": 46664,
+    "config_drive": true,
+    "num_cpus": 1,
+    "num_nics": 1,
+    "num_disks": 1,
+    "disk_details": [
+        {
+            "read_bytes": 262144,
+            "read_requests": 112,
+            "write_bytes": 5778432,
+            "write_requests": 488,
+            "errors_count": 1
+        }
+    ],
+    "cpu_details": [
+        {
+            "id": 0,
+            "time": 17300000000,
+            "utilisation": 15
+        }
+    ],
+    "nic_details": [
+        {
+            "mac_address": "01:23:45:67:89:ab",
+            "rx_octets": 2070139,
+            "rx_errors": 100,
+            "rx_drop": 200,
+            "rx_packets": 26701,
+            "rx_rate": 300,
+            "tx_octets": 140208,
+            "tx_errors": 400,
+            "tx_drop": 500,
+            "tx_packets": 662,
+            "tx_rate": null
+        }
+    ],
+    "memory_details": {
+        "maximum": 524288,
+        "used": 0
+    }
+}
+`
+
+// HandleDiagnosticsGetSuccessfully sets up the test server to respond to a
+// diagnostic Get request with standardized diagnostics.
+func HandleDiagnosticsGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/5678qwer/diagnostics", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, DiagnosticsBody)
+	})
+}
@@ -22,3 +22,57 @@ func TestGetDiagnostics(t *testing.T) {
 
 	th.AssertDeepEquals(t, expected, res)
 }
+
+func TestGetStandardizedDiagnostics(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleDiagnosticsGetSuccessfully(t)
+
+	utilisation := 15
+	rxRate := int64(300)
+	expected := diagnostics.Diagnostics{
+		State:        "running",
+		Driver:       "libvirt",
+		Hypervisor:   "kvm",
+		HypervisorOS: "ubuntu",
+		Uptime:       46664,
+		ConfigDrive:  true,
+		NumCPUs:      1,
+		NumNICs:      1,
+		NumDisks:     1,
+		CPUDetails: []diagnostics.CPUDetails{
+			{ID: 0, Time: 17300000000, Utilisation: &utilisation},
+		},
+		NICDetails: []diagnostics.NICDetails{
+			{
+				MACAddress: "01:23:45:67:89:ab",
+				RxOctets:   2070139,
+				RxErrors:   100,
+				RxDrop:     200,
+				RxPackets:  26701,
+				RxRate:     &rxRate,
+				TxOctets:   140208,
+				TxErrors:   400,
+				TxDrop:     500,
+				TxPackets:  662,
+			},
+		},
+		DiskDetails: []diagnostics.DiskDetails{
+			{ReadBytes: 262144, ReadRequests: 112, WriteBytes: 5778432, WriteRequests: 488, ErrorsCount: 1},
+		},
+		MemoryDetails: diagnostics.MemoryDetails{Maximum: 524288, Used: 0},
+	}
+
+	c := client.ServiceClient()
+	c.Microversion = "2.48"
+	res := diagnostics.Get(context.TODO(), c, "5678qwer")
+
+	actual, err := res.ExtractDiagnostics()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, expected, *actual)
+
+	raw, err := res.Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "libvirt", raw["driver"])
+}
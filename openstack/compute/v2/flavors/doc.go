@@ -146,5 +146,45 @@ Example to Delete an Extra Spec for a Flavor
 	if err != nil {
 		panic(err)
 	}
+
+Example to Create a Private Flavor with Extra Specs and Access Projects
+
+	isPublic := false
+	createOpts := flavors.CreateWithExtrasOpts{
+		CreateOpts: flavors.CreateOpts{
+			Name:        "m1.dedicated",
+			RAM:         4096,
+			VCPUs:       2,
+			Disk:        gophercloud.IntToPointer(20),
+			IsPublic:    &isPublic,
+			Description: "Dedicated CPUs",
+		},
+		ExtraSpecs: flavors.ExtraSpecsOpts{
+			"hw:cpu_policy": "dedicated",
+		},
+		Projects: []string{"15153a0979884b59b0592248ef947921"},
+	}
+
+	// The flavor is deleted if its extra specs or access cannot be set.
+	flavor, err := flavors.CreateWithExtras(context.TODO(), computeClient, createOpts)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Select the Smallest Flavor Matching Requirements
+
+	selectOpts := flavors.SelectOpts{
+		MinVCPUs: 2,
+		MinRAM:   4096,
+		MinDisk:  20,
+		ExtraSpecs: map[string]string{
+			"hw:cpu_policy": "dedicated",
+		},
+	}
+
+	flavor, err := flavors.Select(context.TODO(), computeClient, selectOpts)
+	if err != nil {
+		panic(err)
+	}
 */
 package flavors
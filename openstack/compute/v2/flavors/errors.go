@@ -0,0 +1,46 @@
+package flavors
+
+import (
+	"fmt"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// ErrCreateWithExtras is returned by CreateWithExtras when the flavor was
+// created but its extra specs or access projects could not be set. The
+// flavor is deleted before the error is returned.
+type ErrCreateWithExtras struct {
+	gophercloud.BaseError
+
+	// FlavorID is the ID of the flavor which was created and rolled back.
+	FlavorID string
+
+	// Err is the error which caused the rollback.
+	Err error
+
+	// RollbackErr is the error returned when deleting the flavor, if any.
+	// When set, the flavor still exists.
+	RollbackErr error
+}
+
+func (e ErrCreateWithExtras) Error() string {
+	if e.RollbackErr != nil {
+		return fmt.Sprintf("Unable to set up flavor [%s]: %s; the flavor could not be deleted: %s", e.FlavorID, e.Err, e.RollbackErr)
+	}
+	return fmt.Sprintf("Unable to set up flavor [%s], the flavor was deleted: %s", e.FlavorID, e.Err)
+}
+
+// Unwrap returns the error which caused the rollback.
+func (e ErrCreateWithExtras) Unwrap() error {
+	return e.Err
+}
+
+// ErrNoMatchingFlavor is returned by Select when no flavor satisfies the
+// requirements.
+type ErrNoMatchingFlavor struct {
+	gophercloud.BaseError
+}
+
+func (e ErrNoMatchingFlavor) Error() string {
+	return "No flavor matches the requirements"
+}
@@ -364,3 +364,23 @@ func DeleteExtraSpec(ctx context.Context, client *gophercloud.ServiceClient, fla
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// IDFromName is a convenience function that returns a flavor's ID given its
+// name. Both public and private flavors are searched. It returns a
+// gophercloud.ErrResourceNotFound if no flavor matches the name, and a
+// gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	pages, err := ListDetail(client, ListOpts{AccessType: AllAccess}).AllPages(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	all, err := ExtractFlavors(pages)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.IDFromName(all, name, "flavor", func(r Flavor) (string, string) {
+		return r.Name, r.ID
+	})
+}
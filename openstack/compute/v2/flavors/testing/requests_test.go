@@ -2,11 +2,13 @@ package testing
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/flavors"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
@@ -464,3 +466,191 @@ func TestFlavorExtraSpecDelete(t *testing.T) {
 	res := flavors.DeleteExtraSpec(context.TODO(), fake.ServiceClient(), "1", "hw:cpu_policy")
 	th.AssertNoErr(t, res.Err)
 }
+
+func handleCreateWithExtras(t *testing.T, accessStatus int, deleted *bool) {
+	th.Mux.HandleFunc("/flavors", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+			{
+				"flavor": {
+					"name": "m1.private",
+					"disk": 1,
+					"ram": 512,
+					"vcpus": 1,
+					"os-flavor-access:is_public": false,
+					"description": "foo"
+				}
+			}
+		`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `
+			{
+				"flavor": {
+					"id": "12345678",
+					"name": "m1.private",
+					"disk": 1,
+					"ram": 512,
+					"vcpus": 1,
+					"os-flavor-access:is_public": false,
+					"description": "foo"
+				}
+			}
+		`)
+	})
+
+	th.Mux.HandleFunc("/flavors/12345678/os-extra_specs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `{"extra_specs": {"hw:cpu_policy": "dedicated"}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"extra_specs": {"hw:cpu_policy": "dedicated"}}`)
+	})
+
+	th.Mux.HandleFunc("/flavors/12345678/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `{"addTenantAccess": {"tenant": "2f954bcf047c4ee9b09a37d49ae6db54"}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(accessStatus)
+		fmt.Fprint(w, `{"flavor_access": [{"flavor_id": "12345678", "tenant_id": "2f954bcf047c4ee9b09a37d49ae6db54"}]}`)
+	})
+
+	th.Mux.HandleFunc("/flavors/12345678", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		*deleted = true
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+func createWithExtrasOpts() flavors.CreateWithExtrasOpts {
+	disk := 1
+	isPublic := false
+	return flavors.CreateWithExtrasOpts{
+		CreateOpts: flavors.CreateOpts{
+			Name:        "m1.private",
+			Disk:        &disk,
+			RAM:         512,
+			VCPUs:       1,
+			IsPublic:    &isPublic,
+			Description: "foo",
+		},
+		ExtraSpecs: flavors.ExtraSpecsOpts{"hw:cpu_policy": "dedicated"},
+		Projects:   []string{"2f954bcf047c4ee9b09a37d49ae6db54"},
+	}
+}
+
+func TestCreateWithExtras(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	deleted := false
+	handleCreateWithExtras(t, http.StatusOK, &deleted)
+
+	actual, err := flavors.CreateWithExtras(context.TODO(), fake.ServiceClient(), createWithExtrasOpts())
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "12345678", actual.ID)
+	th.AssertEquals(t, "foo", actual.Description)
+	th.AssertEquals(t, false, deleted)
+}
+
+func TestCreateWithExtrasRollback(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	deleted := false
+	handleCreateWithExtras(t, http.StatusForbidden, &deleted)
+
+	_, err := flavors.CreateWithExtras(context.TODO(), fake.ServiceClient(), createWithExtrasOpts())
+	var createErr flavors.ErrCreateWithExtras
+	if !errors.As(err, &createErr) {
+		t.Fatalf("ErrCreateWithExtras was expected to occur, got %v", err)
+	}
+	th.AssertEquals(t, "12345678", createErr.FlavorID)
+	th.AssertNoErr(t, createErr.RollbackErr)
+	th.AssertEquals(t, true, gophercloud.ResponseCodeIs(err, http.StatusForbidden))
+	th.AssertEquals(t, true, deleted)
+}
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/flavors/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"is_public": "None"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, `
+{
+	"flavors": [
+		{"id": "2bc7a2a9-7c2a-4a8c-9f0e-5d4b7a2b1c01", "name": "flavor-1"},
+		{"id": "7f3c8e1d-51b4-4a8e-8a1e-3d9c0e6f4b02", "name": "flavor-2"},
+		{"id": "c4d1f0e2-9b7a-4f6c-8d3e-1a2b5c6d7e03", "name": "flavor-2"}
+	]
+}`)
+	})
+
+	id, err := flavors.IDFromName(context.TODO(), fake.ServiceClient(), "flavor-1")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2bc7a2a9-7c2a-4a8c-9f0e-5d4b7a2b1c01", id)
+}
+
+func handleSelect(t *testing.T) {
+	th.Mux.HandleFunc("/flavors/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"minRam": "4096"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, `
+{
+	"flavors": [
+		{"id": "4", "name": "m1.large", "vcpus": 8, "ram": 16384, "disk": 80, "extra_specs": {}},
+		{"id": "3", "name": "b.medium", "vcpus": 2, "ram": 4096, "disk": 40, "extra_specs": {"hw:cpu_policy": "dedicated"}},
+		{"id": "2", "name": "a.medium", "vcpus": 2, "ram": 4096, "disk": 40},
+		{"id": "1", "name": "m1.small", "vcpus": 1, "ram": 4096, "disk": 20, "extra_specs": {}}
+	]
+}`)
+	})
+
+	th.Mux.HandleFunc("/flavors/2/os-extra_specs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"extra_specs": {"hw:cpu_policy": "shared"}}`)
+	})
+}
+
+func TestSelect(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleSelect(t)
+
+	opts := flavors.SelectOpts{
+		MinVCPUs: 2,
+		MinRAM:   4096,
+	}
+
+	actual, err := flavors.Select(context.TODO(), fake.ServiceClient(), opts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "a.medium", actual.Name)
+
+	opts.ExtraSpecs = map[string]string{"hw:cpu_policy": "dedicated"}
+	actual, err = flavors.Select(context.TODO(), fake.ServiceClient(), opts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "b.medium", actual.Name)
+
+	opts.ExtraSpecs = map[string]string{"hw:mem_page_size": "large"}
+	_, err = flavors.Select(context.TODO(), fake.ServiceClient(), opts)
+	if _, ok := err.(flavors.ErrNoMatchingFlavor); !ok {
+		t.Fatalf("ErrNoMatchingFlavor was expected to occur, got %v", err)
+	}
+}
@@ -0,0 +1,168 @@
+package flavors
+
+import (
+	"context"
+	"sort"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// CreateWithExtrasOpts specifies a flavor along with the extra specs and the
+// access projects to set on it.
+type CreateWithExtrasOpts struct {
+	// CreateOpts are the options used to create the flavor.
+	CreateOpts CreateOptsBuilder
+
+	// ExtraSpecs are the extra specs to set on the flavor.
+	ExtraSpecs CreateExtraSpecsOptsBuilder
+
+	// Projects are the projects to grant access to the flavor. The flavor
+	// must be private.
+	Projects []string
+}
+
+// CreateWithExtras creates a flavor, then sets its extra specs and grants
+// access to it to opts.Projects. If any of these steps fails, the flavor is
+// deleted and an ErrCreateWithExtras error is returned.
+func CreateWithExtras(ctx context.Context, client *gophercloud.ServiceClient, opts CreateWithExtrasOpts) (*Flavor, error) {
+	if opts.CreateOpts == nil {
+		return nil, gophercloud.ErrMissingInput{Argument: "flavors.CreateWithExtrasOpts.CreateOpts"}
+	}
+
+	flavor, err := Create(ctx, client, opts.CreateOpts).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setExtras(ctx, client, flavor.ID, opts); err != nil {
+		e := ErrCreateWithExtras{FlavorID: flavor.ID, Err: err}
+		e.RollbackErr = Delete(context.WithoutCancel(ctx), client, flavor.ID).ExtractErr()
+		return nil, e
+	}
+
+	return flavor, nil
+}
+
+func setExtras(ctx context.Context, client *gophercloud.ServiceClient, flavorID string, opts CreateWithExtrasOpts) error {
+	if opts.ExtraSpecs != nil {
+		if err := CreateExtraSpecs(ctx, client, flavorID, opts.ExtraSpecs).Err; err != nil {
+			return err
+		}
+	}
+
+	for _, project := range opts.Projects {
+		if err := AddAccess(ctx, client, flavorID, AddAccessOpts{Tenant: project}).Err; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SelectOpts describes the requirements of the flavor chosen by Select.
+type SelectOpts struct {
+	// MinVCPUs is the minimum number of virtual CPUs.
+	MinVCPUs int
+
+	// MinRAM is the minimum amount of memory, measured in MB.
+	MinRAM int
+
+	// MinDisk is the minimum amount of root disk, measured in GB. Flavors
+	// with a root disk of 0, sized after the image, only satisfy a MinDisk
+	// of 0.
+	MinDisk int
+
+	// ExtraSpecs are the extra specs the flavor must have, with the given
+	// values.
+	ExtraSpecs map[string]string
+
+	// AccessType selects the set of flavors to choose from. It defaults to
+	// the flavors available to the current project.
+	AccessType AccessType
+}
+
+// Select lists the flavors and returns the smallest one satisfying opts.
+// Flavors are compared by VCPUs, then RAM, root disk, ephemeral disk and
+// swap, and finally by name and ID, so that the choice is deterministic.
+//
+// When the listed flavors do not include their extra specs, which requires
+// microversion 2.61, the extra specs of the candidates are requested one at
+// a time, smallest first. ErrNoMatchingFlavor is returned if no flavor
+// matches.
+func Select(ctx context.Context, client *gophercloud.ServiceClient, opts SelectOpts) (*Flavor, error) {
+	listOpts := ListOpts{
+		MinDisk:    opts.MinDisk,
+		MinRAM:     opts.MinRAM,
+		AccessType: opts.AccessType,
+	}
+
+	allPages, err := ListDetail(client, listOpts).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allFlavors, err := ExtractFlavors(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]Flavor, 0, len(allFlavors))
+	for _, flavor := range allFlavors {
+		if flavor.VCPUs >= opts.MinVCPUs && flavor.RAM >= opts.MinRAM && flavor.Disk >= opts.MinDisk {
+			candidates = append(candidates, flavor)
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return flavorLess(candidates[i], candidates[j])
+	})
+
+	for i := range candidates {
+		flavor := &candidates[i]
+		if len(opts.ExtraSpecs) == 0 {
+			return flavor, nil
+		}
+
+		extraSpecs := flavor.ExtraSpecs
+		if extraSpecs == nil {
+			extraSpecs, err = ListExtraSpecs(ctx, client, flavor.ID).Extract()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if hasExtraSpecs(extraSpecs, opts.ExtraSpecs) {
+			return flavor, nil
+		}
+	}
+
+	return nil, ErrNoMatchingFlavor{}
+}
+
+// flavorLess orders flavors from the smallest to the largest.
+func flavorLess(a, b Flavor) bool {
+	switch {
+	case a.VCPUs != b.VCPUs:
+		return a.VCPUs < b.VCPUs
+	case a.RAM != b.RAM:
+		return a.RAM < b.RAM
+	case a.Disk != b.Disk:
+		return a.Disk < b.Disk
+	case a.Ephemeral != b.Ephemeral:
+		return a.Ephemeral < b.Ephemeral
+	case a.Swap != b.Swap:
+		return a.Swap < b.Swap
+	case a.Name != b.Name:
+		return a.Name < b.Name
+	}
+	return a.ID < b.ID
+}
+
+func hasExtraSpecs(extraSpecs, required map[string]string) bool {
+	for key, value := range required {
+		if v, ok := extraSpecs[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
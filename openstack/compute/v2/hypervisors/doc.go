@@ -93,5 +93,22 @@ Example of Show Hypervisor Uptime with Compute API microversion greater than 2.5
 	}
 
 	fmt.Printf("%+v\n", hypervisorUptime)
+
+Example of Show Hypervisor Uptime and Servers with Compute API microversion 2.88 or greater
+
+	computeClient.Microversion = "2.88"
+
+	withServers := true
+	getOpts := hypervisors.GetOpts{
+		WithServers: &withServers,
+	}
+
+	hypervisorID := "c48f6247-abe4-4a24-824e-ea39e108874f"
+	hypervisor, err := hypervisors.GetWithOpts(context.TODO(), computeClient, hypervisorID, getOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%s: %s\n", hypervisor.HypervisorHostname, *hypervisor.Uptime)
 */
 package hypervisors
@@ -53,11 +53,12 @@ func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pa
 	}
 
 	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
-		return HypervisorPage{pagination.SinglePageBase(r)}
+		return HypervisorPage{pagination.LinkedPageBase{PageResult: r}}
 	})
 }
 
-// Statistics makes a request against the API to get hypervisors statistics.
+// GetStatistics makes a request against the API to get hypervisors statistics.
+// It was removed in microversion 2.88.
 func GetStatistics(ctx context.Context, client *gophercloud.ServiceClient) (r StatisticsResult) {
 	resp, err := client.Get(ctx, hypervisorsStatisticsURL(client), &r.Body, &gophercloud.RequestOpts{
 		OkCodes: []int{200},
@@ -66,9 +67,44 @@ func GetStatistics(ctx context.Context, client *gophercloud.ServiceClient) (r St
 	return
 }
 
+// GetOptsBuilder allows extensions to add additional parameters to the
+// GetWithOpts request.
+type GetOptsBuilder interface {
+	ToHypervisorGetQuery() (string, error)
+}
+
+// GetOpts specifies the parameters of a GetWithOpts request.
+type GetOpts struct {
+	// WithServers is a bool to include all servers which belong to the hypervisor
+	// This requires microversion 2.53 or later
+	WithServers *bool `q:"with_servers"`
+}
+
+// ToHypervisorGetQuery formats a GetOpts into a query string.
+func (opts GetOpts) ToHypervisorGetQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
 // Get makes a request against the API to get details for specific hypervisor.
 func Get(ctx context.Context, client *gophercloud.ServiceClient, hypervisorID string) (r HypervisorResult) {
-	resp, err := client.Get(ctx, hypervisorsGetURL(client, hypervisorID), &r.Body, &gophercloud.RequestOpts{
+	return GetWithOpts(ctx, client, hypervisorID, nil)
+}
+
+// GetWithOpts makes a request against the API to get details for specific
+// hypervisor, using the given options.
+func GetWithOpts(ctx context.Context, client *gophercloud.ServiceClient, hypervisorID string, opts GetOptsBuilder) (r HypervisorResult) {
+	url := hypervisorsGetURL(client, hypervisorID)
+	if opts != nil {
+		query, err := opts.ToHypervisorGetQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+
+	resp, err := client.Get(ctx, url, &r.Body, &gophercloud.RequestOpts{
 		OkCodes: []int{200},
 	})
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
@@ -76,6 +112,8 @@ func Get(ctx context.Context, client *gophercloud.ServiceClient, hypervisorID st
 }
 
 // GetUptime makes a request against the API to get uptime for specific hypervisor.
+// It was removed in microversion 2.88, where the uptime is part of the
+// hypervisor returned by Get instead.
 func GetUptime(ctx context.Context, client *gophercloud.ServiceClient, hypervisorID string) (r UptimeResult) {
 	resp, err := client.Get(ctx, hypervisorsUptimeURL(client, hypervisorID), &r.Body, &gophercloud.RequestOpts{
 		OkCodes: []int{200},
@@ -138,6 +138,11 @@ type Hypervisor struct {
 
 	// VCPUsUsed is the number of used vcpus on the hypervisor.
 	VCPUsUsed int `json:"vcpus_used"`
+
+	// Uptime is the total uptime of the hypervisor and information about
+	// average load, replacing the GetUptime call.
+	// This requires microversion 2.88 or later.
+	Uptime *string `json:"uptime"`
 }
 
 func (r *Hypervisor) UnmarshalJSON(b []byte) error {
@@ -240,7 +245,7 @@ func (r *Hypervisor) UnmarshalJSON(b []byte) error {
 // HypervisorPage represents a single page of all Hypervisors from a List
 // request.
 type HypervisorPage struct {
-	pagination.SinglePageBase
+	pagination.LinkedPageBase
 }
 
 // IsEmpty determines whether or not a HypervisorPage is empty.
@@ -253,6 +258,20 @@ func (page HypervisorPage) IsEmpty() (bool, error) {
 	return len(va) == 0, err
 }
 
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results. Links are returned with microversion 2.53 or later
+// when a limit is set.
+func (page HypervisorPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"hypervisors_links"`
+	}
+	err := page.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
 // ExtractHypervisors interprets a page of results as a slice of Hypervisors.
 func ExtractHypervisors(p pagination.Page) ([]Hypervisor, error) {
 	var h struct {
@@ -681,3 +681,100 @@ func HandleHypervisorUptimeSuccessfully(t *testing.T) {
 		fmt.Fprint(w, HypervisorUptimeBody)
 	})
 }
+
+// HypervisorV288ResponseBody represents a raw hypervisor GET result with the
+// uptime and servers, as seen after v2.88 with the with_servers parameter.
+const HypervisorV288ResponseBody = `
+{
+    "hypervisor":{
+        "current_workload":0,
+        "status":"enabled",
+        "state":"up",
+        "disk_available_least":0,
+        "host_ip":"1.1.1.1",
+        "free_ram_mb":7680,
+        "hypervisor_hostname":"fake-mini",
+        "hypervisor_type":"fake",
+        "hypervisor_version":2002000,
+        "id":"c48f6247-abe4-4a24-824e-ea39e108874f",
+        "local_gb_used":0,
+        "memory_mb":8192,
+        "memory_mb_used":512,
+        "running_vms":1,
+        "servers":[
+            {
+                "name":"test_server1",
+                "uuid":"31c1e4b7-45b8-4d1b-9a59-ec8ea0b8e0a5"
+            }
+        ],
+        "service":{
+            "host":"e6a37ee802d74863ab8b91ade8f12a67",
+            "id":"9c2566e7-7a54-4777-a1ae-c2662f0c407c",
+            "disabled_reason":null
+        },
+        "uptime":" 08:32:11 up 93 days, 18:25, 12 users,  load average: 0.20, 0.12, 0.14",
+        "vcpus":1,
+        "vcpus_used":0
+    }
+}
+`
+
+func HandleHypervisorGetWithServersSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-hypervisors/"+HypervisorFake.ID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"with_servers": "true",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, HypervisorV288ResponseBody)
+	})
+}
+
+func HandleHypervisorListPagedSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-hypervisors/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+
+		switch r.Form.Get("marker") {
+		case "":
+			fmt.Fprintf(w, `{
+				"hypervisors": [
+					{
+						"hypervisor_hostname": "fake-mini",
+						"hypervisor_version": 2002000,
+						"id": "c48f6247-abe4-4a24-824e-ea39e108874f",
+						"state": "up",
+						"status": "enabled"
+					}
+				],
+				"hypervisors_links": [
+					{
+						"href": "%s/os-hypervisors/detail?limit=1&marker=c48f6247-abe4-4a24-824e-ea39e108874f",
+						"rel": "next"
+					}
+				]
+			}`, th.Server.URL)
+		case "c48f6247-abe4-4a24-824e-ea39e108874f":
+			fmt.Fprint(w, `{
+				"hypervisors": [
+					{
+						"hypervisor_hostname": "fake-mini2",
+						"hypervisor_version": 2002000,
+						"id": "c48f6247-abe4-4a24-824e-ea39e108874e",
+						"state": "up",
+						"status": "enabled"
+					}
+				]
+			}`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", r.Form.Get("marker"))
+		}
+	})
+}
@@ -171,3 +171,40 @@ func TestHypervisorsUptime(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.CheckDeepEquals(t, &expected, actual)
 }
+
+func TestListHypervisorsPaged(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleHypervisorListPagedSuccessfully(t)
+
+	limit := 1
+	pages := 0
+	var ids []string
+	err := hypervisors.List(client.ServiceClient(), hypervisors.ListOpts{Limit: &limit}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		pages++
+		actual, err := hypervisors.ExtractHypervisors(page)
+		if err != nil {
+			return false, err
+		}
+		for _, h := range actual {
+			ids = append(ids, h.ID)
+		}
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, pages)
+	th.AssertDeepEquals(t, []string{"c48f6247-abe4-4a24-824e-ea39e108874f", "c48f6247-abe4-4a24-824e-ea39e108874e"}, ids)
+}
+
+func TestGetHypervisorWithServers(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleHypervisorGetWithServersSuccessfully(t)
+
+	withServers := true
+	actual, err := hypervisors.GetWithOpts(context.TODO(), client.ServiceClient(), HypervisorFake.ID, hypervisors.GetOpts{WithServers: &withServers}).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, []hypervisors.Server{{Name: "test_server1", UUID: "31c1e4b7-45b8-4d1b-9a59-ec8ea0b8e0a5"}}, *actual.Servers)
+	th.AssertEquals(t, HypervisorUptimeExpected.Uptime, *actual.Uptime)
+}
@@ -1,5 +1,3 @@
-package instanceactions
-
 /*
 Package instanceactions provides the ability to list or get a server instance-action.
 
@@ -16,11 +14,38 @@ Example to List and Get actions:
 	}
 
 	for _, action := range actions {
-		action, err = instanceactions.Get(context.TODO(), client, "server-id", action.RequestID).Extract()
+		detail, err := instanceactions.Get(context.TODO(), client, "server-id", action.RequestID).Extract()
 		if err != nil {
 			panic("fail to get instance action")
 		}
 
-		fmt.Println(action)
+		fmt.Println(detail)
+	}
+
+Example to List recent actions page by page:
+
+	client.Microversion = "2.66"
+
+	changesSince := time.Now().Add(-24 * time.Hour)
+	listOpts := instanceactions.ListOpts{
+		Limit:        10,
+		ChangesSince: &changesSince,
+	}
+
+	err := instanceactions.List(client, "server-id", listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		actions, err := instanceactions.ExtractInstanceActions(page)
+		if err != nil {
+			return false, err
+		}
+
+		for _, action := range actions {
+			fmt.Printf("%s %s %s\n", action.StartTime, action.Action, action.RequestID)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		panic(err)
 	}
 */
+package instanceactions
@@ -30,6 +30,10 @@ type InstanceAction struct {
 
 	// UserID is the ID of the user which initiated the action.
 	UserID string `json:"user_id"`
+
+	// UpdatedAt last update date of the action.
+	// This requires microversion 2.58 or later.
+	UpdatedAt *time.Time `json:"-"`
 }
 
 // UnmarshalJSON converts our JSON API response into our instance action struct
@@ -37,7 +41,8 @@ func (i *InstanceAction) UnmarshalJSON(b []byte) error {
 	type tmp InstanceAction
 	var s struct {
 		tmp
-		StartTime gophercloud.JSONRFC3339MilliNoZ `json:"start_time"`
+		StartTime gophercloud.JSONRFC3339MilliNoZ  `json:"start_time"`
+		UpdatedAt *gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
 	}
 	err := json.Unmarshal(b, &s)
 	if err != nil {
@@ -46,6 +51,7 @@ func (i *InstanceAction) UnmarshalJSON(b []byte) error {
 	*i = InstanceAction(s.tmp)
 
 	i.StartTime = time.Time(s.StartTime)
+	i.UpdatedAt = (*time.Time)(s.UpdatedAt)
 
 	return err
 }
@@ -55,7 +61,7 @@ func (i *InstanceAction) UnmarshalJSON(b []byte) error {
 // of structures returned to the client, you may only safely access the data
 // provided through the ExtractInstanceActions call.
 type InstanceActionPage struct {
-	pagination.SinglePageBase
+	pagination.LinkedPageBase
 }
 
 // IsEmpty returns true if an InstanceActionPage contains no instance actions.
@@ -68,6 +74,20 @@ func (r InstanceActionPage) IsEmpty() (bool, error) {
 	return len(instanceactions) == 0, err
 }
 
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results. Links are returned with microversion 2.58 or later
+// when a limit is set.
+func (r InstanceActionPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
 // ExtractInstanceActions interprets a page of results as a slice
 // of InstanceAction.
 func ExtractInstanceActions(r pagination.Page) ([]InstanceAction, error) {
@@ -93,8 +113,15 @@ type Event struct {
 	Result string `json:"result"`
 
 	// Traceback is the traceback stack if an error occurred.
+	// It is only returned to administrators; starting with microversion
+	// 2.51, non-administrators get the events without it.
 	Traceback string `json:"traceback"`
 
+	// Details is the details of the event, e.g. the fault message of a
+	// failed event. It is visible to non-administrators.
+	// This requires microversion 2.84 or later.
+	Details *string `json:"details"`
+
 	// StartTime is the time the action started.
 	StartTime time.Time `json:"-"`
 
@@ -126,3 +126,94 @@ func HandleInstanceActionGetSuccessfully(t *testing.T) {
 		}`)
 	})
 }
+
+// HandleInstanceActionListPagedSuccessfully sets up the test server to
+// respond to a List request with a limit of one action per page, as returned
+// with microversion 2.58 or later.
+func HandleInstanceActionListPagedSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/paged/os-instance-actions", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+		th.AssertEquals(t, "1", r.Form.Get("limit"))
+
+		switch r.Form.Get("marker") {
+		case "":
+			fmt.Fprintf(w, `{
+				"instanceActions": [
+					{
+						"action": "stop",
+						"instance_uuid": "fcd19ef2-b593-40b1-90a5-fc31063fa95c",
+						"message": null,
+						"project_id": "6f70656e737461636b20342065766572",
+						"request_id": "req-f8a59f03-76dc-412f-92c2-21f8612be728",
+						"start_time": "2018-04-25T01:26:29.000000",
+						"updated_at": "2018-04-25T01:26:36.000000",
+						"user_id": "admin"
+					}
+				],
+				"links": [
+					{
+						"href": "%s/servers/paged/os-instance-actions?limit=1&marker=req-f8a59f03-76dc-412f-92c2-21f8612be728",
+						"rel": "next"
+					}
+				]
+			}`, th.Server.URL)
+		case "req-f8a59f03-76dc-412f-92c2-21f8612be728":
+			fmt.Fprint(w, `{
+				"instanceActions": [
+					{
+						"action": "create",
+						"instance_uuid": "fcd19ef2-b593-40b1-90a5-fc31063fa95c",
+						"message": "test",
+						"project_id": "6f70656e737461636b20342065766572",
+						"request_id": "req-50189019-626d-47fb-b944-b8342af09679",
+						"start_time": "2018-04-25T01:26:25.000000",
+						"updated_at": "2018-04-25T01:26:36.000000",
+						"user_id": "admin"
+					}
+				]
+			}`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", r.Form.Get("marker"))
+		}
+	})
+}
+
+// HandleInstanceActionGetWithDetailsSuccessfully sets up the test server to
+// respond to a Get request for a failed action, as returned to a
+// non-administrator with microversion 2.84 or later.
+func HandleInstanceActionGetWithDetailsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/asdfasdfasdf/os-instance-actions/failed", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"instanceAction": {
+				"action": "resize",
+				"events": [
+					{
+						"event": "compute_prep_resize",
+						"finish_time": "2018-04-25T01:26:36.00000",
+						"hostId": "2091634baaccdc4c5a1d57069c833e402921df696b7f970791b12ec6",
+						"result": "Error",
+						"start_time": "2018-04-25T01:26:36.00000",
+						"details": "No valid host was found."
+					}
+				],
+				"instance_uuid": "4bf3473b-d550-4b65-9409-292d44ab14a2",
+				"message": "Error",
+				"project_id": "6f70656e737461636b20342065766572",
+				"request_id": "req-0d819d5c-1527-4669-bdf0-ffad31b5105b",
+				"start_time": "2018-04-25T01:26:36.00000",
+				"updated_at": "2018-04-25T01:26:36.00000",
+				"user_id": "admin"
+			}
+		}`)
+	})
+}
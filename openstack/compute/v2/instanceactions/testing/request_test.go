@@ -47,3 +47,47 @@ func TestGet(t *testing.T) {
 
 	th.CheckDeepEquals(t, GetExpected, actual)
 }
+
+func TestListPaged(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInstanceActionListPagedSuccessfully(t)
+
+	c := client.ServiceClient()
+	c.Microversion = "2.58"
+
+	pages := 0
+	var requestIDs []string
+	err := instanceactions.List(c, "paged", instanceactions.ListOpts{Limit: 1}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		pages++
+		actual, err := instanceactions.ExtractInstanceActions(page)
+		th.AssertNoErr(t, err)
+
+		for _, action := range actual {
+			requestIDs = append(requestIDs, action.RequestID)
+			th.AssertEquals(t, expectedUpdateAt, *action.UpdatedAt)
+		}
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, pages)
+	th.AssertDeepEquals(t, []string{"req-f8a59f03-76dc-412f-92c2-21f8612be728", "req-50189019-626d-47fb-b944-b8342af09679"}, requestIDs)
+}
+
+func TestGetWithDetails(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInstanceActionGetWithDetailsSuccessfully(t)
+
+	c := client.ServiceClient()
+	c.Microversion = "2.84"
+
+	actual, err := instanceactions.Get(context.TODO(), c, "asdfasdfasdf", "failed").Extract()
+	th.AssertNoErr(t, err)
+
+	events := *actual.Events
+	th.AssertEquals(t, 1, len(events))
+	th.AssertEquals(t, "Error", events[0].Result)
+	th.AssertEquals(t, "", events[0].Traceback)
+	th.AssertEquals(t, "No valid host was found.", *events[0].Details)
+}
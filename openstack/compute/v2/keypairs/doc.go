@@ -40,6 +40,46 @@ Example to List Key Pairs using microversion 2.10 or greater
 		fmt.Printf("%+v\n", kp)
 	}
 
+Example to List Key Pairs page by page using microversion 2.35 or greater
+
+	client.Microversion = "2.35"
+
+	listOpts := keypairs.ListOpts{
+		UserID: "user-id",
+		Limit:  50,
+	}
+
+	err := keypairs.List(computeClient, listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		keyPairs, err := keypairs.ExtractKeyPairs(page)
+		if err != nil {
+			return false, err
+		}
+
+		for _, kp := range keyPairs {
+			fmt.Printf("%+v\n", kp)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create an x509 Key Pair using microversion 2.2 or greater
+
+	client.Microversion = "2.2"
+
+	createOpts := keypairs.CreateOpts{
+		Name:      "keypair-name",
+		Type:      keypairs.KeyTypeX509,
+		PublicKey: "-----BEGIN CERTIFICATE-----\n...",
+	}
+
+	keypair, err := keypairs.Create(context.TODO(), computeClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Create a Key Pair
 
 	createOpts := keypairs.CreateOpts{
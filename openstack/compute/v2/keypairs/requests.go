@@ -33,6 +33,21 @@ func (opts CreateOptsExt) ToServerCreateMap() (map[string]any, error) {
 	return base, nil
 }
 
+// ValidateForMicroversion forwards the microversion validation to the base
+// server creation options when they support it.
+func (opts CreateOptsExt) ValidateForMicroversion(microversion string) error {
+	if v, ok := opts.CreateOptsBuilder.(servers.MicroversionValidator); ok {
+		return v.ValidateForMicroversion(microversion)
+	}
+	return nil
+}
+
+// Key pair types. Setting a type requires microversion 2.2 or higher.
+const (
+	KeyTypeSSH  = "ssh"
+	KeyTypeX509 = "x509"
+)
+
 // ListOptsBuilder allows extensions to add additional parameters to the
 // List request.
 type ListOptsBuilder interface {
@@ -44,6 +59,14 @@ type ListOpts struct {
 	// UserID is the user ID that owns the key pair.
 	// This requires microversion 2.10 or higher.
 	UserID string `q:"user_id"`
+
+	// Limit is the maximum number of key pairs to return per page.
+	// This requires microversion 2.35 or higher.
+	Limit int `q:"limit"`
+
+	// Marker is the name of the last key pair seen on the previous page.
+	// This requires microversion 2.35 or higher.
+	Marker string `q:"marker"`
 }
 
 // ToKeyPairListQuery formats a ListOpts into a query string.
@@ -63,7 +86,7 @@ func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pa
 		url += query
 	}
 	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
-		return KeyPairPage{pagination.SinglePageBase(r)}
+		return KeyPairPage{pagination.LinkedPageBase{PageResult: r}}
 	})
 }
 
@@ -83,7 +106,7 @@ type CreateOpts struct {
 	// This requires microversion 2.10 or higher.
 	UserID string `json:"user_id,omitempty"`
 
-	// The type of the keypair. Allowed values are ssh or x509
+	// The type of the keypair. Allowed values are KeyTypeSSH or KeyTypeX509.
 	// This requires microversion 2.2 or higher.
 	Type string `json:"type,omitempty"`
 
@@ -36,7 +36,20 @@ type KeyPair struct {
 // Use the ExtractKeyPairs function to convert the results to a slice of
 // KeyPairs.
 type KeyPairPage struct {
-	pagination.SinglePageBase
+	pagination.LinkedPageBase
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results. Key pairs are paged starting with microversion 2.35.
+func (page KeyPairPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"keypairs_links"`
+	}
+	err := page.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
 }
 
 // IsEmpty determines whether or not a KeyPairPage is empty.
@@ -248,3 +248,67 @@ func HandleDeleteSuccessfullyOtherUser(t *testing.T) {
 		w.WriteHeader(http.StatusAccepted)
 	})
 }
+
+// ListPagedFirstOutput is a sample response to a paged List call for
+// another user using microversion 2.35.
+const ListPagedFirstOutput = `
+{
+	"keypairs": [
+		{
+			"keypair": {
+				"fingerprint": "15:b0:f8:b3:f9:48:63:71:cf:7b:5b:38:6d:44:2d:4a",
+				"name": "firstkey",
+				"public_key": "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAAAgQC+Eo/RZRngaGTkFs7I62ZjsIlO79KklKbMXi8F+KITD4bVQHHn+kV+4gRgkgCRbdoDqoGfpaDFs877DYX9n4z6FrAIZ4PES8TNKhatifpn9NdQYWA+IkU8CuvlEKGuFpKRi/k7JLos/gHi2hy7QUwgtRvcefvD/vgQZOVw/mGR9Q== Generated by Nova\n",
+				"type": "ssh"
+			}
+		}
+	],
+	"keypairs_links": [
+		{
+			"href": "%s/os-keypairs?limit=1&marker=firstkey&user_id=fake2",
+			"rel": "next"
+		}
+	]
+}
+`
+
+// ListPagedSecondOutput is the last page of a paged List call.
+const ListPagedSecondOutput = `
+{
+	"keypairs": [
+		{
+			"keypair": {
+				"fingerprint": "35:9d:d0:c3:4a:80:d3:d8:86:f1:ca:f7:df:c4:f9:d8",
+				"name": "x509key",
+				"public_key": "-----BEGIN CERTIFICATE-----\nMIIDaTCCAlGgAwIBAgIJAMPhLIsZRxkEMA0GCSqGSIb3DQEBCwUAMEsxCzAJBgNV\n-----END CERTIFICATE-----\n",
+				"type": "x509"
+			}
+		}
+	]
+}
+`
+
+// HandleListPagedSuccessfully configures the test server to respond to a
+// paged List request for the key pairs of another user.
+func HandleListPagedSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-keypairs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+		th.AssertEquals(t, "fake2", r.Form.Get("user_id"))
+		th.AssertEquals(t, "1", r.Form.Get("limit"))
+
+		w.Header().Add("Content-Type", "application/json")
+		switch marker := r.Form.Get("marker"); marker {
+		case "":
+			fmt.Fprintf(w, ListPagedFirstOutput, th.Server.URL)
+		case "firstkey":
+			fmt.Fprint(w, ListPagedSecondOutput)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
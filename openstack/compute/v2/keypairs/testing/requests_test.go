@@ -2,9 +2,12 @@ package testing
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/keypairs"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/servers"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
 	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
@@ -28,6 +31,36 @@ func TestList(t *testing.T) {
 	th.CheckEquals(t, 1, count)
 }
 
+func TestListPaged(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListPagedSuccessfully(t)
+
+	listOpts := keypairs.ListOpts{
+		UserID: "fake2",
+		Limit:  1,
+	}
+
+	pages := 0
+	var names, types []string
+	err := keypairs.List(client.ServiceClient(), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		pages++
+		actual, err := keypairs.ExtractKeyPairs(page)
+		if err != nil {
+			return false, err
+		}
+		for _, kp := range actual {
+			names = append(names, kp.Name)
+			types = append(types, kp.Type)
+		}
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, pages)
+	th.AssertDeepEquals(t, []string{"firstkey", "x509key"}, names)
+	th.AssertDeepEquals(t, []string{keypairs.KeyTypeSSH, keypairs.KeyTypeX509}, types)
+}
+
 func TestCreate(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -111,3 +144,23 @@ func TestDeleteOtherUser(t *testing.T) {
 	err := keypairs.Delete(context.TODO(), client.ServiceClient(), "deletedkey", deleteOpts).ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestCreateServerWithKeyPairMicroversionNotAllowed(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	sc := client.ServiceClient()
+	sc.Microversion = "2.89"
+	_, err := servers.Create(context.TODO(), sc, keypairs.CreateOptsExt{
+		CreateOptsBuilder: servers.CreateOpts{
+			Name:      "derp",
+			ImageRef:  "f90f6034-2570-4974-8351-6b49732ef2eb",
+			FlavorRef: "1",
+			Hostname:  "derp.local",
+		},
+		KeyName: "mykey",
+	}, nil).Extract()
+	var invalid gophercloud.ErrInvalidInput
+	th.AssertEquals(t, true, errors.As(err, &invalid))
+	th.AssertEquals(t, "servers.CreateOpts.Hostname", invalid.Argument)
+}
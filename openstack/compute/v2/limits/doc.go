@@ -13,5 +13,17 @@ Example to Retrieve Limits for a Tenant
 	}
 
 	fmt.Printf("%+v\n", limits)
+
+Example to Check for a Limit Removed in Later Microversions
+
+	res := limits.Get(context.TODO(), computeClient, nil)
+	l, err := res.Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	if res.HasAbsoluteLimit("maxPersonality") {
+		fmt.Printf("max personality: %d\n", l.Absolute.MaxPersonality)
+	}
 */
 package limits
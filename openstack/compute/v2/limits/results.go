@@ -1,6 +1,8 @@
 package limits
 
 import (
+	"encoding/json"
+
 	"github.com/vnpaycloud-console/gophercloud/v2"
 )
 
@@ -23,19 +25,22 @@ type Absolute struct {
 	MaxServerMeta int `json:"maxServerMeta"`
 
 	// MaxPersonality is the amount of personality/files available to a tenant.
+	// It is not reported since microversion 2.57.
 	MaxPersonality int `json:"maxPersonality"`
 
 	// MaxPersonalitySize is the personality file size available to a tenant.
+	// It is not reported since microversion 2.57.
 	MaxPersonalitySize int `json:"maxPersonalitySize"`
 
 	// MaxTotalKeypairs is the total keypairs available to a tenant.
 	MaxTotalKeypairs int `json:"maxTotalKeypairs"`
 
 	// MaxSecurityGroups is the number of security groups available to a tenant.
+	// It is not reported since microversion 2.36.
 	MaxSecurityGroups int `json:"maxSecurityGroups"`
 
 	// MaxSecurityGroupRules is the number of security group rules available to
-	// a tenant.
+	// a tenant. It is not reported since microversion 2.36.
 	MaxSecurityGroupRules int `json:"maxSecurityGroupRules"`
 
 	// MaxServerGroups is the number of server groups available to a tenant.
@@ -46,6 +51,7 @@ type Absolute struct {
 	MaxServerGroupMembers int `json:"maxServerGroupMembers"`
 
 	// MaxTotalFloatingIps is the number of floating IPs available to a tenant.
+	// It is not reported since microversion 2.36.
 	MaxTotalFloatingIps int `json:"maxTotalFloatingIps"`
 
 	// MaxTotalInstances is the number of instances/servers available to a tenant.
@@ -62,12 +68,14 @@ type Absolute struct {
 	TotalInstancesUsed int `json:"totalInstancesUsed"`
 
 	// TotalFloatingIpsUsed is the number of floating IPs in use.
+	// It is not reported since microversion 2.36.
 	TotalFloatingIpsUsed int `json:"totalFloatingIpsUsed"`
 
 	// TotalRAMUsed is the total RAM/memory in use measured in megabytes (MB).
 	TotalRAMUsed int `json:"totalRAMUsed"`
 
 	// TotalSecurityGroupsUsed is the total number of security groups in use.
+	// It is not reported since microversion 2.36.
 	TotalSecurityGroupsUsed int `json:"totalSecurityGroupsUsed"`
 
 	// TotalServerGroupsUsed is the total number of server groups in use.
@@ -83,6 +91,23 @@ func (r GetResult) Extract() (*Limits, error) {
 	return s.Limits, err
 }
 
+// HasAbsoluteLimit reports whether the absolute limit with the given JSON
+// name, such as "maxPersonality", is present in the response. Limits removed
+// in later microversions are decoded as zero, which this allows to tell apart
+// from an actual limit of zero.
+func (r GetResult) HasAbsoluteLimit(name string) bool {
+	var s struct {
+		Limits struct {
+			Absolute map[string]json.RawMessage `json:"absolute"`
+		} `json:"limits"`
+	}
+	if err := r.ExtractInto(&s); err != nil {
+		return false
+	}
+	_, ok := s.Limits.Absolute[name]
+	return ok
+}
+
 // GetResult is the response from a Get operation. Call its Extract
 // method to interpret it as an Absolute.
 type GetResult struct {
@@ -65,6 +65,50 @@ var LimitsResult = limits.Limits{
 	},
 }
 
+// GetOutputMicroversion257 is a sample response to a Get call with
+// microversion 2.57, which no longer reports the security group, floating
+// IP and personality limits.
+const GetOutputMicroversion257 = `
+{
+    "limits": {
+        "rate": [],
+        "absolute": {
+            "maxServerMeta": 128,
+            "totalServerGroupsUsed": 1,
+            "maxImageMeta": 128,
+            "maxTotalKeypairs": 100,
+            "maxServerGroups": 10,
+            "totalCoresUsed": 4,
+            "totalRAMUsed": 8192,
+            "totalInstancesUsed": 2,
+            "maxTotalCores": 20,
+            "maxServerGroupMembers": 10,
+            "maxTotalInstances": 10,
+            "maxTotalRAMSize": 51200
+        }
+    }
+}
+`
+
+// LimitsMicroversion257Result is the result of the limits in
+// GetOutputMicroversion257.
+var LimitsMicroversion257Result = limits.Limits{
+	Absolute: limits.Absolute{
+		MaxServerMeta:         128,
+		TotalServerGroupsUsed: 1,
+		MaxImageMeta:          128,
+		MaxTotalKeypairs:      100,
+		MaxServerGroups:       10,
+		TotalCoresUsed:        4,
+		TotalRAMUsed:          8192,
+		TotalInstancesUsed:    2,
+		MaxTotalCores:         20,
+		MaxServerGroupMembers: 10,
+		MaxTotalInstances:     10,
+		MaxTotalRAMSize:       51200,
+	},
+}
+
 const TenantID = "555544443333222211110000ffffeeee"
 
 // HandleGetSuccessfully configures the test server to respond to a Get request
@@ -78,3 +122,16 @@ func HandleGetSuccessfully(t *testing.T) {
 		fmt.Fprint(w, GetOutput)
 	})
 }
+
+// HandleGetMicroversion257Successfully configures the test server to respond
+// to a Get request for the limits of TenantID with microversion 2.57.
+func HandleGetMicroversion257Successfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"tenant_id": TenantID})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetOutputMicroversion257)
+	})
+}
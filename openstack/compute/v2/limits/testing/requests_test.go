@@ -22,3 +22,22 @@ func TestGet(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.CheckDeepEquals(t, &LimitsResult, actual)
 }
+
+func TestGetMicroversion257(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetMicroversion257Successfully(t)
+
+	getOpts := limits.GetOpts{
+		TenantID: TenantID,
+	}
+
+	res := limits.Get(context.TODO(), client.ServiceClient(), getOpts)
+	actual, err := res.Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &LimitsMicroversion257Result, actual)
+
+	th.AssertEquals(t, true, res.HasAbsoluteLimit("totalServerGroupsUsed"))
+	th.AssertEquals(t, false, res.HasAbsoluteLimit("maxPersonality"))
+	th.AssertEquals(t, false, res.HasAbsoluteLimit("maxSecurityGroups"))
+}
@@ -0,0 +1,65 @@
+/*
+Package migrations provides the ability to list the migrations of servers and
+to manage in-progress live migrations.
+
+Migrations are started with servers.MigrateWithOpts, servers.LiveMigrate,
+servers.Resize or servers.Evacuate.
+
+Example to List Migrations of a Host
+
+	listOpts := migrations.ListOpts{
+		Host:          "compute-01",
+		MigrationType: migrations.TypeLiveMigration,
+	}
+
+	computeClient.Microversion = "2.23"
+
+	allPages, err := migrations.List(computeClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allMigrations, err := migrations.ExtractMigrations(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, migration := range allMigrations {
+		fmt.Printf("%+v\n", migration)
+	}
+
+Example to List the In-Progress Live Migrations of a Server
+
+	serverID := "b3f5c3a8-1f4e-4d0b-8a3a-1b2b3c4d5e6f"
+
+	allPages, err := migrations.ListForServer(computeClient, serverID).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	serverMigrations, err := migrations.ExtractServerMigrations(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, migration := range serverMigrations {
+		fmt.Printf("%d: %d bytes remaining\n", migration.ID, migration.MemoryRemainingBytes)
+	}
+
+Example to Force an In-Progress Live Migration to Complete
+
+	err := migrations.ForceComplete(context.TODO(), computeClient, serverID, migrationID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Abort an In-Progress Live Migration
+
+	computeClient.Microversion = "2.24"
+
+	err := migrations.Abort(context.TODO(), computeClient, serverID, migrationID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package migrations
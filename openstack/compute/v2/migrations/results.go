@@ -0,0 +1,263 @@
+package migrations
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// Migration represents a migration of a server, as returned by List.
+type Migration struct {
+	// ID is the ID of the migration.
+	ID int `json:"id"`
+
+	// UUID is the UUID of the migration.
+	// This requires microversion 2.59 or later.
+	UUID string `json:"uuid"`
+
+	// InstanceUUID is the UUID of the migrated server.
+	InstanceUUID string `json:"instance_uuid"`
+
+	// SourceCompute is the source compute host.
+	SourceCompute string `json:"source_compute"`
+
+	// SourceNode is the source compute node.
+	SourceNode string `json:"source_node"`
+
+	// SourceRegion is the source region.
+	SourceRegion string `json:"source_region"`
+
+	// DestCompute is the destination compute host.
+	DestCompute string `json:"dest_compute"`
+
+	// DestNode is the destination compute node.
+	DestNode string `json:"dest_node"`
+
+	// DestHost is the IP address of the destination compute host.
+	DestHost string `json:"dest_host"`
+
+	// DestRegion is the destination region.
+	DestRegion string `json:"dest_region"`
+
+	// OldInstanceTypeID is the ID of the flavor before the migration.
+	OldInstanceTypeID int `json:"old_instance_type_id"`
+
+	// NewInstanceTypeID is the ID of the flavor after the migration.
+	NewInstanceTypeID int `json:"new_instance_type_id"`
+
+	// Status is the status of the migration.
+	Status string `json:"status"`
+
+	// MigrationType is the type of the migration, such as TypeLiveMigration.
+	// This requires microversion 2.23 or later.
+	MigrationType string `json:"migration_type"`
+
+	// UserID is the ID of the user who initiated the migration.
+	// This requires microversion 2.80 or later.
+	UserID string `json:"user_id"`
+
+	// ProjectID is the ID of the project of the migrated server.
+	// This requires microversion 2.80 or later.
+	ProjectID string `json:"project_id"`
+
+	// CreatedAt is the date and time when the migration was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date and time when the migration was last updated.
+	UpdatedAt time.Time `json:"-"`
+}
+
+// UnmarshalJSON converts our JSON API response into our migration struct.
+func (m *Migration) UnmarshalJSON(b []byte) error {
+	type tmp Migration
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*m = Migration(s.tmp)
+
+	m.CreatedAt = time.Time(s.CreatedAt)
+	m.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
+
+// MigrationPage is a page of Migration results.
+type MigrationPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a MigrationPage contains no migrations.
+func (r MigrationPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	migrations, err := ExtractMigrations(r)
+	return len(migrations) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (r MigrationPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"migrations_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// ExtractMigrations interprets a page of results as a slice of Migration.
+func ExtractMigrations(r pagination.Page) ([]Migration, error) {
+	var s struct {
+		Migrations []Migration `json:"migrations"`
+	}
+	err := (r.(MigrationPage)).ExtractInto(&s)
+	return s.Migrations, err
+}
+
+// ServerMigration represents an in-progress live migration of a server.
+type ServerMigration struct {
+	// ID is the ID of the migration.
+	ID int `json:"id"`
+
+	// UUID is the UUID of the migration.
+	// This requires microversion 2.59 or later.
+	UUID string `json:"uuid"`
+
+	// ServerUUID is the UUID of the migrated server.
+	ServerUUID string `json:"server_uuid"`
+
+	// SourceCompute is the source compute host.
+	SourceCompute string `json:"source_compute"`
+
+	// SourceNode is the source compute node.
+	SourceNode string `json:"source_node"`
+
+	// DestCompute is the destination compute host.
+	DestCompute string `json:"dest_compute"`
+
+	// DestNode is the destination compute node.
+	DestNode string `json:"dest_node"`
+
+	// DestHost is the IP address of the destination compute host.
+	DestHost string `json:"dest_host"`
+
+	// Status is the status of the migration.
+	Status string `json:"status"`
+
+	// MemoryTotalBytes is the amount of memory to transfer, in bytes.
+	MemoryTotalBytes int64 `json:"memory_total_bytes"`
+
+	// MemoryProcessedBytes is the amount of memory transferred, in bytes.
+	MemoryProcessedBytes int64 `json:"memory_processed_bytes"`
+
+	// MemoryRemainingBytes is the amount of memory left to transfer, in bytes.
+	MemoryRemainingBytes int64 `json:"memory_remaining_bytes"`
+
+	// DiskTotalBytes is the amount of disk to transfer, in bytes.
+	DiskTotalBytes int64 `json:"disk_total_bytes"`
+
+	// DiskProcessedBytes is the amount of disk transferred, in bytes.
+	DiskProcessedBytes int64 `json:"disk_processed_bytes"`
+
+	// DiskRemainingBytes is the amount of disk left to transfer, in bytes.
+	DiskRemainingBytes int64 `json:"disk_remaining_bytes"`
+
+	// UserID is the ID of the user who initiated the migration.
+	// This requires microversion 2.80 or later.
+	UserID string `json:"user_id"`
+
+	// ProjectID is the ID of the project of the migrated server.
+	// This requires microversion 2.80 or later.
+	ProjectID string `json:"project_id"`
+
+	// CreatedAt is the date and time when the migration was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date and time when the migration was last updated.
+	UpdatedAt time.Time `json:"-"`
+}
+
+// UnmarshalJSON converts our JSON API response into our server migration
+// struct.
+func (m *ServerMigration) UnmarshalJSON(b []byte) error {
+	type tmp ServerMigration
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*m = ServerMigration(s.tmp)
+
+	m.CreatedAt = time.Time(s.CreatedAt)
+	m.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
+
+// ServerMigrationPage is a single page of ServerMigration results.
+type ServerMigrationPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty returns true if a ServerMigrationPage contains no migrations.
+func (r ServerMigrationPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	migrations, err := ExtractServerMigrations(r)
+	return len(migrations) == 0, err
+}
+
+// ExtractServerMigrations interprets a page of results as a slice of
+// ServerMigration.
+func ExtractServerMigrations(r pagination.Page) ([]ServerMigration, error) {
+	var s struct {
+		Migrations []ServerMigration `json:"migrations"`
+	}
+	err := (r.(ServerMigrationPage)).ExtractInto(&s)
+	return s.Migrations, err
+}
+
+// GetForServerResult is the response from a GetForServer operation. Call its
+// Extract method to interpret it as a ServerMigration.
+type GetForServerResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetForServerResult as a ServerMigration.
+func (r GetForServerResult) Extract() (*ServerMigration, error) {
+	var s struct {
+		Migration *ServerMigration `json:"migration"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Migration, err
+}
+
+// ForceCompleteResult is the response from a ForceComplete operation. Call
+// its ExtractErr method to determine if the request succeeded or failed.
+type ForceCompleteResult struct {
+	gophercloud.ErrResult
+}
+
+// AbortResult is the response from an Abort operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type AbortResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,222 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/migrations"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+// ServerID is the ID of the server used in the tests.
+const ServerID = "b3f5c3a8-1f4e-4d0b-8a3a-1b2b3c4d5e6f"
+
+// ListOutput is a sample response to a List request.
+const ListOutput = `
+{
+    "migrations": [
+        {
+            "id": 1234,
+            "uuid": "42341d4b-346a-40d0-83c6-5f4f6892b650",
+            "instance_uuid": "b3f5c3a8-1f4e-4d0b-8a3a-1b2b3c4d5e6f",
+            "source_compute": "compute-01",
+            "source_node": "node-01",
+            "source_region": "RegionOne",
+            "dest_compute": "compute-02",
+            "dest_node": "node-02",
+            "dest_host": "10.0.0.12",
+            "dest_region": "RegionOne",
+            "old_instance_type_id": 1,
+            "new_instance_type_id": 1,
+            "status": "running",
+            "migration_type": "live-migration",
+            "user_id": "ef9d34b4-45d0-4b4d-a9a1-2bd4d2ef25b5",
+            "project_id": "011ee9f4-8f16-4c38-8633-a254d420fd54",
+            "created_at": "2024-05-21T10:12:30.000000",
+            "updated_at": "2024-05-21T10:13:00.000000",
+            "links": [
+                {
+                    "href": "http://openstack.example.com/v2.1/servers/b3f5c3a8-1f4e-4d0b-8a3a-1b2b3c4d5e6f/migrations/1234",
+                    "rel": "self"
+                }
+            ]
+        }
+    ],
+    "migrations_links": [
+        {
+            "href": "%s/os-migrations?marker=42341d4b-346a-40d0-83c6-5f4f6892b650",
+            "rel": "next"
+        }
+    ]
+}
+`
+
+// ListServerMigrationsOutput is a sample response to a ListForServer request.
+const ListServerMigrationsOutput = `
+{
+    "migrations": [
+        {
+            "id": 1234,
+            "uuid": "42341d4b-346a-40d0-83c6-5f4f6892b650",
+            "server_uuid": "b3f5c3a8-1f4e-4d0b-8a3a-1b2b3c4d5e6f",
+            "source_compute": "compute-01",
+            "source_node": "node-01",
+            "dest_compute": "compute-02",
+            "dest_node": "node-02",
+            "dest_host": "10.0.0.12",
+            "status": "running",
+            "memory_total_bytes": 123456,
+            "memory_processed_bytes": 12345,
+            "memory_remaining_bytes": 111111,
+            "disk_total_bytes": 234567,
+            "disk_processed_bytes": 23456,
+            "disk_remaining_bytes": 211111,
+            "user_id": "ef9d34b4-45d0-4b4d-a9a1-2bd4d2ef25b5",
+            "project_id": "011ee9f4-8f16-4c38-8633-a254d420fd54",
+            "created_at": "2024-05-21T10:12:30.000000",
+            "updated_at": "2024-05-21T10:13:00.000000"
+        }
+    ]
+}
+`
+
+// GetServerMigrationOutput is a sample response to a GetForServer request.
+const GetServerMigrationOutput = `
+{
+    "migration": {
+        "id": 1234,
+        "uuid": "42341d4b-346a-40d0-83c6-5f4f6892b650",
+        "server_uuid": "b3f5c3a8-1f4e-4d0b-8a3a-1b2b3c4d5e6f",
+        "source_compute": "compute-01",
+        "source_node": "node-01",
+        "dest_compute": "compute-02",
+        "dest_node": "node-02",
+        "dest_host": "10.0.0.12",
+        "status": "running",
+        "memory_total_bytes": 123456,
+        "memory_processed_bytes": 12345,
+        "memory_remaining_bytes": 111111,
+        "disk_total_bytes": 234567,
+        "disk_processed_bytes": 23456,
+        "disk_remaining_bytes": 211111,
+        "user_id": "ef9d34b4-45d0-4b4d-a9a1-2bd4d2ef25b5",
+        "project_id": "011ee9f4-8f16-4c38-8633-a254d420fd54",
+        "created_at": "2024-05-21T10:12:30.000000",
+        "updated_at": "2024-05-21T10:13:00.000000"
+    }
+}
+`
+
+// ExpectedMigration is the migration in ListOutput.
+var ExpectedMigration = migrations.Migration{
+	ID:                1234,
+	UUID:              "42341d4b-346a-40d0-83c6-5f4f6892b650",
+	InstanceUUID:      ServerID,
+	SourceCompute:     "compute-01",
+	SourceNode:        "node-01",
+	SourceRegion:      "RegionOne",
+	DestCompute:       "compute-02",
+	DestNode:          "node-02",
+	DestHost:          "10.0.0.12",
+	DestRegion:        "RegionOne",
+	OldInstanceTypeID: 1,
+	NewInstanceTypeID: 1,
+	Status:            "running",
+	MigrationType:     migrations.TypeLiveMigration,
+	UserID:            "ef9d34b4-45d0-4b4d-a9a1-2bd4d2ef25b5",
+	ProjectID:         "011ee9f4-8f16-4c38-8633-a254d420fd54",
+	CreatedAt:         time.Date(2024, 5, 21, 10, 12, 30, 0, time.UTC),
+	UpdatedAt:         time.Date(2024, 5, 21, 10, 13, 0, 0, time.UTC),
+}
+
+// ExpectedServerMigration is the migration in ListServerMigrationsOutput and
+// GetServerMigrationOutput.
+var ExpectedServerMigration = migrations.ServerMigration{
+	ID:                   1234,
+	UUID:                 "42341d4b-346a-40d0-83c6-5f4f6892b650",
+	ServerUUID:           ServerID,
+	SourceCompute:        "compute-01",
+	SourceNode:           "node-01",
+	DestCompute:          "compute-02",
+	DestNode:             "node-02",
+	DestHost:             "10.0.0.12",
+	Status:               "running",
+	MemoryTotalBytes:     123456,
+	MemoryProcessedBytes: 12345,
+	MemoryRemainingBytes: 111111,
+	DiskTotalBytes:       234567,
+	DiskProcessedBytes:   23456,
+	DiskRemainingBytes:   211111,
+	UserID:               "ef9d34b4-45d0-4b4d-a9a1-2bd4d2ef25b5",
+	ProjectID:            "011ee9f4-8f16-4c38-8633-a254d420fd54",
+	CreatedAt:            time.Date(2024, 5, 21, 10, 12, 30, 0, time.UTC),
+	UpdatedAt:            time.Date(2024, 5, 21, 10, 13, 0, 0, time.UTC),
+}
+
+// HandleListSuccessfully sets up the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-migrations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+		switch r.Form.Get("marker") {
+		case "":
+			th.AssertEquals(t, "compute-01", r.Form.Get("host"))
+			th.AssertEquals(t, "live-migration", r.Form.Get("migration_type"))
+			fmt.Fprintf(w, ListOutput, th.Server.URL)
+		case "42341d4b-346a-40d0-83c6-5f4f6892b650":
+			fmt.Fprint(w, `{"migrations": []}`)
+		default:
+			t.Fatalf("/os-migrations invoked with unexpected marker=[%s]", r.Form.Get("marker"))
+		}
+	})
+}
+
+// HandleListForServerSuccessfully sets up the test server to respond to a
+// ListForServer request.
+func HandleListForServerSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+ServerID+"/migrations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ListServerMigrationsOutput)
+	})
+}
+
+// HandleServerMigrationSuccessfully sets up the test server to respond to
+// GetForServer and Abort requests.
+func HandleServerMigrationSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+ServerID+"/migrations/1234", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprint(w, GetServerMigrationOutput)
+		case "DELETE":
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleForceCompleteSuccessfully sets up the test server to respond to a
+// ForceComplete request.
+func HandleForceCompleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+ServerID+"/migrations/1234/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"force_complete": null}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
@@ -0,0 +1,82 @@
+package testing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/migrations"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	listOpts := migrations.ListOpts{
+		Host:          "compute-01",
+		MigrationType: migrations.TypeLiveMigration,
+	}
+
+	allPages, err := migrations.List(client.ServiceClient(), listOpts).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := migrations.ExtractMigrations(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []migrations.Migration{ExpectedMigration}, actual)
+}
+
+func TestListOptsChanges(t *testing.T) {
+	since := time.Date(2024, 5, 21, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2024, 5, 22, 0, 0, 0, 0, time.UTC)
+	listOpts := migrations.ListOpts{
+		Status:        "completed",
+		ChangesSince:  &since,
+		ChangesBefore: &before,
+	}
+
+	query, err := listOpts.ToMigrationListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "?changes-before=2024-05-22T00%3A00%3A00Z&changes-since=2024-05-21T00%3A00%3A00Z&status=completed", query)
+}
+
+func TestListForServer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListForServerSuccessfully(t)
+
+	allPages, err := migrations.ListForServer(client.ServiceClient(), ServerID).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := migrations.ExtractServerMigrations(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []migrations.ServerMigration{ExpectedServerMigration}, actual)
+}
+
+func TestGetForServer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerMigrationSuccessfully(t)
+
+	actual, err := migrations.GetForServer(context.TODO(), client.ServiceClient(), ServerID, 1234).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ExpectedServerMigration, actual)
+}
+
+func TestForceComplete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleForceCompleteSuccessfully(t)
+
+	err := migrations.ForceComplete(context.TODO(), client.ServiceClient(), ServerID, 1234).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestAbort(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerMigrationSuccessfully(t)
+
+	err := migrations.Abort(context.TODO(), client.ServiceClient(), ServerID, 1234).ExtractErr()
+	th.AssertNoErr(t, err)
+}
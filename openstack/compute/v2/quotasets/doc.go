@@ -32,5 +32,35 @@ Example to Update a Quota Set
 	}
 
 	fmt.Printf("%+v\n", quotaset)
+
+Example to Get the Default Quota Set
+
+	quotaset, err := quotasets.GetDefaults(context.TODO(), computeClient, "tenant-id").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", quotaset)
+
+Example to Update the Quota Set of a User
+
+	updateOpts := quotasets.UpdateOpts{
+		Instances: gophercloud.IntToPointer(5),
+		Force:     true,
+	}
+
+	quotaset, err := quotasets.UpdateForUser(context.TODO(), computeClient, "tenant-id", "user-id", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", quotaset)
+
+Example to Reset the Quota Set of a User
+
+	_, err := quotasets.DeleteForUser(context.TODO(), computeClient, "tenant-id", "user-id").Extract()
+	if err != nil {
+		panic(err)
+	}
 */
 package quotasets
@@ -40,6 +40,50 @@ func Delete(ctx context.Context, client *gophercloud.ServiceClient, tenantID str
 	return
 }
 
+// GetDefaults returns the default quotas for the given tenantID.
+func GetDefaults(ctx context.Context, client *gophercloud.ServiceClient, tenantID string) (r GetDefaultsResult) {
+	resp, err := client.Get(ctx, getDefaultsURL(client, tenantID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetForUser returns the quotas of the given user within the given tenantID.
+func GetForUser(ctx context.Context, client *gophercloud.ServiceClient, tenantID, userID string) (r GetResult) {
+	resp, err := client.Get(ctx, userURL(client, tenantID, userID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetDetailForUser returns the detailed quotas of the given user within the
+// given tenantID.
+func GetDetailForUser(ctx context.Context, client *gophercloud.ServiceClient, tenantID, userID string) (r GetDetailResult) {
+	resp, err := client.Get(ctx, userDetailURL(client, tenantID, userID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateForUser updates the quotas of the given user within the given
+// tenantID and returns the new QuotaSet.
+func UpdateForUser(ctx context.Context, client *gophercloud.ServiceClient, tenantID, userID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	reqBody, err := opts.ToComputeQuotaUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Put(ctx, userURL(client, tenantID, userID), reqBody, &r.Body, &gophercloud.RequestOpts{OkCodes: []int{200}})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteForUser resets the quotas of the given user within the given
+// tenantID to the quotas of the tenant.
+func DeleteForUser(ctx context.Context, client *gophercloud.ServiceClient, tenantID, userID string) (r DeleteResult) {
+	resp, err := client.Delete(ctx, userURL(client, tenantID, userID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // Options for Updating the quotas of a Tenant.
 // All int-values are pointers so they can be nil if they are not needed.
 // You can use gopercloud.IntToPointer() for convenience
@@ -165,6 +165,12 @@ type GetResult struct {
 	quotaResult
 }
 
+// GetDefaultsResult is the response from a GetDefaults operation. Call its
+// Extract method to interpret it as a QuotaSet.
+type GetDefaultsResult struct {
+	quotaResult
+}
+
 // UpdateResult is the response from a Update operation. Call its Extract method
 // to interpret it as a QuotaSet.
 type UpdateResult struct {
@@ -213,3 +213,48 @@ func HandleDeleteSuccessfully(t *testing.T) {
 		w.WriteHeader(202)
 	})
 }
+
+// FirstUserID is the user ID used by the per-user quota requests.
+const FirstUserID = "9349aff8be7545ac9d2f1d00999a23cd"
+
+// HandleGetDefaultsSuccessfully configures the test server to respond to a Get Defaults request for sample tenant
+func HandleGetDefaultsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-quota-sets/"+FirstTenantID+"/defaults", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleUserQuotaSuccessfully configures the test server to respond to Get, Put and Delete requests for sample user of sample tenant
+func HandleUserQuotaSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-quota-sets/"+FirstTenantID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"user_id": FirstUserID})
+
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, GetOutput)
+		case "PUT":
+			th.TestJSONRequest(t, r, PartialUpdateBody)
+			fmt.Fprint(w, UpdateOutput)
+		case "DELETE":
+			w.WriteHeader(202)
+		default:
+			t.Fatalf("Unexpected method: %s", r.Method)
+		}
+	})
+}
+
+// HandleGetDetailForUserSuccessfully configures the test server to respond to a Get Details request for sample user of sample tenant
+func HandleGetDetailForUserSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-quota-sets/"+FirstTenantID+"/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"user_id": FirstUserID})
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetDetailsOutput)
+	})
+}
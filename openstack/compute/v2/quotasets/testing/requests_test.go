@@ -56,6 +56,42 @@ func TestDelete(t *testing.T) {
 	th.AssertNoErr(t, err)
 }
 
+func TestGetDefaults(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetDefaultsSuccessfully(t)
+	actual, err := quotasets.GetDefaults(context.TODO(), client.ServiceClient(), FirstTenantID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstQuotaSet, actual)
+}
+
+func TestUserQuota(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUserQuotaSuccessfully(t)
+
+	actual, err := quotasets.GetForUser(context.TODO(), client.ServiceClient(), FirstTenantID, FirstUserID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstQuotaSet, actual)
+
+	opts := quotasets.UpdateOpts{Cores: gophercloud.IntToPointer(200), Force: true}
+	actual, err = quotasets.UpdateForUser(context.TODO(), client.ServiceClient(), FirstTenantID, FirstUserID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstQuotaSet, actual)
+
+	err = quotasets.DeleteForUser(context.TODO(), client.ServiceClient(), FirstTenantID, FirstUserID).Err
+	th.AssertNoErr(t, err)
+}
+
+func TestGetDetailForUser(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetDetailForUserSuccessfully(t)
+	actual, err := quotasets.GetDetailForUser(context.TODO(), client.ServiceClient(), FirstTenantID, FirstUserID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstQuotaDetailsSet, actual)
+}
+
 type ErrorUpdateOpts quotasets.UpdateOpts
 
 func (opts ErrorUpdateOpts) ToComputeQuotaUpdateMap() (map[string]any, error) {
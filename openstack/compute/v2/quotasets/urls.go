@@ -1,6 +1,10 @@
 package quotasets
 
-import "github.com/vnpaycloud-console/gophercloud/v2"
+import (
+	"net/url"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
 
 const resourcePath = "os-quota-sets"
 
@@ -19,3 +23,15 @@ func updateURL(c *gophercloud.ServiceClient, tenantID string) string {
 func deleteURL(c *gophercloud.ServiceClient, tenantID string) string {
 	return getURL(c, tenantID)
 }
+
+func getDefaultsURL(c *gophercloud.ServiceClient, tenantID string) string {
+	return c.ServiceURL(resourcePath, tenantID, "defaults")
+}
+
+func userURL(c *gophercloud.ServiceClient, tenantID, userID string) string {
+	return getURL(c, tenantID) + "?" + url.Values{"user_id": {userID}}.Encode()
+}
+
+func userDetailURL(c *gophercloud.ServiceClient, tenantID, userID string) string {
+	return getDetailURL(c, tenantID) + "?" + url.Values{"user_id": {userID}}.Encode()
+}
@@ -21,5 +21,24 @@ Example of Creating a new RemoteConsole
 	}
 
 	fmt.Printf("Console URL: %s\n", remtoteConsole.URL)
+
+CreateWithFallback uses the legacy os-getVNCConsole, os-getSPICEConsole,
+os-getRDPConsole and os-getSerialConsole server actions when the client
+microversion is lower than 2.6.
+
+Example of Creating a RemoteConsole on Clouds without Microversion 2.6
+
+	createOpts := remoteconsoles.CreateOpts{
+	  Protocol: remoteconsoles.ConsoleProtocolVNC,
+	  Type:     remoteconsoles.ConsoleTypeNoVNC,
+	}
+	serverID := "b16ba811-199d-4ffd-8839-ba96c1185a67"
+
+	remoteConsole, err := remoteconsoles.CreateWithFallback(context.TODO(), computeClient, serverID, createOpts)
+	if err != nil {
+	  panic(err)
+	}
+
+	fmt.Printf("Console URL: %s\n", remoteConsole.URL)
 */
 package remoteconsoles
@@ -2,10 +2,25 @@ package remoteconsoles
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils"
 )
 
+// remoteConsolesMicroversion is the microversion introducing the
+// remote-consoles API.
+const remoteConsolesMicroversion = "2.6"
+
+// legacyActions maps console protocols to the server actions used to get a
+// console before the remote-consoles API.
+var legacyActions = map[ConsoleProtocol]string{
+	ConsoleProtocolVNC:    "os-getVNCConsole",
+	ConsoleProtocolSPICE:  "os-getSPICEConsole",
+	ConsoleProtocolRDP:    "os-getRDPConsole",
+	ConsoleProtocolSerial: "os-getSerialConsole",
+}
+
 // ConsoleProtocol represents valid remote console protocol.
 // It can be used to create a remote console with one of the pre-defined protocol.
 type ConsoleProtocol string
@@ -84,3 +99,74 @@ func Create(ctx context.Context, client *gophercloud.ServiceClient, serverID str
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// LegacyCreateOptsBuilder allows to add additional parameters to the
+// CreateLegacy request.
+type LegacyCreateOptsBuilder interface {
+	ToRemoteConsoleLegacyCreateMap() (map[string]any, error)
+}
+
+// ToRemoteConsoleLegacyCreateMap builds a server action request body from
+// the CreateOpts, e.g. {"os-getVNCConsole": {"type": "novnc"}}.
+func (opts CreateOpts) ToRemoteConsoleLegacyCreateMap() (map[string]any, error) {
+	if opts.Protocol == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "remoteconsoles.CreateOpts.Protocol"}
+	}
+	if opts.Type == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "remoteconsoles.CreateOpts.Type"}
+	}
+
+	action, ok := legacyActions[opts.Protocol]
+	if !ok {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "remoteconsoles.CreateOpts.Protocol"
+		err.Value = opts.Protocol
+		err.Info = fmt.Sprintf("protocol requires microversion %s or later", remoteConsolesMicroversion)
+		return nil, err
+	}
+
+	return map[string]any{
+		action: map[string]any{
+			"type": opts.Type,
+		},
+	}, nil
+}
+
+// CreateLegacy requests a console on the specified server through the
+// os-getVNCConsole, os-getSPICEConsole, os-getRDPConsole or
+// os-getSerialConsole server actions, which are available on clouds not
+// supporting microversion 2.6.
+func CreateLegacy(ctx context.Context, client *gophercloud.ServiceClient, serverID string, opts LegacyCreateOptsBuilder) (r LegacyCreateResult) {
+	reqBody, err := opts.ToRemoteConsoleLegacyCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Post(ctx, actionURL(client, serverID), reqBody, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateWithFallback requests a remote console on the specified server. It
+// uses Create when the client microversion is at least 2.6 and CreateLegacy
+// otherwise, so the same call works against older clouds.
+func CreateWithFallback(ctx context.Context, client *gophercloud.ServiceClient, serverID string, opts CreateOpts) (*RemoteConsole, error) {
+	supported, err := utils.MicroversionAtLeast(client.Microversion, remoteConsolesMicroversion)
+	if err != nil {
+		return nil, err
+	}
+
+	if supported {
+		return Create(ctx, client, serverID, opts).Extract()
+	}
+
+	console, err := CreateLegacy(ctx, client, serverID, opts).Extract()
+	if err == nil && console != nil {
+		console.Protocol = string(opts.Protocol)
+	}
+
+	return console, err
+}
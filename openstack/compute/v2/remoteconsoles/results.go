@@ -36,3 +36,19 @@ func (r commonResult) Extract() (*RemoteConsole, error) {
 	err := r.ExtractInto(&s)
 	return s.RemoteConsole, err
 }
+
+// LegacyCreateResult represents the result of a CreateLegacy operation. Call
+// its Extract method to interpret it as a RemoteConsole.
+type LegacyCreateResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a LegacyCreateResult as a RemoteConsole. The legacy
+// server actions don't return the console protocol.
+func (r LegacyCreateResult) Extract() (*RemoteConsole, error) {
+	var s struct {
+		Console *RemoteConsole `json:"console"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Console, err
+}
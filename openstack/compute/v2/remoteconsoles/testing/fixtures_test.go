@@ -20,3 +20,23 @@ const RemoteConsoleCreateResult = `
     }
 }
 `
+
+// LegacyVNCConsoleRequest represents a request to get a VNC console through
+// the os-getVNCConsole server action.
+const LegacyVNCConsoleRequest = `
+{
+    "os-getVNCConsole": {
+        "type": "novnc"
+    }
+}
+`
+
+// LegacyVNCConsoleResult represents a raw server response to the LegacyVNCConsoleRequest.
+const LegacyVNCConsoleResult = `
+{
+    "console": {
+        "type": "novnc",
+        "url": "http://192.168.0.4:6080/vnc_auto.html?token=9a2372b9-6a0e-4f71-aca1-56020e6bb677"
+    }
+}
+`
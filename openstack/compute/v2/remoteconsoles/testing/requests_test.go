@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/remoteconsoles"
 	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
 	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
@@ -39,3 +40,78 @@ func TestCreate(t *testing.T) {
 	th.AssertEquals(t, s.Type, string(remoteconsoles.ConsoleTypeNoVNC))
 	th.AssertEquals(t, s.URL, "http://192.168.0.4:6080/vnc_auto.html?token=9a2372b9-6a0e-4f71-aca1-56020e6bb677")
 }
+
+func TestCreateLegacy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/b16ba811-199d-4ffd-8839-ba96c1185a67/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, LegacyVNCConsoleRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, LegacyVNCConsoleResult)
+	})
+
+	opts := remoteconsoles.CreateOpts{
+		Protocol: remoteconsoles.ConsoleProtocolVNC,
+		Type:     remoteconsoles.ConsoleTypeNoVNC,
+	}
+	s, err := remoteconsoles.CreateLegacy(context.TODO(), fake.ServiceClient(), "b16ba811-199d-4ffd-8839-ba96c1185a67", opts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, s.Protocol, "")
+	th.AssertEquals(t, s.Type, string(remoteconsoles.ConsoleTypeNoVNC))
+	th.AssertEquals(t, s.URL, "http://192.168.0.4:6080/vnc_auto.html?token=9a2372b9-6a0e-4f71-aca1-56020e6bb677")
+}
+
+func TestCreateLegacyUnsupportedProtocol(t *testing.T) {
+	opts := remoteconsoles.CreateOpts{
+		Protocol: remoteconsoles.ConsoleProtocolMKS,
+		Type:     remoteconsoles.ConsoleTypeWebMKS,
+	}
+	_, err := opts.ToRemoteConsoleLegacyCreateMap()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("Expected ErrInvalidInput but got %v", err)
+	}
+}
+
+func TestCreateWithFallback(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/b16ba811-199d-4ffd-8839-ba96c1185a67/remote-consoles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, RemoteConsoleCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, RemoteConsoleCreateResult)
+	})
+
+	th.Mux.HandleFunc("/servers/b16ba811-199d-4ffd-8839-ba96c1185a67/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, LegacyVNCConsoleRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, LegacyVNCConsoleResult)
+	})
+
+	opts := remoteconsoles.CreateOpts{
+		Protocol: remoteconsoles.ConsoleProtocolVNC,
+		Type:     remoteconsoles.ConsoleTypeNoVNC,
+	}
+
+	for _, microversion := range []string{"", "2.1", "2.6", "latest"} {
+		client := fake.ServiceClient()
+		client.Microversion = microversion
+
+		s, err := remoteconsoles.CreateWithFallback(context.TODO(), client, "b16ba811-199d-4ffd-8839-ba96c1185a67", opts)
+		th.AssertNoErr(t, err)
+
+		th.AssertEquals(t, s.Protocol, string(remoteconsoles.ConsoleProtocolVNC))
+		th.AssertEquals(t, s.Type, string(remoteconsoles.ConsoleTypeNoVNC))
+	}
+}
@@ -15,3 +15,7 @@ func rootURL(c *gophercloud.ServiceClient, serverID string) string {
 func createURL(c *gophercloud.ServiceClient, serverID string) string {
 	return rootURL(c, serverID)
 }
+
+func actionURL(c *gophercloud.ServiceClient, serverID string) string {
+	return c.ServiceURL(rootPath, serverID, "action")
+}
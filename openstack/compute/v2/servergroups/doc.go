@@ -31,21 +31,27 @@ Example to Create a Server Group
 
 Example to Create a Server Group with additional microversion 2.64 fields
 
-		createOpts := servergroups.CreateOpts{
-			Name:   "my_sg",
-			Policy: "anti-affinity",
-	        	Rules: &servergroups.Rules{
-	            		MaxServerPerHost: 3,
-	        	},
-		}
-
-		computeClient.Microversion = "2.64"
-		result := servergroups.Create(context.TODO(), computeClient, createOpts)
-
-		serverGroup, err := result.Extract()
-		if err != nil {
-			panic(err)
-		}
+	createOpts := servergroups.CreateOpts{
+		Name:   "my_sg",
+		Policy: servergroups.PolicyAntiAffinity,
+		Rules: &servergroups.Rules{
+			MaxServerPerHost: 3,
+		},
+	}
+
+	computeClient.Microversion = "2.64"
+	result := servergroups.Create(context.TODO(), computeClient, createOpts)
+
+	serverGroup, err := result.Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Policy: %s\n", serverGroup.EffectivePolicy())
+
+Create rejects Policy and Rules when the client microversion is earlier than
+2.64, an unset microversion being the base 2.1 microversion. Rules are also
+rejected with any policy other than anti-affinity.
 
 Example to Delete a Server Group
 
@@ -2,11 +2,25 @@ package servergroups
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
 
+// Server group policies.
+const (
+	PolicyAffinity         = "affinity"
+	PolicyAntiAffinity     = "anti-affinity"
+	PolicySoftAffinity     = "soft-affinity"
+	PolicySoftAntiAffinity = "soft-anti-affinity"
+)
+
+// policyRulesMicroversion is the microversion replacing the policies list
+// with the policy and rules fields.
+const policyRulesMicroversion = "2.64"
+
 type ListOptsBuilder interface {
 	ToServerListQuery() (string, error)
 }
@@ -73,8 +87,70 @@ func (opts CreateOpts) ToServerGroupCreateMap() (map[string]any, error) {
 	return gophercloud.BuildRequestBody(opts, "server_group")
 }
 
-// Create requests the creation of a new Server Group.
+// baseMicroversion is the microversion used by a client without one set.
+const baseMicroversion = "2.1"
+
+// MicroversionValidator is implemented by options able to check that the
+// fields they set are supported by a given microversion. Create validates
+// options implementing it against the microversion of the client, treating
+// an unset microversion as the base 2.1 microversion.
+type MicroversionValidator interface {
+	ValidateForMicroversion(microversion string) error
+}
+
+// ValidateForMicroversion returns an error if Policy or Rules are set with a
+// microversion earlier than 2.64, or if Rules are set with a policy other
+// than anti-affinity.
+func (opts CreateOpts) ValidateForMicroversion(microversion string) error {
+	if microversion == "" {
+		microversion = baseMicroversion
+	}
+
+	policyRules, err := utils.MicroversionAtLeast(microversion, policyRulesMicroversion)
+	if err != nil {
+		return err
+	}
+
+	if !policyRules {
+		for _, field := range []struct {
+			argument string
+			set      bool
+		}{
+			{"Policy", opts.Policy != ""},
+			{"Rules", opts.Rules != nil},
+		} {
+			if field.set {
+				err := gophercloud.ErrInvalidInput{}
+				err.Argument = "servergroups.CreateOpts." + field.argument
+				err.Value = microversion
+				err.Info = fmt.Sprintf("requires microversion %s or later", policyRulesMicroversion)
+				return err
+			}
+		}
+		return nil
+	}
+
+	if opts.Rules != nil && opts.Policy != PolicyAntiAffinity {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "servergroups.CreateOpts.Rules"
+		err.Info = fmt.Sprintf("rules are only supported by the %s policy", PolicyAntiAffinity)
+		return err
+	}
+
+	return nil
+}
+
+// Create requests the creation of a new Server Group. If opts implements
+// MicroversionValidator, it is validated against the microversion of the
+// client first.
 func Create(ctx context.Context, client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	if v, ok := opts.(MicroversionValidator); ok {
+		if err := v.ValidateForMicroversion(client.Microversion); err != nil {
+			r.Err = err
+			return
+		}
+	}
+
 	b, err := opts.ToServerGroupCreateMap()
 	if err != nil {
 		r.Err = err
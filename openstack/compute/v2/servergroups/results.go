@@ -48,6 +48,19 @@ type ServerGroup struct {
 	Rules *Rules `json:"rules"`
 }
 
+// EffectivePolicy returns the policy of the server group regardless of the
+// microversion used to retrieve it: Policy starting with microversion 2.64,
+// or the first element of Policies before.
+func (sg ServerGroup) EffectivePolicy() string {
+	if sg.Policy != nil && *sg.Policy != "" {
+		return *sg.Policy
+	}
+	if len(sg.Policies) > 0 {
+		return sg.Policies[0]
+	}
+	return ""
+}
+
 // Rules represents set of rules for a policy.
 // This requires microversion 2.64 or later.
 type Rules struct {
@@ -224,6 +224,31 @@ func HandleCreateMicroversionSuccessfully(t *testing.T) {
 	})
 }
 
+// HandleCreatePolicyRulesSuccessfully configures the test server to respond
+// to a Create request sent with microversion 2.64, which only accepts the
+// policy and rules fields.
+func HandleCreatePolicyRulesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-server-groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "X-OpenStack-Nova-API-Version", "2.64")
+		th.TestJSONRequest(t, r, `
+{
+    "server_group": {
+        "name": "test",
+        "policy": "anti-affinity",
+        "rules": {
+            "max_server_per_host": 3
+        }
+    }
+}
+`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, CreateOutputMicroversion)
+	})
+}
+
 // HandleDeleteSuccessfully configures the test server to respond to a Delete request for a
 // an existing server group
 func HandleDeleteSuccessfully(t *testing.T) {
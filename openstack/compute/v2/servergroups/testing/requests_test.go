@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/servergroups"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
@@ -53,7 +54,10 @@ func TestCreateMicroversion(t *testing.T) {
 	CreatedServerGroup.Policy = &policy
 	CreatedServerGroup.Rules = &rules
 
-	result := servergroups.Create(context.TODO(), client.ServiceClient(), servergroups.CreateOpts{
+	computeClient := client.ServiceClient()
+	computeClient.Microversion = "2.64"
+
+	result := servergroups.Create(context.TODO(), computeClient, servergroups.CreateOpts{
 		Name:     "test",
 		Policies: []string{"anti-affinity"},
 		Policy:   policy,
@@ -103,3 +107,80 @@ func TestDelete(t *testing.T) {
 	err := servergroups.Delete(context.TODO(), client.ServiceClient(), "616fb98f-46ca-475e-917e-2563e5a8cd19").ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestCreatePolicyRules(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreatePolicyRulesSuccessfully(t)
+
+	computeClient := client.ServiceClient()
+	computeClient.Type = "compute"
+	computeClient.Microversion = "2.64"
+
+	result := servergroups.Create(context.TODO(), computeClient, servergroups.CreateOpts{
+		Name:   "test",
+		Policy: servergroups.PolicyAntiAffinity,
+		Rules: &servergroups.Rules{
+			MaxServerPerHost: 3,
+		},
+	})
+
+	actual, err := result.Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, servergroups.PolicyAntiAffinity, actual.EffectivePolicy())
+	th.AssertEquals(t, 3, actual.Rules.MaxServerPerHost)
+}
+
+func TestCreateRulesBaseMicroversionNotAllowed(t *testing.T) {
+	result := servergroups.Create(context.TODO(), client.ServiceClient(), servergroups.CreateOpts{
+		Name:   "test",
+		Policy: servergroups.PolicyAntiAffinity,
+		Rules: &servergroups.Rules{
+			MaxServerPerHost: 3,
+		},
+	})
+
+	invalid, ok := result.Err.(gophercloud.ErrInvalidInput)
+	if !ok {
+		t.Fatalf("expected ErrInvalidInput, got %v", result.Err)
+	}
+	th.AssertEquals(t, "2.1", invalid.Value)
+}
+
+func TestCreateOptsValidateForMicroversion(t *testing.T) {
+	opts := servergroups.CreateOpts{
+		Name:     "test",
+		Policies: []string{servergroups.PolicyAffinity},
+	}
+	th.AssertNoErr(t, opts.ValidateForMicroversion("2.15"))
+
+	opts = servergroups.CreateOpts{
+		Name:   "test",
+		Policy: servergroups.PolicyAffinity,
+	}
+	th.AssertNoErr(t, opts.ValidateForMicroversion("latest"))
+
+	err := opts.ValidateForMicroversion("2.63")
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("expected ErrInvalidInput for policy before 2.64, got %v", err)
+	}
+
+	opts.Rules = &servergroups.Rules{MaxServerPerHost: 2}
+
+	err = opts.ValidateForMicroversion("2.64")
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("expected ErrInvalidInput for rules with affinity, got %v", err)
+	}
+
+	opts.Policy = servergroups.PolicyAntiAffinity
+	th.AssertNoErr(t, opts.ValidateForMicroversion("2.64"))
+}
+
+func TestEffectivePolicy(t *testing.T) {
+	sg := servergroups.ServerGroup{Policies: []string{servergroups.PolicySoftAffinity}}
+	th.AssertEquals(t, servergroups.PolicySoftAffinity, sg.EffectivePolicy())
+
+	policy := servergroups.PolicySoftAntiAffinity
+	sg = servergroups.ServerGroup{Policy: &policy}
+	th.AssertEquals(t, servergroups.PolicySoftAntiAffinity, sg.EffectivePolicy())
+}
@@ -16,13 +16,38 @@ Example to List Servers
 		panic(err)
 	}
 
-	allServers, err := servers.ExtractServers(allPages)
+	allServers, err := servers.ExtractServerSummaries(allPages)
 	if err != nil {
 		panic(err)
 	}
 
 	for _, server := range allServers {
-		fmt.Printf("%+v\n", server)
+		fmt.Printf("%s: %s\n", server.ID, server.Name)
+	}
+
+The Compute API has no parameter to select the fields of the listed
+servers. To only decode the fields needed, extract the pages of List into a
+custom structure with ExtractServersInto, one page at a time:
+
+	type serverStatus struct {
+		ID     string `json:"id"`
+		Status string `json:"status"`
+	}
+
+	err := servers.List(computeClient, nil).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		var statuses []serverStatus
+		if err := servers.ExtractServersInto(page, &statuses); err != nil {
+			return false, err
+		}
+
+		for _, s := range statuses {
+			fmt.Printf("%s: %s\n", s.ID, s.Status)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		panic(err)
 	}
 
 Example to List Detail Servers
@@ -58,6 +83,25 @@ Example to Create a Server
 		panic(err)
 	}
 
+Example to Create a Server and Wait for it to Become Active
+
+	createOpts := servers.CreateOpts{
+		Name:      "server_name",
+		ImageRef:  "image-uuid",
+		FlavorRef: "flavor-uuid",
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Minute)
+	defer cancel()
+
+	server, err := servers.CreateAndWait(ctx, computeClient, createOpts, nil)
+	if serverFault, ok := err.(servers.ErrServerFault); ok {
+		fmt.Printf("Server %s failed to build: %s\n", server.ID, serverFault.Fault.Message)
+	}
+	if err != nil {
+		panic(err)
+	}
+
 Example to Add a Server to a Server Group
 
 	schedulerHintOpts := servers.SchedulerHintOpts{
@@ -252,6 +296,87 @@ Example to Force Delete a Server
 		panic(err)
 	}
 
+Example to Get the Floating IPv4 Addresses of a Server
+
+	server, err := servers.Get(context.TODO(), computeClient, "d9072956-1560-487c-97f2-18bdf65ec749").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	addresses, err := server.FilterAddresses(servers.AddressFilter{
+		Version: 4,
+		Type:    servers.AddressTypeFloating,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, address := range addresses {
+		fmt.Println(address.Address)
+	}
+
+# Example to Restore a soft-deleted Server
+
+When the cloud reclaims deleted servers after an interval, deleted servers are
+kept in the SOFT_DELETED status until then and can be listed and restored.
+
+	listOpts := servers.ListOpts{
+		Deleted: true,
+		Status:  "SOFT_DELETED",
+	}
+
+	allPages, err := servers.List(computeClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	deletedServers, err := servers.ExtractServers(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, server := range deletedServers {
+		err := servers.Restore(context.TODO(), computeClient, server.ID).ExtractErr()
+		if err != nil {
+			panic(err)
+		}
+	}
+
+# Example to Retrieve and Clear the Password of a Windows Server
+
+The password generated by the guest is encrypted with the public key of the
+key pair of the server and can be decrypted with the private key.
+
+	serverID := "d9072956-1560-487c-97f2-18bdf65ec749"
+
+	block, _ := pem.Decode(privateKeyPEM)
+	privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		panic(err)
+	}
+
+	password, err := servers.GetPassword(context.TODO(), computeClient, serverID).ExtractPassword(privateKey)
+	if err != nil {
+		panic(err)
+	}
+
+	err = servers.ClearPassword(context.TODO(), computeClient, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Lock a Server with a Reason
+
+	serverID := "d9072956-1560-487c-97f2-18bdf65ec749"
+
+	computeClient.Microversion = "2.73"
+	err := servers.LockWithOpts(context.TODO(), computeClient, serverID, servers.LockOpts{
+		Reason: "maintenance",
+	}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Reboot a Server
 
 	rebootOpts := servers.RebootOpts{
@@ -268,13 +393,32 @@ Example to Reboot a Server
 Example to Rebuild a Server
 
 	rebuildOpts := servers.RebuildOpts{
-		Name:    "new_name",
-		ImageID: "image-uuid",
+		Name:     "new_name",
+		ImageRef: "image-uuid",
+	}
+
+	serverID := "d9072956-1560-487c-97f2-18bdf65ec749"
+
+	server, err := servers.Rebuild(context.TODO(), computeClient, serverID, rebuildOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+# Example to Rebuild a Server and unset its Key Pair
+
+Fields requiring a newer microversion are rejected before the request is sent
+when the microversion of the client is too old.
+
+	keyName := ""
+	rebuildOpts := servers.RebuildOpts{
+		ImageRef: "image-uuid",
+		KeyName:  &keyName,
 	}
 
 	serverID := "d9072956-1560-487c-97f2-18bdf65ec749"
 
-	server, err := servers.Rebuilt(computeClient, serverID, rebuildOpts).Extract()
+	computeClient.Microversion = "2.54"
+	server, err := servers.Rebuild(context.TODO(), computeClient, serverID, rebuildOpts).Extract()
 	if err != nil {
 		panic(err)
 	}
@@ -309,5 +453,79 @@ Example to Snapshot a Server
 	if err != nil {
 		panic(err)
 	}
+
+Example to Migrate a Server to a Given Host
+
+	migrateOpts := servers.MigrateOpts{
+		Host: "compute-02",
+	}
+
+	serverID := "d9072956-1560-487c-97f2-18bdf65ec749"
+
+	computeClient.Microversion = "2.56"
+	err := servers.MigrateWithOpts(context.TODO(), computeClient, serverID, migrateOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Once the server is in the VERIFY_RESIZE state, the migration is confirmed with
+ConfirmResize or reverted with RevertResize. The migrations package lists
+the migrations of the server.
+
+Example to Shelve Offload and Unshelve a Server on a Given Host
+
+	serverID := "d9072956-1560-487c-97f2-18bdf65ec749"
+
+	err := servers.ShelveOffload(context.TODO(), computeClient, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	err = servers.WaitForShelvedOffloaded(context.TODO(), computeClient, serverID)
+	if err != nil {
+		panic(err)
+	}
+
+	unshelveOpts := servers.UnshelveOpts{
+		Host: "compute-02",
+	}
+
+	computeClient.Microversion = "2.91"
+	err = servers.Unshelve(context.TODO(), computeClient, serverID, unshelveOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get the NUMA Topology of a Server
+
+	serverID := "d9072956-1560-487c-97f2-18bdf65ec749"
+
+	computeClient.Microversion = "2.78"
+	topology, err := servers.GetTopology(context.TODO(), computeClient, serverID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, node := range topology.Nodes {
+		fmt.Printf("vCPUs %v pinned to %v\n", node.VCPUSet, node.CPUPinning)
+	}
+
+Example to Rescue a Server
+
+	rescueOpts := servers.RescueOpts{
+		RescueImageRef: "f90f6034-2570-4974-8351-6b49732ef2eb",
+	}
+
+	serverID := "d9072956-1560-487c-97f2-18bdf65ec749"
+
+	adminPass, err := servers.Rescue(context.TODO(), computeClient, serverID, rescueOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = servers.WaitForRescue(context.TODO(), computeClient, serverID)
+	if err != nil {
+		panic(err)
+	}
 */
 package servers
@@ -69,3 +69,17 @@ type ErrServerNotFound struct {
 func (e ErrServerNotFound) Error() string {
 	return fmt.Sprintf("I couldn't find server [%s]", e.ID)
 }
+
+// ErrServerFault is the error returned by CreateAndWait,
+// WaitForShelvedOffloaded and WaitForRescue when a server goes to the ERROR
+// status before reaching the expected status.
+type ErrServerFault struct {
+	gophercloud.BaseError
+	ID     string
+	Status string
+	Fault  Fault
+}
+
+func (e ErrServerFault) Error() string {
+	return fmt.Sprintf("Server [%s] went to ERROR while waiting for %s: %s (code %d)", e.ID, e.Status, e.Fault.Message, e.Fault.Code)
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
 
@@ -91,6 +92,15 @@ type ListOpts struct {
 
 	// Display servers based on their availability zone (Admin only until microversion 2.82).
 	AvailabilityZone string `q:"availability_zone"`
+
+	// Deleted lists deleted servers, including the SOFT_DELETED servers which
+	// can still be restored when the cloud reclaims deleted servers after an
+	// interval. Admin only by default.
+	Deleted bool `q:"deleted"`
+
+	// Locked filters on the lock status of the servers.
+	// This requires the client to be set to microversion 2.73 or later.
+	Locked *bool `q:"locked"`
 }
 
 // ToServerListQuery formats a ListOpts into a query string.
@@ -100,6 +110,9 @@ func (opts ListOpts) ToServerListQuery() (string, error) {
 }
 
 // ListSimple makes a request against the API to list servers accessible to you.
+// Only the ID, name and links of the servers are returned, which makes the
+// response much smaller than the one of List. Use ExtractServerSummaries to
+// interpret its pages.
 func ListSimple(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
 	url := listURL(client)
 	if opts != nil {
@@ -510,7 +523,16 @@ type CreateOpts struct {
 	DiskConfig DiskConfig `json:"OS-DCF:diskConfig,omitempty"`
 
 	// HypervisorHostname is the name of the hypervisor to which the server is scheduled.
+	// Requires microversion 2.74 or later.
 	HypervisorHostname string `json:"hypervisor_hostname,omitempty"`
+
+	// Host is the name of the compute service host to which the server is
+	// scheduled. Requires microversion 2.74 or later.
+	Host string `json:"host,omitempty"`
+
+	// TrustedImageCertificates is a list of certificate IDs used to verify
+	// the signature of the image. Requires microversion 2.63 or later.
+	TrustedImageCertificates []string `json:"trusted_image_certificates,omitempty"`
 }
 
 // ToServerCreateMap assembles a request body based on the contents of a
@@ -584,8 +606,83 @@ func (opts CreateOpts) ToServerCreateMap() (map[string]any, error) {
 	return b, nil
 }
 
+// MicroversionValidator is implemented by options able to check that the
+// fields they set are supported by a given microversion. Create, Update and
+// Rebuild validate options implementing it against the microversion of the
+// client, treating an unset microversion as the base 2.1 microversion.
+type MicroversionValidator interface {
+	ValidateForMicroversion(microversion string) error
+}
+
+// ValidateForMicroversion returns an error if a field of the CreateOpts
+// requiring a later microversion than the given one is set.
+func (opts CreateOpts) ValidateForMicroversion(microversion string) error {
+	gated := []struct {
+		argument     string
+		set          bool
+		microversion string
+	}{
+		{"Tags", len(opts.Tags) > 0, "2.52"},
+		{"TrustedImageCertificates", len(opts.TrustedImageCertificates) > 0, "2.63"},
+		{"Host", opts.Host != "", "2.74"},
+		{"HypervisorHostname", opts.HypervisorHostname != "", "2.74"},
+		{"Hostname", opts.Hostname != "", "2.90"},
+	}
+	for _, field := range gated {
+		if !field.set {
+			continue
+		}
+		if err := requireMicroversion(microversion, field.microversion, "servers.CreateOpts."+field.argument); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// baseMicroversion is the microversion used by a client without one set.
+const baseMicroversion = "2.1"
+
+// requireMicroversion returns an error naming argument if microversion is
+// earlier than required. An empty microversion is the base 2.1 microversion.
+func requireMicroversion(microversion, required, argument string) error {
+	if microversion == "" {
+		microversion = baseMicroversion
+	}
+
+	ok, err := utils.MicroversionAtLeast(microversion, required)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return nil
+	}
+
+	invalid := gophercloud.ErrInvalidInput{}
+	invalid.Argument = argument
+	invalid.Value = microversion
+	invalid.Info = fmt.Sprintf("requires microversion %s or later", required)
+	return invalid
+}
+
+// validateForMicroversion validates opts against microversion if it
+// implements MicroversionValidator.
+func validateForMicroversion(opts any, microversion string) error {
+	if v, ok := opts.(MicroversionValidator); ok {
+		return v.ValidateForMicroversion(microversion)
+	}
+	return nil
+}
+
 // Create requests a server to be provisioned to the user in the current tenant.
+// If opts implements MicroversionValidator, it is validated against the
+// microversion of the client first.
 func Create(ctx context.Context, client *gophercloud.ServiceClient, opts CreateOptsBuilder, hintOpts SchedulerHintOptsBuilder) (r CreateResult) {
+	if err := validateForMicroversion(opts, client.Microversion); err != nil {
+		r.Err = err
+		return
+	}
+
 	b, err := opts.ToServerCreateMap()
 	if err != nil {
 		r.Err = err
@@ -623,6 +720,15 @@ func ForceDelete(ctx context.Context, client *gophercloud.ServiceClient, id stri
 	return
 }
 
+// Restore restores a server in the SOFT_DELETED status, which only happens
+// when the cloud reclaims deleted servers after an interval. Once the
+// interval has elapsed, the server is deleted and can't be restored anymore.
+func Restore(ctx context.Context, client *gophercloud.ServiceClient, id string) (r RestoreResult) {
+	resp, err := client.Post(ctx, actionURL(client, id), map[string]any{"restore": nil}, nil, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // Get requests details on a single server, by ID.
 func Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (r GetResult) {
 	resp, err := client.Get(ctx, getURL(client, id), &r.Body, &gophercloud.RequestOpts{
@@ -651,6 +757,10 @@ type UpdateOpts struct {
 
 	// AccessIPv6 provides a new IPv6 address for the instance.
 	AccessIPv6 string `json:"accessIPv6,omitempty"`
+
+	// Hostname changes the hostname configured for the instance in the
+	// metadata service. Requires microversion 2.90 or later.
+	Hostname *string `json:"hostname,omitempty"`
 }
 
 // ToServerUpdateMap formats an UpdateOpts structure into a request body.
@@ -658,8 +768,24 @@ func (opts UpdateOpts) ToServerUpdateMap() (map[string]any, error) {
 	return gophercloud.BuildRequestBody(opts, "server")
 }
 
+// ValidateForMicroversion returns an error if a field of the UpdateOpts
+// requiring a later microversion than the given one is set.
+func (opts UpdateOpts) ValidateForMicroversion(microversion string) error {
+	if opts.Hostname != nil {
+		return requireMicroversion(microversion, "2.90", "servers.UpdateOpts.Hostname")
+	}
+	return nil
+}
+
 // Update requests that various attributes of the indicated server be changed.
+// If opts implements MicroversionValidator, it is validated against the
+// microversion of the client first.
 func Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	if err := validateForMicroversion(opts, client.Microversion); err != nil {
+		r.Err = err
+		return
+	}
+
 	b, err := opts.ToServerUpdateMap()
 	if err != nil {
 		r.Err = err
@@ -774,6 +900,14 @@ type RebuildOpts struct {
 
 	// DiskConfig controls how the rebuilt server's disk is partitioned.
 	DiskConfig DiskConfig `json:"OS-DCF:diskConfig,omitempty"`
+
+	// KeyName [optional] replaces the key pair of the server. An empty string
+	// unsets the key pair. Requires microversion 2.54 or later.
+	KeyName *string `json:"-"`
+
+	// TrustedImageCertificates [optional] is a list of certificate IDs used to
+	// verify the signature of the image. Requires microversion 2.63 or later.
+	TrustedImageCertificates []string `json:"trusted_image_certificates,omitempty"`
 }
 
 // ToServerRebuildMap formats a RebuildOpts struct into a map for use in JSON
@@ -790,12 +924,43 @@ func (opts RebuildOpts) ToServerRebuildMap() (map[string]any, error) {
 		return nil, err
 	}
 
+	if opts.KeyName != nil {
+		if *opts.KeyName == "" {
+			b["key_name"] = nil
+		} else {
+			b["key_name"] = *opts.KeyName
+		}
+	}
+
 	return map[string]any{"rebuild": b}, nil
 }
 
+// ValidateForMicroversion returns an error if a field of the RebuildOpts
+// requiring a later microversion than the given one is set.
+func (opts RebuildOpts) ValidateForMicroversion(microversion string) error {
+	if opts.KeyName != nil {
+		if err := requireMicroversion(microversion, "2.54", "servers.RebuildOpts.KeyName"); err != nil {
+			return err
+		}
+	}
+	if len(opts.TrustedImageCertificates) > 0 {
+		if err := requireMicroversion(microversion, "2.63", "servers.RebuildOpts.TrustedImageCertificates"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Rebuild will reprovision the server according to the configuration options
-// provided in the RebuildOpts struct.
+// provided in the RebuildOpts struct. If opts implements
+// MicroversionValidator, it is validated against the microversion of the
+// client first.
 func Rebuild(ctx context.Context, client *gophercloud.ServiceClient, id string, opts RebuildOptsBuilder) (r RebuildResult) {
+	if err := validateForMicroversion(opts, client.Microversion); err != nil {
+		r.Err = err
+		return
+	}
+
 	b, err := opts.ToServerRebuildMap()
 	if err != nil {
 		r.Err = err
@@ -1059,6 +1224,24 @@ func GetPassword(ctx context.Context, client *gophercloud.ServiceClient, serverI
 	return
 }
 
+// GetTopology returns the NUMA topology of a server, given its ID.
+// Requires microversion 2.78 or later. The host NUMA node and the CPU pinning
+// are only returned to administrators.
+func GetTopology(ctx context.Context, client *gophercloud.ServiceClient, id string) (r GetTopologyResult) {
+	resp, err := client.Get(ctx, topologyURL(client, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ClearPassword makes a request against the nova API to clear the encrypted
+// administrative password from the metadata service. It doesn't change the
+// password of the server.
+func ClearPassword(ctx context.Context, client *gophercloud.ServiceClient, serverId string) (r ClearPasswordResult) {
+	resp, err := client.Delete(ctx, passwordURL(client, serverId), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // ShowConsoleOutputOptsBuilder is the interface types must satisfy in order to be
 // used as ShowConsoleOutput options
 type ShowConsoleOutputOptsBuilder interface {
@@ -1145,6 +1328,45 @@ func Lock(ctx context.Context, client *gophercloud.ServiceClient, id string) (r
 	return
 }
 
+// LockOptsBuilder allows extensions to add additional parameters to the
+// LockWithOpts request.
+type LockOptsBuilder interface {
+	ToServerLockMap() (map[string]any, error)
+}
+
+// LockOpts specifies parameters of a Lock operation.
+type LockOpts struct {
+	// Reason is the reason the server is locked for.
+	// This requires microversion 2.73 or later.
+	Reason string `json:"locked_reason,omitempty"`
+}
+
+// ToServerLockMap formats a LockOpts as a map that can be used as a JSON
+// request body for the LockWithOpts request.
+func (opts LockOpts) ToServerLockMap() (map[string]any, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return map[string]any{"lock": nil}, nil
+	}
+	return map[string]any{"lock": b}, nil
+}
+
+// LockWithOpts is the operation responsible for locking a Compute server
+// with additional parameters, such as the reason of the lock.
+func LockWithOpts(ctx context.Context, client *gophercloud.ServiceClient, id string, opts LockOptsBuilder) (r LockResult) {
+	b, err := opts.ToServerLockMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, actionURL(client, id), b, nil, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // Unlock is the operation responsible for unlocking a Compute server.
 func Unlock(ctx context.Context, client *gophercloud.ServiceClient, id string) (r UnlockResult) {
 	resp, err := client.Post(ctx, actionURL(client, id), map[string]any{"unlock": nil}, nil, nil)
@@ -1152,6 +1374,15 @@ func Unlock(ctx context.Context, client *gophercloud.ServiceClient, id string) (
 	return
 }
 
+// TriggerCrashDump triggers a crash dump in the guest of a server, usually
+// through a non-maskable interrupt, for the kernel to dump its memory.
+// This requires microversion 2.17 or later.
+func TriggerCrashDump(ctx context.Context, client *gophercloud.ServiceClient, id string) (r TriggerCrashDumpResult) {
+	resp, err := client.Post(ctx, actionURL(client, id), map[string]any{"trigger_crash_dump": nil}, nil, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // Migrate will initiate a migration of the instance to another host.
 func Migrate(ctx context.Context, client *gophercloud.ServiceClient, id string) (r MigrateResult) {
 	resp, err := client.Post(ctx, actionURL(client, id), map[string]any{"migrate": nil}, nil, nil)
@@ -1159,6 +1390,41 @@ func Migrate(ctx context.Context, client *gophercloud.ServiceClient, id string)
 	return
 }
 
+// MigrateOptsBuilder allows extensions to add additional parameters to the
+// MigrateWithOpts request.
+type MigrateOptsBuilder interface {
+	ToServerMigrateMap() (map[string]any, error)
+}
+
+// MigrateOpts specifies parameters of migrate action.
+type MigrateOpts struct {
+	// The host to which to migrate the server.
+	// If this parameter is empty, the scheduler chooses a host.
+	// This requires microversion 2.56 or later.
+	Host string `json:"host,omitempty"`
+}
+
+// ToServerMigrateMap constructs a request body from MigrateOpts.
+func (opts MigrateOpts) ToServerMigrateMap() (map[string]any, error) {
+	if opts.Host == "" {
+		return map[string]any{"migrate": nil}, nil
+	}
+	return gophercloud.BuildRequestBody(opts, "migrate")
+}
+
+// MigrateWithOpts will initiate a cold migration of the instance to another
+// host, optionally chosen by the caller.
+func MigrateWithOpts(ctx context.Context, client *gophercloud.ServiceClient, id string, opts MigrateOptsBuilder) (r MigrateResult) {
+	b, err := opts.ToServerMigrateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, actionURL(client, id), b, nil, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // LiveMigrateOptsBuilder allows extensions to add additional parameters to the
 // LiveMigrate request.
 type LiveMigrateOptsBuilder interface {
@@ -1314,17 +1580,21 @@ type UnshelveOpts struct {
 	// Sets the availability zone to unshelve a server
 	// Available only after nova 2.77
 	AvailabilityZone string `json:"availability_zone,omitempty"`
+
+	// Sets the host to unshelve a shelved offloaded server on.
+	// Available only after nova 2.91
+	Host string `json:"host,omitempty"`
 }
 
 func (opts UnshelveOpts) ToUnshelveMap() (map[string]any, error) {
-	// Key 'availabilty_zone' is required if the unshelve action is an object
-	// i.e {"unshelve": {}} will be rejected
+	// One of the keys 'availabilty_zone' or 'host' is required if the
+	// unshelve action is an object i.e {"unshelve": {}} will be rejected
 	b, err := gophercloud.BuildRequestBody(opts, "unshelve")
 	if err != nil {
 		return nil, err
 	}
 
-	if _, ok := b["unshelve"].(map[string]any)["availability_zone"]; !ok {
+	if len(b["unshelve"].(map[string]any)) == 0 {
 		b["unshelve"] = nil
 	}
 
@@ -1370,3 +1640,22 @@ func Resume(ctx context.Context, client *gophercloud.ServiceClient, id string) (
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// IDFromName is a convenience function that returns a server's ID given its
+// name. It returns a gophercloud.ErrResourceNotFound if no server matches the
+// name, and a gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	pages, err := ListSimple(client, ListOpts{Name: name}).AllPages(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	all, err := ExtractServers(pages)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.IDFromName(all, name, "server", func(r Server) (string, string) {
+		return r.Name, r.ID
+	})
+}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"net/url"
 	"path"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
@@ -112,6 +114,56 @@ func (r GetPasswordResult) ExtractPassword(privateKey *rsa.PrivateKey) (string,
 	return s.Password, err
 }
 
+// GetTopologyResult is the response from a GetTopology operation. Call its
+// Extract method to interpret it as a Topology.
+type GetTopologyResult struct {
+	gophercloud.Result
+}
+
+// Topology is the NUMA topology of a server.
+type Topology struct {
+	// Nodes are the NUMA nodes of the server.
+	Nodes []TopologyNode `json:"nodes"`
+
+	// PagesizeKB is the page size of the server memory in KiB, or 0 if the
+	// server doesn't use huge pages.
+	PagesizeKB int `json:"pagesize_kb"`
+}
+
+// TopologyNode is a NUMA node of a server.
+type TopologyNode struct {
+	// CPUPinning maps the guest vCPUs of the node to the host CPUs they are
+	// pinned to. Only returned to administrators, and empty if the server
+	// doesn't use dedicated CPUs.
+	CPUPinning map[int]int `json:"cpu_pinning"`
+
+	// HostNode is the host NUMA node the node is placed on. Only returned to
+	// administrators.
+	HostNode *int `json:"host_node"`
+
+	// MemoryMB is the amount of memory of the node in MiB.
+	MemoryMB int `json:"memory_mb"`
+
+	// Siblings lists the groups of vCPUs which are thread siblings.
+	Siblings [][]int `json:"siblings"`
+
+	// VCPUSet is the set of guest vCPUs of the node.
+	VCPUSet []int `json:"vcpu_set"`
+}
+
+// Extract interprets a GetTopologyResult as a Topology.
+func (r GetTopologyResult) Extract() (*Topology, error) {
+	var s Topology
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ClearPasswordResult is the response from a ClearPassword operation. Call
+// its ExtractErr method to determine if the request succeeded or failed.
+type ClearPasswordResult struct {
+	gophercloud.ErrResult
+}
+
 func decryptPassword(encryptedPassword string, privateKey *rsa.PrivateKey) (string, error) {
 	b64EncryptedPassword := make([]byte, base64.StdEncoding.DecodedLen(len(encryptedPassword)))
 
@@ -283,6 +335,10 @@ type Server struct {
 	// Locked indicates the lock status of the server
 	// This requires microversion 2.9 or later
 	Locked *bool `json:"locked"`
+
+	// LockedReason is the reason the server is locked for, if any.
+	// This requires microversion 2.73 or later
+	LockedReason *string `json:"locked_reason"`
 }
 
 type AttachedVolume struct {
@@ -406,6 +462,27 @@ func ExtractServers(r pagination.Page) ([]Server, error) {
 	return s, err
 }
 
+// ServerSummary represents a server as returned by ListSimple, which only
+// includes its ID, name and links.
+type ServerSummary struct {
+	// ID uniquely identifies this server amongst all other servers.
+	ID string `json:"id"`
+
+	// Name contains the human-readable name for the server.
+	Name string `json:"name"`
+
+	// Links includes HTTP references to the itself.
+	Links []gophercloud.Link `json:"links"`
+}
+
+// ExtractServerSummaries interprets the results of a single page from a
+// ListSimple call, producing a slice of ServerSummary entities.
+func ExtractServerSummaries(r pagination.Page) ([]ServerSummary, error) {
+	var s []ServerSummary
+	err := ExtractServersInto(r, &s)
+	return s, err
+}
+
 // MetadataResult contains the result of a call for (potentially) multiple
 // key-value pairs. Call its Extract method to interpret it as a
 // map[string]interface.
@@ -473,10 +550,92 @@ func (r MetadatumResult) Extract() (map[string]string, error) {
 	return s.Metadatum, err
 }
 
+// Address types reported by the OS-EXT-IPS:type attribute of a server
+// address.
+const (
+	AddressTypeFixed    = "fixed"
+	AddressTypeFloating = "floating"
+)
+
 // Address represents an IP address.
 type Address struct {
 	Version int    `json:"version"`
 	Address string `json:"addr"`
+
+	// Type is the type of the address, either AddressTypeFixed or
+	// AddressTypeFloating. It is only set in the addresses of a Server.
+	Type string `json:"OS-EXT-IPS:type,omitempty"`
+
+	// MACAddr is the MAC address of the interface the address is bound to.
+	// It is only set in the addresses of a Server.
+	MACAddr string `json:"OS-EXT-IPS-MAC:mac_addr,omitempty"`
+}
+
+// AddressFilter selects addresses of a server in FilterAddresses. Empty fields
+// match any address.
+type AddressFilter struct {
+	// Network is the name of the network the address belongs to.
+	Network string
+
+	// Version is the IP version of the address, 4 or 6.
+	Version int
+
+	// Type is the type of the address, AddressTypeFixed or
+	// AddressTypeFloating.
+	Type string
+
+	// MACAddr is the MAC address of the interface the address is bound to.
+	// It is compared case-insensitively.
+	MACAddr string
+}
+
+// ExtractAddresses interprets the Addresses of a server as a map of addresses
+// keyed by network name.
+func (r Server) ExtractAddresses() (map[string][]Address, error) {
+	b, err := json.Marshal(r.Addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	var addresses map[string][]Address
+	err = json.Unmarshal(b, &addresses)
+	return addresses, err
+}
+
+// FilterAddresses returns the addresses of a server matching the filter.
+// Addresses are ordered by network name, then as reported by the server.
+func (r Server) FilterAddresses(filter AddressFilter) ([]Address, error) {
+	addresses, err := r.ExtractAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	networks := make([]string, 0, len(addresses))
+	for network := range addresses {
+		networks = append(networks, network)
+	}
+	sort.Strings(networks)
+
+	var filtered []Address
+	for _, network := range networks {
+		if filter.Network != "" && filter.Network != network {
+			continue
+		}
+		for _, address := range addresses[network] {
+			if filter.Version != 0 && filter.Version != address.Version {
+				continue
+			}
+			if filter.Type != "" && filter.Type != address.Type {
+				continue
+			}
+			if filter.MACAddr != "" && !strings.EqualFold(filter.MACAddr, address.MACAddr) {
+				continue
+			}
+			filtered = append(filtered, address)
+		}
+	}
+
+	return filtered, nil
 }
 
 // AddressPage abstracts the raw results of making a ListAddresses() request
@@ -578,6 +737,12 @@ type UnlockResult struct {
 	gophercloud.ErrResult
 }
 
+// TriggerCrashDumpResult is the response from a TriggerCrashDump operation.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type TriggerCrashDumpResult struct {
+	gophercloud.ErrResult
+}
+
 // MigrateResult is the response from a Migrate operation. Call its ExtractErr
 // method to determine if the request suceeded or failed.
 type MigrateResult struct {
@@ -674,3 +839,9 @@ type SuspendResult struct {
 type ResumeResult struct {
 	gophercloud.ErrResult
 }
+
+// RestoreResult is the response from a Restore operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type RestoreResult struct {
+	gophercloud.ErrResult
+}
@@ -159,7 +159,8 @@ const ServerListBody = `
 			"OS-EXT-STS:power_state": 1,
 			"config_drive": "",
 			"metadata": {},
-			"locked": true
+			"locked": true,
+		"locked_reason": "maintenance"
 		},
 		{
 		"status": "ACTIVE",
@@ -299,7 +300,8 @@ const SingleServerBody = `
 		"OS-EXT-STS:power_state": 1,
 		"config_drive": "",
 		"metadata": {},
-		"locked": true
+		"locked": true,
+		"locked_reason": "maintenance"
 	}
 }
 `
@@ -634,6 +636,7 @@ var (
 		DiskConfig:         servers.Manual,
 		AvailabilityZone:   "nova",
 		Locked:             func() *bool { b := true; return &b }(),
+		LockedReason:       func() *string { s := "maintenance"; return &s }(),
 	}
 
 	ConsoleOutput = "abc"
@@ -1032,6 +1035,18 @@ func HandleServerForceDeletionSuccessfully(t *testing.T) {
 	})
 }
 
+// HandleServerRestoreSuccessfully sets up the test server to respond to a
+// server restore request.
+func HandleServerRestoreSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/asdfasdfasdf/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{ "restore": null }`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
 // HandleServerGetSuccessfully sets up the test server to respond to a server Get request.
 func HandleServerGetSuccessfully(t *testing.T) {
 	th.Mux.HandleFunc("/servers/1234asdf", func(w http.ResponseWriter, r *http.Request) {
@@ -1324,6 +1339,77 @@ func HandlePasswordGetSuccessfully(t *testing.T) {
 	})
 }
 
+// ServerTopologyBody is the JSON response of a server topology request.
+const ServerTopologyBody = `
+{
+    "nodes": [
+        {
+            "cpu_pinning": {"0": 0, "1": 5},
+            "host_node": 0,
+            "memory_mb": 1024,
+            "siblings": [[0, 1]],
+            "vcpu_set": [0, 1]
+        },
+        {
+            "cpu_pinning": {"2": 1, "3": 8},
+            "host_node": 1,
+            "memory_mb": 2048,
+            "siblings": [[2, 3]],
+            "vcpu_set": [2, 3]
+        }
+    ],
+    "pagesize_kb": 4
+}
+`
+
+// HandleServerTopologyGetSuccessfully sets up the test server to respond to a
+// server topology request.
+func HandleServerTopologyGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/1234asdf/topology", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ServerTopologyBody)
+	})
+}
+
+// HandlePasswordClearSuccessfully sets up the test server to respond to a
+// password Clear request.
+func HandlePasswordClearSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/1234asdf/os-server-password", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleServerLockWithReasonSuccessfully sets up the test server to respond
+// to a server lock request with a reason.
+func HandleServerLockWithReasonSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/1234asdf/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{ "lock": { "locked_reason": "maintenance" } }`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// HandleServerTriggerCrashDumpSuccessfully sets up the test server to
+// respond to a server trigger crash dump request.
+func HandleServerTriggerCrashDumpSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/1234asdf/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{ "trigger_crash_dump": null }`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
 // HandleServerWithTagsCreationSuccessfully sets up the test server to respond
 // to a server creation request with a given response.
 func HandleServerWithTagsCreationSuccessfully(t *testing.T) {
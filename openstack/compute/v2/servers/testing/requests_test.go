@@ -4,9 +4,13 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/servers"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
@@ -57,6 +61,23 @@ func TestListAllServers(t *testing.T) {
 	th.CheckDeepEquals(t, ServerDerp, actual[1])
 }
 
+func TestListServerSummaries(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerListSimpleSuccessfully(t)
+
+	allPages, err := servers.ListSimple(client.ServiceClient(), servers.ListOpts{}).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := servers.ExtractServerSummaries(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, len(actual))
+	th.AssertEquals(t, ServerHerp.ID, actual[0].ID)
+	th.AssertEquals(t, ServerHerp.Name, actual[0].Name)
+	th.AssertEquals(t, ServerDerp.ID, actual[1].ID)
+	th.AssertEquals(t, ServerDerp.Name, actual[1].Name)
+	th.AssertEquals(t, "self", actual[1].Links[0].Rel)
+}
+
 func TestListAllServersWithExtensions(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -199,7 +220,9 @@ func TestCreateServerWithHostname(t *testing.T) {
 	defer th.TeardownHTTP()
 	HandleServerCreationWithHostname(t, SingleServerBody)
 
-	actual, err := servers.Create(context.TODO(), client.ServiceClient(), servers.CreateOpts{
+	sc := client.ServiceClient()
+	sc.Microversion = "2.90"
+	actual, err := servers.Create(context.TODO(), sc, servers.CreateOpts{
 		Name:      "derp",
 		ImageRef:  "f90f6034-2570-4974-8351-6b49732ef2eb",
 		FlavorRef: "1",
@@ -233,6 +256,79 @@ func TestCreateServerWithDiskConfig(t *testing.T) {
 	th.CheckJSONEquals(t, expected, actual)
 }
 
+func TestCreateServerForMicroversion(t *testing.T) {
+	opts := servers.CreateOpts{
+		Name:                     "createdserver",
+		ImageRef:                 "asdfasdfasdf",
+		FlavorRef:                "performance1-1",
+		Hostname:                 "derp.local",
+		Host:                     "compute-01",
+		HypervisorHostname:       "compute-01.local",
+		TrustedImageCertificates: []string{"0b5d2c72-12cc-4ba6-a8d7-3ff5cc1d8cb8"},
+	}
+	expected := `
+		{
+			"server": {
+				"name": "createdserver",
+				"imageRef": "asdfasdfasdf",
+				"flavorRef": "performance1-1",
+				"hostname": "derp.local",
+				"host": "compute-01",
+				"hypervisor_hostname": "compute-01.local",
+				"trusted_image_certificates": ["0b5d2c72-12cc-4ba6-a8d7-3ff5cc1d8cb8"]
+			}
+		}
+	`
+
+	th.AssertNoErr(t, opts.ValidateForMicroversion("2.90"))
+	actual, err := opts.ToServerCreateMap()
+	th.AssertNoErr(t, err)
+	th.CheckJSONEquals(t, expected, actual)
+
+	err = opts.ValidateForMicroversion("2.74")
+	var invalid gophercloud.ErrInvalidInput
+	th.AssertEquals(t, true, errors.As(err, &invalid))
+	th.AssertEquals(t, "servers.CreateOpts.Hostname", invalid.Argument)
+
+	opts.Hostname = ""
+	err = opts.ValidateForMicroversion("2.73")
+	th.AssertEquals(t, true, errors.As(err, &invalid))
+	th.AssertEquals(t, "servers.CreateOpts.Host", invalid.Argument)
+}
+
+func TestCreateServerMicroversionNotAllowed(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	sc := client.ServiceClient()
+	sc.Microversion = "2.62"
+	_, err := servers.Create(context.TODO(), sc, servers.CreateOpts{
+		Name:                     "derp",
+		ImageRef:                 "f90f6034-2570-4974-8351-6b49732ef2eb",
+		FlavorRef:                "1",
+		TrustedImageCertificates: []string{"0b5d2c72-12cc-4ba6-a8d7-3ff5cc1d8cb8"},
+	}, nil).Extract()
+	var invalid gophercloud.ErrInvalidInput
+	th.AssertEquals(t, true, errors.As(err, &invalid))
+	th.AssertEquals(t, "servers.CreateOpts.TrustedImageCertificates", invalid.Argument)
+}
+
+func TestCreateServerBaseMicroversionNotAllowed(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	_, err := servers.Create(context.TODO(), client.ServiceClient(), servers.CreateOpts{
+		Name:      "derp",
+		ImageRef:  "f90f6034-2570-4974-8351-6b49732ef2eb",
+		FlavorRef: "1",
+		Hostname:  "derp.local",
+	}, nil).Extract()
+	var invalid gophercloud.ErrInvalidInput
+	th.AssertEquals(t, true, errors.As(err, &invalid))
+	th.AssertEquals(t, "servers.CreateOpts.Hostname", invalid.Argument)
+	th.AssertEquals(t, "2.1", invalid.Value)
+}
+
 func TestCreateServerWithBFVBootFromNewVolume(t *testing.T) {
 	opts := servers.CreateOpts{
 		Name:      "createdserver",
@@ -747,6 +843,26 @@ func TestForceDeleteServer(t *testing.T) {
 	th.AssertNoErr(t, res.Err)
 }
 
+func TestRestoreServer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerRestoreSuccessfully(t)
+
+	res := servers.Restore(context.TODO(), client.ServiceClient(), "asdfasdfasdf")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestListDeletedServersQuery(t *testing.T) {
+	opts := servers.ListOpts{
+		Deleted: true,
+		Status:  "SOFT_DELETED",
+	}
+
+	query, err := opts.ToServerListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "?deleted=true&status=SOFT_DELETED", query)
+}
+
 func TestGetServer(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -775,6 +891,7 @@ func TestGetFaultyServer(t *testing.T) {
 	FaultyServer := ServerDerp
 	FaultyServer.Fault = DerpFault
 	FaultyServer.Locked = nil
+	FaultyServer.LockedReason = nil
 	th.CheckDeepEquals(t, FaultyServer, *actual)
 }
 
@@ -848,6 +965,81 @@ func TestGetPassword(t *testing.T) {
 	th.AssertNoErr(t, res.Err)
 }
 
+func TestGetTopology(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerTopologyGetSuccessfully(t)
+
+	actual, err := servers.GetTopology(context.TODO(), client.ServiceClient(), "1234asdf").Extract()
+	th.AssertNoErr(t, err)
+
+	hostNode0, hostNode1 := 0, 1
+	expected := &servers.Topology{
+		Nodes: []servers.TopologyNode{
+			{
+				CPUPinning: map[int]int{0: 0, 1: 5},
+				HostNode:   &hostNode0,
+				MemoryMB:   1024,
+				Siblings:   [][]int{{0, 1}},
+				VCPUSet:    []int{0, 1},
+			},
+			{
+				CPUPinning: map[int]int{2: 1, 3: 8},
+				HostNode:   &hostNode1,
+				MemoryMB:   2048,
+				Siblings:   [][]int{{2, 3}},
+				VCPUSet:    []int{2, 3},
+			},
+		},
+		PagesizeKB: 4,
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestClearPassword(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePasswordClearSuccessfully(t)
+
+	err := servers.ClearPassword(context.TODO(), client.ServiceClient(), "1234asdf").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestLockServerWithReason(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerLockWithReasonSuccessfully(t)
+
+	err := servers.LockWithOpts(context.TODO(), client.ServiceClient(), "1234asdf", servers.LockOpts{
+		Reason: "maintenance",
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestLockOptsWithoutReason(t *testing.T) {
+	actual, err := servers.LockOpts{}.ToServerLockMap()
+	th.AssertNoErr(t, err)
+	th.CheckJSONEquals(t, `{ "lock": null }`, actual)
+}
+
+func TestTriggerCrashDump(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerTriggerCrashDumpSuccessfully(t)
+
+	err := servers.TriggerCrashDump(context.TODO(), client.ServiceClient(), "1234asdf").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestListLockedServersQuery(t *testing.T) {
+	locked := false
+	opts := servers.ListOpts{Locked: &locked}
+
+	query, err := opts.ToServerListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "?locked=false", query)
+}
+
 func TestRebootServer(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -900,6 +1092,47 @@ func TestRebuildServerWithDiskConfig(t *testing.T) {
 	th.CheckJSONEquals(t, expected, actual)
 }
 
+func TestRebuildServerUnsetKeyName(t *testing.T) {
+	keyName := ""
+	opts := servers.RebuildOpts{
+		ImageRef: "asdfasdfasdf",
+		KeyName:  &keyName,
+	}
+	expected := `
+		{
+			"rebuild": {
+				"imageRef": "asdfasdfasdf",
+				"key_name": null
+			}
+		}
+	`
+
+	th.AssertNoErr(t, opts.ValidateForMicroversion("2.54"))
+	actual, err := opts.ToServerRebuildMap()
+	th.AssertNoErr(t, err)
+	th.CheckJSONEquals(t, expected, actual)
+
+	err = opts.ValidateForMicroversion("2.53")
+	var invalid gophercloud.ErrInvalidInput
+	th.AssertEquals(t, true, errors.As(err, &invalid))
+	th.AssertEquals(t, "servers.RebuildOpts.KeyName", invalid.Argument)
+}
+
+func TestUpdateServerHostnameForMicroversion(t *testing.T) {
+	hostname := "new-hostname"
+	opts := servers.UpdateOpts{Hostname: &hostname}
+
+	th.AssertNoErr(t, opts.ValidateForMicroversion("latest"))
+	actual, err := opts.ToServerUpdateMap()
+	th.AssertNoErr(t, err)
+	th.CheckJSONEquals(t, `{"server": {"hostname": "new-hostname"}}`, actual)
+
+	err = opts.ValidateForMicroversion("2.89")
+	var invalid gophercloud.ErrInvalidInput
+	th.AssertEquals(t, true, errors.As(err, &invalid))
+	th.AssertEquals(t, "servers.UpdateOpts.Hostname", invalid.Argument)
+}
+
 func TestResizeServer(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -967,6 +1200,28 @@ func TestRevertResize(t *testing.T) {
 	th.AssertNoErr(t, res.Err)
 }
 
+func TestMigrateWithOpts(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/1234asdf/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{ "migrate": { "host": "compute-02" } }`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	res := servers.MigrateWithOpts(context.TODO(), client.ServiceClient(), "1234asdf", servers.MigrateOpts{Host: "compute-02"})
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestMigrateOptsWithoutHost(t *testing.T) {
+	b, err := servers.MigrateOpts{}.ToServerMigrateMap()
+	th.AssertNoErr(t, err)
+	th.AssertJSONEquals(t, `{ "migrate": null }`, b)
+}
+
 func TestGetMetadatum(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -1147,6 +1402,7 @@ func TestCreateServerWithTags(t *testing.T) {
 	ServerDerpTags := ServerDerp
 	ServerDerpTags.Tags = &tags
 	ServerDerpTags.Locked = nil
+	ServerDerpTags.LockedReason = nil
 
 	createOpts := servers.CreateOpts{
 		Name:      "derp",
@@ -1182,3 +1438,138 @@ func TestCreateServerWithHypervisorHostname(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.CheckJSONEquals(t, expected, actual)
 }
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, `
+{
+	"servers": [
+		{"id": "2bc7a2a9-7c2a-4a8c-9f0e-5d4b7a2b1c01", "name": "server-1"},
+		{"id": "7f3c8e1d-51b4-4a8e-8a1e-3d9c0e6f4b02", "name": "server-2"},
+		{"id": "c4d1f0e2-9b7a-4f6c-8d3e-1a2b5c6d7e03", "name": "server-2"}
+	]
+}`)
+	})
+
+	id, err := servers.IDFromName(context.TODO(), client.ServiceClient(), "server-1")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2bc7a2a9-7c2a-4a8c-9f0e-5d4b7a2b1c01", id)
+}
+
+func TestCreateAndWait(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerCreationSuccessfully(t, SingleServerBody)
+
+	th.Mux.HandleFunc("/servers/9e5476bd-a4ec-4653-93d6-72c93aa682ba", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		fmt.Fprint(w, SingleServerBody)
+	})
+
+	actual, err := servers.CreateAndWait(context.TODO(), client.ServiceClient(), servers.CreateOpts{
+		Name:      "derp",
+		ImageRef:  "f90f6034-2570-4974-8351-6b49732ef2eb",
+		FlavorRef: "1",
+	}, nil)
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, ServerDerp, *actual)
+}
+
+func TestCreateAndWaitBuildFault(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerCreationSuccessfully(t, SingleServerBody)
+
+	th.Mux.HandleFunc("/servers/9e5476bd-a4ec-4653-93d6-72c93aa682ba", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		fmt.Fprint(w, strings.Replace(FaultyServerBody, `"status": "ACTIVE"`, `"status": "ERROR"`, 1))
+	})
+
+	actual, err := servers.CreateAndWait(context.TODO(), client.ServiceClient(), servers.CreateOpts{
+		Name:      "derp",
+		ImageRef:  "f90f6034-2570-4974-8351-6b49732ef2eb",
+		FlavorRef: "1",
+	}, nil)
+
+	serverFault, ok := err.(servers.ErrServerFault)
+	if !ok {
+		t.Fatalf("Expected ErrServerFault but got %v", err)
+	}
+	th.AssertEquals(t, "ERROR", actual.Status)
+	th.AssertEquals(t, actual.ID, serverFault.ID)
+	th.AssertEquals(t, "ACTIVE", serverFault.Status)
+	th.AssertEquals(t, 500, serverFault.Fault.Code)
+	th.AssertEquals(t, "Stock details for test", serverFault.Fault.Details)
+}
+
+func TestUnshelveToHost(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/1234asdf/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"unshelve": {"host": "compute-01"}}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	res := servers.Unshelve(context.TODO(), client.ServiceClient(), "1234asdf", servers.UnshelveOpts{Host: "compute-01"})
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestUnshelveWithoutOpts(t *testing.T) {
+	b, err := servers.UnshelveOpts{}.ToUnshelveMap()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, map[string]any{"unshelve": nil}, b)
+}
+
+func TestWaitForRescue(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/9e5476bd-a4ec-4653-93d6-72c93aa682ba", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		fmt.Fprint(w, strings.Replace(SingleServerBody, `"status": "ACTIVE"`, `"status": "RESCUE"`, 1))
+	})
+
+	err := servers.WaitForRescue(context.TODO(), client.ServiceClient(), "9e5476bd-a4ec-4653-93d6-72c93aa682ba")
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForShelvedOffloadedFault(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/9e5476bd-a4ec-4653-93d6-72c93aa682ba", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		fmt.Fprint(w, strings.Replace(FaultyServerBody, `"status": "ACTIVE"`, `"status": "ERROR"`, 1))
+	})
+
+	err := servers.WaitForShelvedOffloaded(context.TODO(), client.ServiceClient(), "9e5476bd-a4ec-4653-93d6-72c93aa682ba")
+
+	serverFault, ok := err.(servers.ErrServerFault)
+	if !ok {
+		t.Fatalf("Expected ErrServerFault but got %v", err)
+	}
+	th.AssertEquals(t, "SHELVED_OFFLOADED", serverFault.Status)
+	th.AssertEquals(t, 500, serverFault.Fault.Code)
+}
@@ -111,3 +111,51 @@ func TestListAddressesAllPages(t *testing.T) {
 	_, err = servers.ExtractAddresses(allPages)
 	th.AssertNoErr(t, err)
 }
+
+func TestServerFilterAddresses(t *testing.T) {
+	var server servers.Server
+	err := json.Unmarshal([]byte(`{
+		"id": "ef079b0c-e610-4dfb-b1aa-b49f07ac48e5",
+		"addresses": {
+			"public": [
+				{"version": 4, "addr": "172.24.4.10", "OS-EXT-IPS:type": "fixed", "OS-EXT-IPS-MAC:mac_addr": "fa:16:3e:aa:bb:01"}
+			],
+			"private": [
+				{"version": 4, "addr": "10.0.0.32", "OS-EXT-IPS:type": "fixed", "OS-EXT-IPS-MAC:mac_addr": "fa:16:3e:7c:1b:2b"},
+				{"version": 6, "addr": "fd00::32", "OS-EXT-IPS:type": "fixed", "OS-EXT-IPS-MAC:mac_addr": "fa:16:3e:7c:1b:2b"},
+				{"version": 4, "addr": "172.24.4.20", "OS-EXT-IPS:type": "floating", "OS-EXT-IPS-MAC:mac_addr": "fa:16:3e:7c:1b:2b"}
+			]
+		}
+	}`), &server)
+	th.AssertNoErr(t, err)
+
+	floating, err := server.FilterAddresses(servers.AddressFilter{Type: servers.AddressTypeFloating})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []servers.Address{
+		{Version: 4, Address: "172.24.4.20", Type: "floating", MACAddr: "fa:16:3e:7c:1b:2b"},
+	}, floating)
+
+	fixedIPv4, err := server.FilterAddresses(servers.AddressFilter{Version: 4, Type: servers.AddressTypeFixed})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(fixedIPv4))
+	th.AssertEquals(t, "10.0.0.32", fixedIPv4[0].Address)
+	th.AssertEquals(t, "172.24.4.10", fixedIPv4[1].Address)
+
+	ipv6, err := server.FilterAddresses(servers.AddressFilter{Network: "private", Version: 6})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(ipv6))
+	th.AssertEquals(t, "fd00::32", ipv6[0].Address)
+
+	byMAC, err := server.FilterAddresses(servers.AddressFilter{MACAddr: "FA:16:3E:AA:BB:01"})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(byMAC))
+	th.AssertEquals(t, "172.24.4.10", byMAC[0].Address)
+
+	none, err := server.FilterAddresses(servers.AddressFilter{Network: "missing"})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(none))
+
+	all, err := server.ExtractAddresses()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, len(all["private"]))
+}
@@ -22,3 +22,63 @@ func WaitForStatus(ctx context.Context, c *gophercloud.ServiceClient, id, status
 		return false, nil
 	})
 }
+
+// WaitForShelvedOffloaded polls a server until it becomes SHELVED_OFFLOADED,
+// either after ShelveOffload or after Shelve once the shelved offload time of
+// the cloud has elapsed. If the server goes to the ERROR status, an
+// ErrServerFault holding the server's fault is returned.
+func WaitForShelvedOffloaded(ctx context.Context, c *gophercloud.ServiceClient, id string) error {
+	_, err := waitForStatusOrFault(ctx, c, id, "SHELVED_OFFLOADED")
+	return err
+}
+
+// WaitForRescue polls a server until it becomes RESCUE after Rescue. If the
+// server goes to the ERROR status, an ErrServerFault holding the server's
+// fault is returned.
+func WaitForRescue(ctx context.Context, c *gophercloud.ServiceClient, id string) error {
+	_, err := waitForStatusOrFault(ctx, c, id, "RESCUE")
+	return err
+}
+
+// waitForStatusOrFault polls a server until it transitions to the given
+// status, and fails if it goes to the ERROR status instead. It returns the
+// server as last retrieved.
+func waitForStatusOrFault(ctx context.Context, c *gophercloud.ServiceClient, id, status string) (*Server, error) {
+	var server *Server
+	err := gophercloud.WaitFor(ctx, func(ctx context.Context) (bool, error) {
+		current, err := Get(ctx, c, id).Extract()
+		if err != nil {
+			return false, err
+		}
+		server = current
+
+		switch current.Status {
+		case status:
+			return true, nil
+		case "ERROR":
+			return false, ErrServerFault{ID: current.ID, Status: status, Fault: current.Fault}
+		}
+
+		return false, nil
+	})
+
+	return server, err
+}
+
+// CreateAndWait requests a server to be provisioned and polls it until it
+// becomes ACTIVE. If the server goes to the ERROR status, an ErrServerFault
+// holding the server's fault is returned along with the server, so that the
+// caller can clean it up.
+func CreateAndWait(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder, hintOpts SchedulerHintOptsBuilder) (*Server, error) {
+	server, err := Create(ctx, c, opts, hintOpts).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := waitForStatusOrFault(ctx, c, server.ID, "ACTIVE")
+	if current != nil {
+		server = current
+	}
+
+	return server, err
+}
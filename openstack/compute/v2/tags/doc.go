@@ -5,14 +5,14 @@ This extension is available since 2.26 Compute V2 API microversion.
 
 Example to List all server Tags
 
-		client.Microversion = "2.26"
+	client.Microversion = "2.26"
 
-	    serverTags, err := tags.List(context.TODO(), client, serverID).Extract()
-	    if err != nil {
-	        log.Fatal(err)
-	    }
+	serverTags, err := tags.List(context.TODO(), client, serverID).Extract()
+	if err != nil {
+	    log.Fatal(err)
+	}
 
-	    fmt.Printf("Tags: %v\n", serverTags)
+	fmt.Printf("Tags: %v\n", serverTags)
 
 Example to Check if the specific Tag exists on a server
 
@@ -66,5 +66,20 @@ Example to Delete all Tags on a server
 	if err != nil {
 	    log.Fatal(err)
 	}
+
+Example to List servers having any of the given Tags
+
+	client.Microversion = "2.26"
+
+	listOpts := servers.ListOpts{
+		// Filters are comma-separated lists of tags.
+		TagsAny: "foo,bar",
+		NotTags: "baz",
+	}
+
+	allPages, err := servers.List(client, listOpts).AllPages(context.TODO())
+	if err != nil {
+	    log.Fatal(err)
+	}
 */
 package tags
@@ -2,10 +2,45 @@ package tags
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
 )
 
+const (
+	// MaxTagLength is the maximum length of a server tag.
+	MaxTagLength = 60
+
+	// MaxTagsPerServer is the maximum number of tags of a server.
+	MaxTagsPerServer = 50
+)
+
+// validateTag checks that a tag is accepted by the Compute service: it must
+// be between 1 and MaxTagLength characters long and must not contain "/"
+// or ",".
+func validateTag(argument, tag string) error {
+	if tag == "" {
+		return gophercloud.ErrMissingInput{Argument: argument}
+	}
+
+	info := ""
+	switch {
+	case len([]rune(tag)) > MaxTagLength:
+		info = fmt.Sprintf("must be at most %d characters long", MaxTagLength)
+	case strings.ContainsAny(tag, "/,"):
+		info = `must not contain "/" or ","`
+	default:
+		return nil
+	}
+
+	err := gophercloud.ErrInvalidInput{}
+	err.Argument = argument
+	err.Value = tag
+	err.Info = info
+	return err
+}
+
 // List all tags on a server.
 func List(ctx context.Context, client *gophercloud.ServiceClient, serverID string) (r ListResult) {
 	url := listURL(client, serverID)
@@ -38,6 +73,18 @@ type ReplaceAllOpts struct {
 
 // ToTagsReplaceAllMap formats a ReplaceALlOpts into the body of the ReplaceAll request.
 func (opts ReplaceAllOpts) ToTagsReplaceAllMap() (map[string]any, error) {
+	if len(opts.Tags) > MaxTagsPerServer {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "tags.ReplaceAllOpts.Tags"
+		err.Value = len(opts.Tags)
+		err.Info = fmt.Sprintf("a server can have at most %d tags", MaxTagsPerServer)
+		return nil, err
+	}
+	for i, tag := range opts.Tags {
+		if err := validateTag(fmt.Sprintf("tags.ReplaceAllOpts.Tags[%d]", i), tag); err != nil {
+			return nil, err
+		}
+	}
 	return gophercloud.BuildRequestBody(opts, "")
 }
 
@@ -56,8 +103,13 @@ func ReplaceAll(ctx context.Context, client *gophercloud.ServiceClient, serverID
 	return
 }
 
-// Add adds a new Tag on a server.
+// Add adds a new Tag on a server. The tag is validated before the request is
+// sent.
 func Add(ctx context.Context, client *gophercloud.ServiceClient, serverID, tag string) (r AddResult) {
+	if err := validateTag("tag", tag); err != nil {
+		r.Err = err
+		return
+	}
 	url := addURL(client, serverID, tag)
 	resp, err := client.Put(ctx, url, nil, nil, &gophercloud.RequestOpts{
 		OkCodes: []int{201, 204},
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/tags"
 	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
 	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
@@ -152,3 +154,29 @@ func TestDeleteAll(t *testing.T) {
 	err := tags.DeleteAll(context.TODO(), client.ServiceClient(), "uuid1").ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestTagValidation(t *testing.T) {
+	for _, tag := range []string{"foo/bar", "foo,bar", strings.Repeat("a", tags.MaxTagLength+1)} {
+		err := tags.Add(context.TODO(), client.ServiceClient(), "uuid1", tag).ExtractErr()
+		if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+			t.Fatalf("ErrInvalidInput was expected to occur for %q, got %v", tag, err)
+		}
+	}
+
+	_, err := tags.ReplaceAllOpts{Tags: []string{"foo", ""}}.ToTagsReplaceAllMap()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("ErrMissingInput was expected to occur, got %v", err)
+	}
+
+	tooMany := make([]string, tags.MaxTagsPerServer+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("tag%d", i)
+	}
+	_, err = tags.ReplaceAllOpts{Tags: tooMany}.ToTagsReplaceAllMap()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("ErrInvalidInput was expected to occur, got %v", err)
+	}
+
+	_, err = tags.ReplaceAllOpts{Tags: []string{"foo", strings.Repeat("a", tags.MaxTagLength)}}.ToTagsReplaceAllMap()
+	th.AssertNoErr(t, err)
+}
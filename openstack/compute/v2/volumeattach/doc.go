@@ -17,6 +17,21 @@ Example to Attach a Volume
 		panic(err)
 	}
 
+Example to Delete a Volume on Server Termination
+
+	computeClient.Microversion = "2.85"
+
+	deleteOnTermination := true
+	updateOpts := volumeattach.UpdateOpts{
+		VolumeID:            volumeID,
+		DeleteOnTermination: &deleteOnTermination,
+	}
+
+	err := volumeattach.Update(context.TODO(), computeClient, serverID, volumeID, updateOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Detach a Volume
 
 	serverID := "7ac8686c-de71-4acb-9600-ec18b1a1ed6d"
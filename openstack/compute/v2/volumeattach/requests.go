@@ -64,6 +64,42 @@ func Get(ctx context.Context, client *gophercloud.ServiceClient, serverID, volum
 	return
 }
 
+// UpdateOptsBuilder allows extensions to add parameters to the Update request.
+type UpdateOptsBuilder interface {
+	ToVolumeAttachmentUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts specifies volume attachment update parameters.
+type UpdateOpts struct {
+	// VolumeID is the ID of the volume attached to the instance. Setting it
+	// to the ID of another volume swaps the attached volume.
+	VolumeID string `json:"volumeId" required:"true"`
+
+	// DeleteOnTermination specifies whether or not to delete the volume when
+	// the server is destroyed. Requires 2.85 microversion
+	DeleteOnTermination *bool `json:"delete_on_termination,omitempty"`
+}
+
+// ToVolumeAttachmentUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToVolumeAttachmentUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "volumeAttachment")
+}
+
+// Update requests the update of an existing volume attachment of the
+// server, identified by the ID of the attached volume.
+func Update(ctx context.Context, client *gophercloud.ServiceClient, serverID, volumeID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToVolumeAttachmentUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(ctx, updateURL(client, serverID, volumeID), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // Delete requests the deletion of a previous stored VolumeAttachment from
 // the server.
 func Delete(ctx context.Context, client *gophercloud.ServiceClient, serverID, volumeID string) (r DeleteResult) {
@@ -82,6 +82,12 @@ type GetResult struct {
 	VolumeAttachmentResult
 }
 
+// UpdateResult is the response from an Update operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type UpdateResult struct {
+	gophercloud.ErrResult
+}
+
 // DeleteResult is the response from a Delete operation. Call its ExtractErr
 // method to determine if the call succeeded or failed.
 type DeleteResult struct {
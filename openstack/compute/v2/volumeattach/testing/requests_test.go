@@ -108,3 +108,20 @@ func TestDelete(t *testing.T) {
 	err := volumeattach.Delete(context.TODO(), client.ServiceClient(), serverID, aID).ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleUpdateSuccessfully(t)
+
+	aID := "a26887c6-c47b-4654-abb5-dfadf7d3f804"
+	serverID := "4d8c3732-a248-40ed-bebc-539a6ffd25c0"
+	deleteOnTermination := false
+
+	err := volumeattach.Update(context.TODO(), client.ServiceClient(), serverID, aID, volumeattach.UpdateOpts{
+		VolumeID:            aID,
+		DeleteOnTermination: &deleteOnTermination,
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
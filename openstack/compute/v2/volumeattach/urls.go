@@ -20,6 +20,10 @@ func getURL(c *gophercloud.ServiceClient, serverID, aID string) string {
 	return c.ServiceURL("servers", serverID, resourcePath, aID)
 }
 
+func updateURL(c *gophercloud.ServiceClient, serverID, aID string) string {
+	return getURL(c, serverID, aID)
+}
+
 func deleteURL(c *gophercloud.ServiceClient, serverID, aID string) string {
 	return getURL(c, serverID, aID)
 }
@@ -0,0 +1,33 @@
+/*
+Package pools provides information about the pool API resource for the
+OpenStack DNS service. A pool is a group of DNS servers hosting zones. Pools
+are usually only visible to administrators.
+
+Example to List Pools
+
+	allPages, err := pools.List(dnsClient, nil).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allPools, err := pools.ExtractPools(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, pool := range allPools {
+		fmt.Printf("%+v\n", pool)
+	}
+
+Example to Get a Pool
+
+	pool, err := pools.Get(context.TODO(), dnsClient, "794ccc2c-d751-44fe-b57f-8894c9f5c842").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, nsRecord := range pool.NSRecords {
+		fmt.Println(nsRecord.Hostname)
+	}
+*/
+package pools
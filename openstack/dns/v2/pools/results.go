@@ -0,0 +1,105 @@
+package pools
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// GetResult is the result of a Get request. Call its Extract method
+// to interpret the result as a Pool.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as a Pool.
+func (r GetResult) Extract() (*Pool, error) {
+	var s *Pool
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// PoolPage is a single page of Pool results.
+type PoolPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if the page contains no results.
+func (r PoolPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	s, err := ExtractPools(r)
+	return len(s) == 0, err
+}
+
+// ExtractPools extracts a slice of Pools from a List result.
+func ExtractPools(r pagination.Page) ([]Pool, error) {
+	var s struct {
+		Pools []Pool `json:"pools"`
+	}
+	err := (r.(PoolPage)).ExtractInto(&s)
+	return s.Pools, err
+}
+
+// NSRecord is a nameserver record of a pool.
+type NSRecord struct {
+	// Hostname is the fully qualified name of the nameserver.
+	Hostname string `json:"hostname"`
+
+	// Priority is the order in which the nameserver is listed in NS records.
+	Priority int `json:"priority"`
+}
+
+// Pool represents a DNS pool.
+type Pool struct {
+	// ID uniquely identifies this pool.
+	ID string `json:"id"`
+
+	// Name is the name of the pool.
+	Name string `json:"name"`
+
+	// Description for this pool.
+	Description string `json:"description"`
+
+	// ProjectID identifies the project/tenant owning this resource.
+	ProjectID string `json:"project_id"`
+
+	// Attributes for the pool, used to schedule zones.
+	Attributes map[string]string `json:"attributes"`
+
+	// NSRecords are the nameservers of the pool, which zones hosted on it are
+	// expected to be delegated to.
+	NSRecords []NSRecord `json:"ns_records"`
+
+	// CreatedAt is the date when the pool was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date when the last change was made to the pool.
+	UpdatedAt time.Time `json:"-"`
+
+	// Links includes HTTP references to the itself.
+	Links map[string]any `json:"links"`
+}
+
+func (r *Pool) UnmarshalJSON(b []byte) error {
+	type tmp Pool
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Pool(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return err
+}
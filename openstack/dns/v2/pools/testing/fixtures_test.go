@@ -0,0 +1,105 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/dns/v2/pools"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "pools": [
+        {
+            "description": "Default PowerDNS Pool",
+            "id": "794ccc2c-d751-44fe-b57f-8894c9f5c842",
+            "project_id": "noauth-project",
+            "created_at": "2015-02-18T22:18:58.000000",
+            "attributes": {},
+            "ns_records": [
+                {
+                    "hostname": "ns1.example.com.",
+                    "priority": 1
+                }
+            ],
+            "links": {
+                "self": "http://127.0.0.1:9001/v2/pools/794ccc2c-d751-44fe-b57f-8894c9f5c842"
+            },
+            "name": "default",
+            "updated_at": "2015-02-19T15:59:44.000000"
+        }
+    ],
+    "links": {
+        "self": "http://127.0.0.1:9001/v2/pools"
+    },
+    "metadata": {
+        "total_count": 1
+    }
+}
+`
+
+// GetOutput is a sample response to a Get call.
+const GetOutput = `
+{
+    "description": "Default PowerDNS Pool",
+    "id": "794ccc2c-d751-44fe-b57f-8894c9f5c842",
+    "project_id": "noauth-project",
+    "created_at": "2015-02-18T22:18:58.000000",
+    "attributes": {},
+    "ns_records": [
+        {
+            "hostname": "ns1.example.com.",
+            "priority": 1
+        }
+    ],
+    "links": {
+        "self": "http://127.0.0.1:9001/v2/pools/794ccc2c-d751-44fe-b57f-8894c9f5c842"
+    },
+    "name": "default",
+    "updated_at": "2015-02-19T15:59:44.000000"
+}
+`
+
+// DefaultPool is the pool in ListOutput and GetOutput.
+var DefaultPool = pools.Pool{
+	ID:          "794ccc2c-d751-44fe-b57f-8894c9f5c842",
+	Name:        "default",
+	Description: "Default PowerDNS Pool",
+	ProjectID:   "noauth-project",
+	Attributes:  map[string]string{},
+	NSRecords: []pools.NSRecord{
+		{Hostname: "ns1.example.com.", Priority: 1},
+	},
+	CreatedAt: time.Date(2015, 2, 18, 22, 18, 58, 0, time.UTC),
+	UpdatedAt: time.Date(2015, 2, 19, 15, 59, 44, 0, time.UTC),
+	Links: map[string]any{
+		"self": "http://127.0.0.1:9001/v2/pools/794ccc2c-d751-44fe-b57f-8894c9f5c842",
+	},
+}
+
+// HandleListSuccessfully configures the test server to respond to a List request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/pools", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ListOutput)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/pools/794ccc2c-d751-44fe-b57f-8894c9f5c842", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetOutput)
+	})
+}
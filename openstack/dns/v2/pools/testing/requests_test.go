@@ -0,0 +1,32 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/dns/v2/pools"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	allPages, err := pools.List(client.ServiceClient(), nil).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	allPools, err := pools.ExtractPools(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []pools.Pool{DefaultPool}, allPools)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := pools.Get(context.TODO(), client.ServiceClient(), "794ccc2c-d751-44fe-b57f-8894c9f5c842").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &DefaultPool, actual)
+}
@@ -0,0 +1,13 @@
+package pools
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+// baseURL returns the base URL for pools.
+func baseURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("pools")
+}
+
+// poolURL returns the URL for a specific pool.
+func poolURL(c *gophercloud.ServiceClient, poolID string) string {
+	return c.ServiceURL("pools", poolID)
+}
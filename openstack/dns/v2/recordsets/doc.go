@@ -41,6 +41,27 @@ Example to Create a RecordSet
 		panic(err)
 	}
 
+# Example to Create an MX RecordSet
+
+Records are validated before the request is sent, according to the type of
+the RecordSet. With Normalize set, they are also rewritten in canonical form:
+the record below is sent as "10 mail.example.com.".
+
+	createOpts := recordsets.CreateOpts{
+		Name:      "example.com.",
+		Type:      "MX",
+		Records:   []string{"10 mail.example.com"},
+		Normalize: true,
+	}
+
+	rr, err := recordsets.Create(context.TODO(), dnsClient, zoneID, createOpts).Extract()
+	if err != nil {
+		if invalid, ok := err.(recordsets.ErrInvalidRecord); ok {
+			fmt.Printf("invalid record %s: %s\n", invalid.Record, invalid.Reason)
+		}
+		panic(err)
+	}
+
 Example to Delete a RecordSet
 
 	zoneID := "fff121f5-c506-410a-a69e-2d73ef9cbdbd"
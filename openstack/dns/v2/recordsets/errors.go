@@ -0,0 +1,26 @@
+package recordsets
+
+import (
+	"fmt"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// ErrInvalidRecord is returned when a record does not match the format
+// required by the type of its RecordSet.
+type ErrInvalidRecord struct {
+	gophercloud.BaseError
+
+	// Type is the RRTYPE of the RecordSet.
+	Type string
+
+	// Record is the invalid record.
+	Record string
+
+	// Reason describes why the record is invalid.
+	Reason string
+}
+
+func (e ErrInvalidRecord) Error() string {
+	return fmt.Sprintf("Invalid %s record [%s]: %s", e.Type, e.Record, e.Reason)
+}
@@ -87,10 +87,23 @@ type CreateOpts struct {
 
 	// Type is the RRTYPE of the RecordSet.
 	Type string `json:"type,omitempty"`
+
+	// Normalize, if set, rewrites Records in the canonical form expected by
+	// Designate, see NormalizeRecords. Otherwise they are only validated,
+	// see ValidateRecords.
+	Normalize bool `json:"-"`
 }
 
 // ToRecordSetCreateMap formats an CreateOpts structure into a request body.
+// Records are validated according to Type, and normalized if Normalize is
+// set.
 func (opts CreateOpts) ToRecordSetCreateMap() (map[string]any, error) {
+	records, err := checkRecords(opts.Type, opts.Records, opts.Normalize)
+	if err != nil {
+		return nil, err
+	}
+	opts.Records = records
+
 	b, err := gophercloud.BuildRequestBody(opts, "")
 	if err != nil {
 		return nil, err
@@ -99,6 +112,15 @@ func (opts CreateOpts) ToRecordSetCreateMap() (map[string]any, error) {
 	return b, nil
 }
 
+// checkRecords validates records according to recordType and returns them,
+// normalized if normalize is set.
+func checkRecords(recordType string, records []string, normalize bool) ([]string, error) {
+	if normalize {
+		return NormalizeRecords(recordType, records)
+	}
+	return records, ValidateRecords(recordType, records)
+}
+
 // Create creates a recordset in a given zone.
 func Create(ctx context.Context, client *gophercloud.ServiceClient, zoneID string, opts CreateOptsBuilder) (r CreateResult) {
 	b, err := opts.ToRecordSetCreateMap()
@@ -130,10 +152,26 @@ type UpdateOpts struct {
 
 	// Records are the DNS records of the RecordSet.
 	Records []string `json:"records,omitempty"`
+
+	// Type is the RRTYPE of the RecordSet. It can't be changed and is only
+	// used to validate Records, see ValidateRecords.
+	Type string `json:"-"`
+
+	// Normalize, if set, rewrites Records in the canonical form expected by
+	// Designate, see NormalizeRecords.
+	Normalize bool `json:"-"`
 }
 
 // ToRecordSetUpdateMap formats an UpdateOpts structure into a request body.
+// Records are validated according to Type, and normalized if Normalize is
+// set.
 func (opts UpdateOpts) ToRecordSetUpdateMap() (map[string]any, error) {
+	records, err := checkRecords(opts.Type, opts.Records, opts.Normalize)
+	if err != nil {
+		return nil, err
+	}
+	opts.Records = records
+
 	b, err := gophercloud.BuildRequestBody(opts, "")
 	if err != nil {
 		return nil, err
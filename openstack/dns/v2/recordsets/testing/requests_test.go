@@ -3,6 +3,7 @@ package testing
 import (
 	"context"
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/dns/v2/recordsets"
@@ -146,3 +147,100 @@ func TestDelete(t *testing.T) {
 	th.AssertNoErr(t, err)
 	//th.CheckDeepEquals(t, &DeletedZone, actual)
 }
+
+func TestNormalizeRecords(t *testing.T) {
+	tests := []struct {
+		recordType string
+		records    []string
+		expected   []string
+	}{
+		{"A", []string{"10.1.0.2", " 192.168.0.1 "}, []string{"10.1.0.2", "192.168.0.1"}},
+		{"AAAA", []string{"2001:DB8:0:0::1"}, []string{"2001:db8::1"}},
+		{"CNAME", []string{"www.example.org"}, []string{"www.example.org."}},
+		{"cname", []string{"www.example.org."}, []string{"www.example.org."}},
+		{"MX", []string{"010  mail.example.org"}, []string{"10 mail.example.org."}},
+		{"SRV", []string{"10 60 05060 sip.example.org"}, []string{"10 60 5060 sip.example.org."}},
+		{"TXT", []string{`v=spf1 include:"example.org" -all`}, []string{`"v=spf1 include:\"example.org\" -all"`}},
+		{"TXT", []string{`"already quoted"`}, []string{`"already quoted"`}},
+		{"TXT", []string{`"ends with a backslash \\"`}, []string{`"ends with a backslash \\"`}},
+		{"TXT", []string{strings.Repeat("a", 300)}, []string{`"` + strings.Repeat("a", 255) + `" "` + strings.Repeat("a", 45) + `"`}},
+		{"TXT", []string{strings.Repeat("a", 254) + "é"}, []string{`"` + strings.Repeat("a", 254) + `" "é"`}},
+		{"CAA", []string{"0 Issue letsencrypt.org"}, []string{`0 issue "letsencrypt.org"`}},
+		{"SSHFP", []string{"1 1 abcdef"}, []string{"1 1 abcdef"}},
+	}
+
+	for _, test := range tests {
+		actual, err := recordsets.NormalizeRecords(test.recordType, test.records)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, test.expected, actual)
+	}
+}
+
+func TestNormalizeRecordsInvalid(t *testing.T) {
+	tests := []struct {
+		recordType string
+		record     string
+	}{
+		{"A", "2001:db8::1"},
+		{"A", "10.1.0"},
+		{"AAAA", "10.1.0.2"},
+		{"CNAME", "www..example.org"},
+		{"MX", "mail.example.org"},
+		{"MX", "70000 mail.example.org"},
+		{"SRV", "10 60 sip.example.org"},
+		{"TXT", `"unterminated`},
+		{"TXT", `"escaped quote \"`},
+		{"CAA", "256 issue letsencrypt.org"},
+		{"CAA", "0 is-sue letsencrypt.org"},
+	}
+
+	for _, test := range tests {
+		_, err := recordsets.NormalizeRecords(test.recordType, []string{test.record})
+		invalid, ok := err.(recordsets.ErrInvalidRecord)
+		if !ok {
+			t.Fatalf("expected ErrInvalidRecord for %s record %q, got %v", test.recordType, test.record, err)
+		}
+		th.AssertEquals(t, test.record, invalid.Record)
+	}
+}
+
+func TestCreateInvalidRecord(t *testing.T) {
+	createOpts := recordsets.CreateOpts{
+		Name:    "example.org.",
+		Type:    "A",
+		Records: []string{"not-an-address"},
+	}
+
+	res := recordsets.Create(context.TODO(), client.ServiceClient(), "2150b1bf-dee2-4221-9d85-11f7886fb15f", createOpts)
+	_, ok := res.Err.(recordsets.ErrInvalidRecord)
+	th.AssertEquals(t, true, ok)
+}
+
+func TestUpdateNormalizesRecords(t *testing.T) {
+	updateOpts := recordsets.UpdateOpts{
+		Type:      "MX",
+		Records:   []string{"10 mail.example.org"},
+		Normalize: true,
+	}
+
+	b, err := updateOpts.ToRecordSetUpdateMap()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []any{"10 mail.example.org."}, b["records"])
+}
+
+func TestCreateValidatesRecordsWithoutNormalizing(t *testing.T) {
+	createOpts := recordsets.CreateOpts{
+		Name:    "example.org.",
+		Type:    "MX",
+		Records: []string{"010 mail.example.org"},
+	}
+
+	b, err := createOpts.ToRecordSetCreateMap()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []any{"010 mail.example.org"}, b["records"])
+
+	createOpts.Records = []string{"mail.example.org"}
+	_, err = createOpts.ToRecordSetCreateMap()
+	_, ok := err.(recordsets.ErrInvalidRecord)
+	th.AssertEquals(t, true, ok)
+}
@@ -0,0 +1,237 @@
+package recordsets
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxTXTStringLength is the maximum length of a single character-string of a
+// TXT record.
+const maxTXTStringLength = 255
+
+// NormalizeRecords validates records against the format required by the
+// given RRTYPE and returns them in the canonical form expected by Designate:
+//
+//   - A and AAAA addresses are formatted canonically.
+//   - CNAME, NS and PTR targets, and the targets of MX and SRV records, are
+//     made fully qualified with a trailing dot.
+//   - The priority, weight and port of MX and SRV records are formatted as
+//     plain integers.
+//   - TXT records are quoted and split in strings of 255 characters.
+//   - CAA values are quoted.
+//
+// Records of other types are returned unchanged. An ErrInvalidRecord is
+// returned for the first invalid record.
+//
+// NormalizeRecords is used by CreateOpts and UpdateOpts when their Normalize
+// field is set.
+func NormalizeRecords(recordType string, records []string) ([]string, error) {
+	normalize, ok := normalizers[strings.ToUpper(recordType)]
+	if !ok {
+		return records, nil
+	}
+
+	normalized := make([]string, len(records))
+	for i, record := range records {
+		n, reason := normalize(strings.TrimSpace(record))
+		if reason != "" {
+			return nil, ErrInvalidRecord{
+				Type:   strings.ToUpper(recordType),
+				Record: record,
+				Reason: reason,
+			}
+		}
+		normalized[i] = n
+	}
+
+	return normalized, nil
+}
+
+// ValidateRecords validates records against the format required by the given
+// RRTYPE, as NormalizeRecords does, without changing them. An
+// ErrInvalidRecord is returned for the first invalid record.
+func ValidateRecords(recordType string, records []string) error {
+	_, err := NormalizeRecords(recordType, records)
+	return err
+}
+
+// A normalizer returns the canonical form of a record, or the reason why the
+// record is invalid.
+type normalizer func(record string) (string, string)
+
+var normalizers = map[string]normalizer{
+	"A":     normalizeA,
+	"AAAA":  normalizeAAAA,
+	"CNAME": normalizeHostname,
+	"NS":    normalizeHostname,
+	"PTR":   normalizeHostname,
+	"MX":    normalizeMX,
+	"SRV":   normalizeSRV,
+	"TXT":   normalizeTXT,
+	"CAA":   normalizeCAA,
+}
+
+func normalizeA(record string) (string, string) {
+	ip := net.ParseIP(record)
+	if ip == nil || ip.To4() == nil || strings.Contains(record, ":") {
+		return "", "not an IPv4 address"
+	}
+	return ip.String(), ""
+}
+
+func normalizeAAAA(record string) (string, string) {
+	ip := net.ParseIP(record)
+	if ip == nil || !strings.Contains(record, ":") {
+		return "", "not an IPv6 address"
+	}
+	if ip.To4() != nil {
+		// IPv4-mapped addresses would be formatted as IPv4
+		return record, ""
+	}
+	return ip.String(), ""
+}
+
+func normalizeHostname(record string) (string, string) {
+	name := strings.TrimSuffix(record, ".")
+	if name == "" {
+		return "", "empty hostname"
+	}
+	if len(name) > 253 {
+		return "", "hostname longer than 253 characters"
+	}
+	for _, label := range strings.Split(name, ".") {
+		if label == "" {
+			return "", "empty label in hostname"
+		}
+		if len(label) > 63 {
+			return "", "label longer than 63 characters in hostname"
+		}
+		if strings.ContainsAny(label, " \t\"") {
+			return "", "invalid character in hostname"
+		}
+	}
+	return name + ".", ""
+}
+
+func normalizeMX(record string) (string, string) {
+	fields := strings.Fields(record)
+	if len(fields) != 2 {
+		return "", "expected \"<priority> <host>\""
+	}
+
+	priority, reason := parseUint16("priority", fields[0])
+	if reason != "" {
+		return "", reason
+	}
+
+	host, reason := normalizeHostname(fields[1])
+	if reason != "" {
+		return "", reason
+	}
+
+	return fmt.Sprintf("%d %s", priority, host), ""
+}
+
+func normalizeSRV(record string) (string, string) {
+	fields := strings.Fields(record)
+	if len(fields) != 4 {
+		return "", "expected \"<priority> <weight> <port> <target>\""
+	}
+
+	var values [3]uint16
+	for i, name := range []string{"priority", "weight", "port"} {
+		v, reason := parseUint16(name, fields[i])
+		if reason != "" {
+			return "", reason
+		}
+		values[i] = v
+	}
+
+	target, reason := normalizeHostname(fields[3])
+	if reason != "" {
+		return "", reason
+	}
+
+	return fmt.Sprintf("%d %d %d %s", values[0], values[1], values[2], target), ""
+}
+
+func normalizeTXT(record string) (string, string) {
+	// Records which are already quoted are left to the server.
+	if strings.HasPrefix(record, "\"") {
+		if len(record) < 2 || !strings.HasSuffix(record, "\"") || escapedAt(record, len(record)-1) {
+			return "", "unterminated quoted string"
+		}
+		return record, ""
+	}
+
+	// Split in strings of at most 255 bytes, without splitting a multi-byte
+	// character.
+	var chunks []string
+	for len(record) > maxTXTStringLength {
+		cut := maxTXTStringLength
+		for cut > 0 && !utf8.RuneStart(record[cut]) {
+			cut--
+		}
+		chunks = append(chunks, quote(record[:cut]))
+		record = record[cut:]
+	}
+	chunks = append(chunks, quote(record))
+
+	return strings.Join(chunks, " "), ""
+}
+
+func normalizeCAA(record string) (string, string) {
+	fields := strings.SplitN(record, " ", 3)
+	if len(fields) != 3 {
+		return "", "expected \"<flags> <tag> <value>\""
+	}
+
+	flags, err := strconv.ParseUint(fields[0], 10, 8)
+	if err != nil {
+		return "", "flags must be an integer between 0 and 255"
+	}
+
+	tag := fields[1]
+	if tag == "" {
+		return "", "empty tag"
+	}
+	for _, c := range tag {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return "", "tag must be alphanumeric"
+		}
+	}
+
+	value := strings.TrimSpace(fields[2])
+	if !strings.HasPrefix(value, "\"") {
+		value = quote(value)
+	}
+
+	return fmt.Sprintf("%d %s %s", flags, strings.ToLower(tag), value), ""
+}
+
+func parseUint16(name, value string) (uint16, string) {
+	v, err := strconv.ParseUint(value, 10, 16)
+	if err != nil {
+		return 0, name + " must be an integer between 0 and 65535"
+	}
+	return uint16(v), ""
+}
+
+// escapedAt reports whether the character of s at index i is escaped, that
+// is preceded by an odd number of backslashes.
+func escapedAt(s string, i int) bool {
+	backslashes := 0
+	for j := i - 1; j >= 0 && s[j] == '\\'; j-- {
+		backslashes++
+	}
+	return backslashes%2 == 1
+}
+
+func quote(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	s = strings.ReplaceAll(s, "\"", "\\\"")
+	return "\"" + s + "\""
+}
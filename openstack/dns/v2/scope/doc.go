@@ -0,0 +1,39 @@
+/*
+Package scope allows the OpenStack DNS service to be used across projects by
+setting the x-auth-all-projects and x-auth-sudo-project-id headers on every
+request of a service client.
+
+Example to List the Zones of all Projects
+
+	adminClient, err := scope.NewServiceClient(dnsClient, scope.Opts{
+		AllProjects: true,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	allPages, err := zones.List(adminClient, nil).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Zone on behalf of another Project
+
+	sudoClient, err := scope.NewServiceClient(dnsClient, scope.Opts{
+		SudoProjectID: "4335d1f0-f793-11e2-b778-0800200c9a66",
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := zones.CreateOpts{
+		Name:  "example.com.",
+		Email: "jdoe@example.com",
+	}
+
+	zone, err := zones.Create(context.TODO(), sudoClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package scope
@@ -0,0 +1,36 @@
+package scope
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+// OptsBuilder allows extensions to add additional headers to the scoped
+// service client.
+type OptsBuilder interface {
+	ToScopeHeadersMap() (map[string]string, error)
+}
+
+// Opts specifies the project scope of the requests sent to the DNS service.
+// Both options require administrative privileges.
+type Opts struct {
+	// AllProjects shows or acts on the resources of all projects.
+	AllProjects bool `h:"X-Auth-All-Projects"`
+
+	// SudoProjectID acts on behalf of the given project.
+	SudoProjectID string `h:"X-Auth-Sudo-Project-ID"`
+}
+
+// ToScopeHeadersMap formats an Opts into a map of headers.
+func (opts Opts) ToScopeHeadersMap() (map[string]string, error) {
+	return gophercloud.BuildHeaders(opts)
+}
+
+// NewServiceClient returns a copy of client sending the headers of opts on
+// every request, so that any dns/v2 operation can be scoped without changing
+// its options. The original client is left untouched.
+func NewServiceClient(client *gophercloud.ServiceClient, opts OptsBuilder) (*gophercloud.ServiceClient, error) {
+	h, err := opts.ToScopeHeadersMap()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.With(h, ""), nil
+}
@@ -0,0 +1,2 @@
+// scope unit tests
+package testing
@@ -0,0 +1,34 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+// GetOutput is a sample response to a zone Get call.
+const GetOutput = `
+{
+    "id": "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3",
+    "project_id": "4335d1f0-f793-11e2-b778-0800200c9a66",
+    "name": "example.org.",
+    "email": "joe@example.org"
+}
+`
+
+// HandleScopedGetSuccessfully configures the test server to respond to a zone
+// Get request carrying the given scope headers.
+func HandleScopedGetSuccessfully(t *testing.T, allProjects, sudoProjectID string) {
+	th.Mux.HandleFunc("/zones/a86dba58-0043-4cc6-a1bb-69d5e86f3ca3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.CheckEquals(t, allProjects, r.Header.Get("X-Auth-All-Projects"))
+		th.CheckEquals(t, sudoProjectID, r.Header.Get("X-Auth-Sudo-Project-ID"))
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetOutput)
+	})
+}
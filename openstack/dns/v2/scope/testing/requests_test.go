@@ -0,0 +1,44 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/dns/v2/scope"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/dns/v2/zones"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestNewServiceClient(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleScopedGetSuccessfully(t, "true", "4335d1f0-f793-11e2-b778-0800200c9a66")
+
+	sc := client.ServiceClient()
+	sc.MoreHeaders = map[string]string{"X-Custom": "value"}
+
+	scoped, err := scope.NewServiceClient(sc, scope.Opts{
+		AllProjects:   true,
+		SudoProjectID: "4335d1f0-f793-11e2-b778-0800200c9a66",
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "value", scoped.MoreHeaders["X-Custom"])
+	th.CheckDeepEquals(t, map[string]string{"X-Custom": "value"}, sc.MoreHeaders)
+
+	zone, err := zones.Get(context.TODO(), scoped, "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "4335d1f0-f793-11e2-b778-0800200c9a66", zone.ProjectID)
+}
+
+func TestNewServiceClientUnscoped(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleScopedGetSuccessfully(t, "", "")
+
+	scoped, err := scope.NewServiceClient(client.ServiceClient(), scope.Opts{})
+	th.AssertNoErr(t, err)
+
+	_, err = zones.Get(context.TODO(), scoped, "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3").Extract()
+	th.AssertNoErr(t, err)
+}
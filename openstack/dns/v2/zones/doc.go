@@ -44,5 +44,25 @@ Example to Delete a Zone
 	if err != nil {
 		panic(err)
 	}
+
+Example to List the Nameservers of a Zone
+
+	nameservers, err := zones.ListNameservers(context.TODO(), dnsClient, zoneID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, ns := range nameservers {
+		fmt.Println(ns.Hostname)
+	}
+
+Example to Verify the Delegation of a Zone
+
+	err := zones.VerifyDelegation(context.TODO(), dnsClient, zoneID)
+	if e, ok := err.(zones.ErrDelegationMismatch); ok {
+		fmt.Printf("missing: %v, unexpected: %v\n", e.Missing, e.Unexpected)
+	} else if err != nil {
+		panic(err)
+	}
 */
 package zones
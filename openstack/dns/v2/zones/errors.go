@@ -0,0 +1,29 @@
+package zones
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// ErrDelegationMismatch is returned by VerifyDelegation when the nameservers
+// of a zone differ from the NS records of the pool hosting it.
+type ErrDelegationMismatch struct {
+	gophercloud.BaseError
+	ZoneID string
+	PoolID string
+
+	// Missing are the NS records of the pool which are not nameservers of
+	// the zone.
+	Missing []string
+
+	// Unexpected are the nameservers of the zone which are not NS records of
+	// the pool.
+	Unexpected []string
+}
+
+func (e ErrDelegationMismatch) Error() string {
+	return fmt.Sprintf("Nameservers of zone [%s] do not match the NS records of pool [%s]: missing [%s], unexpected [%s]",
+		e.ZoneID, e.PoolID, strings.Join(e.Missing, ", "), strings.Join(e.Unexpected, ", "))
+}
@@ -62,6 +62,13 @@ func Get(ctx context.Context, client *gophercloud.ServiceClient, zoneID string)
 	return
 }
 
+// ListNameservers returns the nameservers of a zone, given its ID.
+func ListNameservers(ctx context.Context, client *gophercloud.ServiceClient, zoneID string) (r NameserversResult) {
+	resp, err := client.Get(ctx, zoneNameserversURL(client, zoneID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // CreateOptsBuilder allows extensions to add additional attributes to the
 // Create request.
 type CreateOptsBuilder interface {
@@ -45,6 +45,30 @@ type DeleteResult struct {
 	commonResult
 }
 
+// NameserversResult is the result of a ListNameservers request. Call its
+// Extract method to interpret the result as a slice of Nameservers.
+type NameserversResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a NameserversResult as a slice of Nameservers.
+func (r NameserversResult) Extract() ([]Nameserver, error) {
+	var s struct {
+		Nameservers []Nameserver `json:"nameservers"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Nameservers, err
+}
+
+// Nameserver is a nameserver a zone is served by.
+type Nameserver struct {
+	// Hostname is the fully qualified name of the nameserver.
+	Hostname string `json:"hostname"`
+
+	// Priority is the order in which the nameserver is listed in NS records.
+	Priority int `json:"priority"`
+}
+
 // ZonePage is a single page of Zone results.
 type ZonePage struct {
 	pagination.LinkedPageBase
@@ -300,3 +300,50 @@ func HandleDeleteSuccessfully(t *testing.T) {
 			fmt.Fprint(w, DeleteZoneResponse)
 		})
 }
+
+// ListNameserversOutput is a sample response to a ListNameservers call.
+const ListNameserversOutput = `
+{
+    "nameservers": [
+        {
+            "hostname": "ns1.example.com.",
+            "priority": 1
+        },
+        {
+            "hostname": "ns2.example.com.",
+            "priority": 2
+        }
+    ]
+}
+`
+
+// ExpectedNameservers is the slice of results that should be parsed from
+// ListNameserversOutput.
+var ExpectedNameservers = []zones.Nameserver{
+	{Hostname: "ns1.example.com.", Priority: 1},
+	{Hostname: "ns2.example.com.", Priority: 2},
+}
+
+// HandleListNameserversSuccessfully configures the test server to respond to
+// a ListNameservers request.
+func HandleListNameserversSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/a86dba58-0043-4cc6-a1bb-69d5e86f3ca3/nameservers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ListNameserversOutput)
+	})
+}
+
+// HandleGetPoolSuccessfully configures the test server to respond to a pool
+// Get request with the given NS records.
+func HandleGetPoolSuccessfully(t *testing.T, nsRecords string) {
+	th.Mux.HandleFunc("/pools/572ba08c-d929-4c70-8e42-03824bb24ca2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id": "572ba08c-d929-4c70-8e42-03824bb24ca2", "name": "default", "ns_records": %s}`, nsRecords)
+	})
+}
@@ -146,3 +146,40 @@ func TestUnshare(t *testing.T) {
 	err := zones.Unshare(context.TODO(), client.ServiceClient(), "zone-id", "share-id").ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestListNameservers(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListNameserversSuccessfully(t)
+
+	actual, err := zones.ListNameservers(context.TODO(), client.ServiceClient(), "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedNameservers, actual)
+}
+
+func TestVerifyDelegation(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+	HandleListNameserversSuccessfully(t)
+	HandleGetPoolSuccessfully(t, `[{"hostname": "NS2.example.com", "priority": 1}, {"hostname": "ns1.example.com.", "priority": 2}]`)
+
+	err := zones.VerifyDelegation(context.TODO(), client.ServiceClient(), "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3")
+	th.AssertNoErr(t, err)
+}
+
+func TestVerifyDelegationMismatch(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+	HandleListNameserversSuccessfully(t)
+	HandleGetPoolSuccessfully(t, `[{"hostname": "ns1.example.com.", "priority": 1}, {"hostname": "ns3.example.com.", "priority": 2}]`)
+
+	err := zones.VerifyDelegation(context.TODO(), client.ServiceClient(), "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3")
+	e, ok := err.(zones.ErrDelegationMismatch)
+	if !ok {
+		t.Fatalf("Expected zones.ErrDelegationMismatch, got %T", err)
+	}
+	th.CheckDeepEquals(t, []string{"ns3.example.com"}, e.Missing)
+	th.CheckDeepEquals(t, []string{"ns2.example.com"}, e.Unexpected)
+}
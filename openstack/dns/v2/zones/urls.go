@@ -21,3 +21,8 @@ func zoneShareURL(c *gophercloud.ServiceClient, zoneID string) string {
 func zoneUnshareURL(c *gophercloud.ServiceClient, zoneID, shareID string) string {
 	return c.ServiceURL("zones", zoneID, "shares", shareID)
 }
+
+// zoneNameserversURL returns the URL for listing the nameservers of a zone.
+func zoneNameserversURL(c *gophercloud.ServiceClient, zoneID string) string {
+	return c.ServiceURL("zones", zoneID, "nameservers")
+}
@@ -0,0 +1,72 @@
+package zones
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/dns/v2/pools"
+)
+
+// VerifyDelegation compares the nameservers of a zone with the NS records of
+// the pool hosting it. An ErrDelegationMismatch is returned when they differ.
+// Hostnames are compared case-insensitively, ignoring the trailing dot.
+//
+// Getting the pool usually requires administrative privileges.
+func VerifyDelegation(ctx context.Context, client *gophercloud.ServiceClient, zoneID string) error {
+	zone, err := Get(ctx, client, zoneID).Extract()
+	if err != nil {
+		return err
+	}
+
+	nameservers, err := ListNameservers(ctx, client, zoneID).Extract()
+	if err != nil {
+		return err
+	}
+
+	pool, err := pools.Get(ctx, client, zone.PoolID).Extract()
+	if err != nil {
+		return err
+	}
+
+	expected := make(map[string]bool, len(pool.NSRecords))
+	for _, ns := range pool.NSRecords {
+		expected[normalizeHostname(ns.Hostname)] = true
+	}
+
+	actual := make(map[string]bool, len(nameservers))
+	for _, ns := range nameservers {
+		actual[normalizeHostname(ns.Hostname)] = true
+	}
+
+	var missing, unexpected []string
+	for h := range expected {
+		if !actual[h] {
+			missing = append(missing, h)
+		}
+	}
+	for h := range actual {
+		if !expected[h] {
+			unexpected = append(unexpected, h)
+		}
+	}
+
+	if len(missing) == 0 && len(unexpected) == 0 {
+		return nil
+	}
+
+	sort.Strings(missing)
+	sort.Strings(unexpected)
+
+	return ErrDelegationMismatch{
+		ZoneID:     zoneID,
+		PoolID:     zone.PoolID,
+		Missing:    missing,
+		Unexpected: unexpected,
+	}
+}
+
+func normalizeHostname(hostname string) string {
+	return strings.ToLower(strings.TrimSuffix(hostname, "."))
+}
@@ -10,5 +10,15 @@ Example of Creating a Service Client
 	client, err := openstack.NewNetworkV2(provider, gophercloud.EndpointOpts{
 		Region: os.Getenv("OS_REGION_NAME"),
 	})
+
+Example of Failing Over Between Identity Endpoints
+
+	ao, err := openstack.AuthOptionsFromEnv()
+	provider, err := openstack.NewFailoverClient(
+		"https://keystone.region-one.example.com:5000/",
+		"https://keystone.region-two.example.com:5000/",
+	)
+	provider.IdentityFailover.Cooldown = time.Minute
+	err = openstack.Authenticate(context.TODO(), provider, ao)
 */
 package openstack
@@ -2,6 +2,7 @@ package openstack
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
 )
@@ -45,3 +46,26 @@ type ErrNoPassword struct{ gophercloud.ErrInvalidInput }
 func (e ErrNoPassword) Error() string {
 	return "Environment variable OS_PASSWORD needs to be set."
 }
+
+// ErrIdentityUnreachable is the error when none of the identity endpoints of
+// a client's IdentityFailover could be reached.
+type ErrIdentityUnreachable struct {
+	gophercloud.BaseError
+
+	// Errors are the errors returned by each endpoint, in the order in which
+	// they were tried.
+	Errors []error
+}
+
+func (e ErrIdentityUnreachable) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		msgs = append(msgs, err.Error())
+	}
+	return fmt.Sprintf("None of the identity endpoints could be reached: %s", strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the errors returned by each endpoint.
+func (e ErrIdentityUnreachable) Unwrap() []error {
+	return e.Errors
+}
@@ -0,0 +1,91 @@
+/*
+Package applicationcredentials enables management of OpenStack Identity
+Application Credentials and their Access Rules.
+
+Example to List Application Credentials
+
+	listOpts := applicationcredentials.ListOpts{}
+
+	allPages, err := applicationcredentials.List(identityClient, userID, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allApplicationCredentials, err := applicationcredentials.ExtractApplicationCredentials(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, applicationCredential := range allApplicationCredentials {
+		fmt.Printf("%+v\n", applicationCredential)
+	}
+
+Example to Get an Application Credential
+
+	applicationCredential, err := applicationcredentials.Get(context.TODO(), identityClient, userID, applicationCredentialID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create an Application Credential with Access Rules
+
+	createOpts := applicationcredentials.CreateOpts{
+		Name: "monitoring",
+		Roles: []applicationcredentials.Role{
+			{Name: "reader"},
+		},
+		AccessRules: []applicationcredentials.AccessRule{
+			{
+				Path:    "/v2.0/metrics",
+				Method:  "GET",
+				Service: "monitoring",
+			},
+		},
+	}
+
+	applicationCredential, err := applicationcredentials.Create(context.TODO(), identityClient, userID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	// NOTE: the secret is only returned in the create response
+	fmt.Printf("Application credential secret: %s\n", applicationCredential.Secret)
+
+Example to Delete an Application Credential
+
+	err := applicationcredentials.Delete(context.TODO(), identityClient, userID, applicationCredentialID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Access Rules
+
+	allPages, err := applicationcredentials.ListAccessRules(identityClient, userID).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allAccessRules, err := applicationcredentials.ExtractAccessRules(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, accessRule := range allAccessRules {
+		fmt.Printf("%+v\n", accessRule)
+	}
+
+Example to Get an Access Rule
+
+	accessRule, err := applicationcredentials.GetAccessRule(context.TODO(), identityClient, userID, accessRuleID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Access Rule
+
+	err := applicationcredentials.DeleteAccessRule(context.TODO(), identityClient, userID, accessRuleID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package applicationcredentials
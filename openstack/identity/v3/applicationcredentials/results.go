@@ -17,7 +17,7 @@ type Role struct {
 	Name string `json:"name,omitempty"`
 }
 
-// ApplicationCredential represents the access rule object
+// AccessRule represents the access rule object
 type AccessRule struct {
 	// The ID of the access rule
 	ID string `json:"id,omitempty"`
@@ -0,0 +1,24 @@
+/*
+Package catalog provides the ability to retrieve the service catalog of the
+current token from the OpenStack Identity service (GET /v3/auth/catalog).
+
+Together with projects.ListAvailable and domains.ListAvailable it allows a
+client holding only an unscoped token to discover what it can scope to.
+
+Example to List the Service Catalog
+
+	allPages, err := catalog.List(identityClient).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allEntries, err := catalog.ExtractServiceCatalog(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, entry := range allEntries {
+		fmt.Printf("%s: %+v\n", entry.Type, entry.Endpoints)
+	}
+*/
+package catalog
@@ -0,0 +1,77 @@
+/*
+Package credentials provides information and interaction with the credentials
+API resource for the OpenStack Identity service.
+
+Example to List Credentials
+
+	listOpts := credentials.ListOpts{
+		UserID: "bb5476fd12884539b41d5a88f838d773",
+		Type:   credentials.TypeEC2,
+	}
+
+	allPages, err := credentials.List(identityClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allCredentials, err := credentials.ExtractCredentials(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, credential := range allCredentials {
+		blob, err := credential.ExtractEC2Blob()
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("%s: %s\n", credential.ID, blob.Access)
+	}
+
+Example to Create an EC2 Credential
+
+	blob, err := credentials.EC2Blob{
+		Access: "181920",
+		Secret: "secretKey",
+	}.ToCredentialBlob()
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := credentials.CreateOpts{
+		ProjectID: "731fc6f265cd486d900f16e84c5cb594",
+		Type:      credentials.TypeEC2,
+		UserID:    "bb5476fd12884539b41d5a88f838d773",
+		Blob:      blob,
+	}
+
+	credential, err := credentials.Create(context.TODO(), identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Rotate the seed of a TOTP Credential
+
+	blob, err := credentials.TOTPBlob(newSeed)
+	if err != nil {
+		panic(err)
+	}
+
+	updateOpts := credentials.UpdateOpts{
+		Blob: blob,
+	}
+
+	credentialID := "3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510"
+	credential, err := credentials.Update(context.TODO(), identityClient, credentialID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Credential
+
+	credentialID := "3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510"
+	err := credentials.Delete(context.TODO(), identityClient, credentialID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package credentials
@@ -0,0 +1,20 @@
+package credentials
+
+import (
+	"fmt"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// ErrCredentialType is returned when the blob of a credential is parsed as a
+// type the credential does not have.
+type ErrCredentialType struct {
+	gophercloud.BaseError
+	ID       string
+	Expected string
+	Actual   string
+}
+
+func (e ErrCredentialType) Error() string {
+	return fmt.Sprintf("Credential [%s] has type [%s], expected [%s]", e.ID, e.Actual, e.Expected)
+}
@@ -2,11 +2,74 @@ package credentials
 
 import (
 	"context"
+	"encoding/base32"
+	"encoding/json"
+	"encoding/pem"
+	"strings"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
 
+// Credential types with a well known blob format.
+const (
+	TypeEC2  = "ec2"
+	TypeCert = "cert"
+	TypeTOTP = "totp"
+)
+
+// EC2Blob is the content of the blob of an "ec2" credential.
+type EC2Blob struct {
+	// Access is the EC2 access key.
+	Access string `json:"access" required:"true"`
+	// Secret is the EC2 secret key.
+	Secret string `json:"secret" required:"true"`
+	// TrustID is the ID of the trust the credential was created for, if any.
+	TrustID string `json:"trust_id,omitempty"`
+}
+
+// ToCredentialBlob serializes an EC2Blob into a credential blob.
+func (b EC2Blob) ToCredentialBlob() (string, error) {
+	m, err := gophercloud.BuildRequestBody(b, "")
+	if err != nil {
+		return "", err
+	}
+	j, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	return string(j), nil
+}
+
+// TOTPBlob validates a base32 encoded TOTP seed and returns it in the form
+// expected by a "totp" credential blob: upper case and without padding.
+func TOTPBlob(secret string) (string, error) {
+	secret = strings.TrimRight(strings.ToUpper(strings.TrimSpace(secret)), "=")
+	if secret == "" {
+		return "", gophercloud.ErrMissingInput{Argument: "secret"}
+	}
+	if _, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret); err != nil {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "secret"
+		err.Info = "TOTP secret must be base32 encoded"
+		return "", err
+	}
+	return secret, nil
+}
+
+// CertBlob validates a PEM encoded certificate and returns it as a "cert"
+// credential blob.
+func CertBlob(certificate string) (string, error) {
+	block, _ := pem.Decode([]byte(certificate))
+	if block == nil || block.Type != "CERTIFICATE" {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "certificate"
+		err.Info = "certificate must be PEM encoded"
+		return "", err
+	}
+	return certificate, nil
+}
+
 // ListOptsBuilder allows extensions to add additional parameters to
 // the List request
 type ListOptsBuilder interface {
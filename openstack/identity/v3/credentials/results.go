@@ -1,6 +1,8 @@
 package credentials
 
 import (
+	"encoding/json"
+
 	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
@@ -21,6 +23,20 @@ type Credential struct {
 	Links map[string]any `json:"links"`
 }
 
+// ExtractEC2Blob parses the blob of an "ec2" credential. An
+// ErrCredentialType error is returned for credentials of another type.
+func (c Credential) ExtractEC2Blob() (*EC2Blob, error) {
+	if c.Type != TypeEC2 {
+		return nil, ErrCredentialType{ID: c.ID, Expected: TypeEC2, Actual: c.Type}
+	}
+
+	var b EC2Blob
+	if err := json.Unmarshal([]byte(c.Blob), &b); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
 type credentialResult struct {
 	gophercloud.Result
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/credentials"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
@@ -98,3 +99,57 @@ func TestUpdateCredential(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.CheckDeepEquals(t, SecondCredentialUpdated, *actual)
 }
+
+func TestEC2Blob(t *testing.T) {
+	blob, err := credentials.EC2Blob{Access: "181920", Secret: "secretKey"}.ToCredentialBlob()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, Credential.Blob, blob)
+
+	actual, err := Credential.ExtractEC2Blob()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, credentials.EC2Blob{Access: "181920", Secret: "secretKey"}, *actual)
+
+	_, err = credentials.EC2Blob{Access: "181920"}.ToCredentialBlob()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("expected ErrMissingInput, got %v", err)
+	}
+
+	totp := Credential
+	totp.Type = credentials.TypeTOTP
+	_, err = totp.ExtractEC2Blob()
+	if _, ok := err.(credentials.ErrCredentialType); !ok {
+		t.Fatalf("expected ErrCredentialType, got %v", err)
+	}
+}
+
+func TestTOTPBlob(t *testing.T) {
+	blob, err := credentials.TOTPBlob(" gezdgnbvgy3tqojq== ")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "GEZDGNBVGY3TQOJQ", blob)
+
+	_, err = credentials.TOTPBlob("not-base32!")
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+
+	_, err = credentials.TOTPBlob("")
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("expected ErrMissingInput, got %v", err)
+	}
+}
+
+func TestCertBlob(t *testing.T) {
+	cert := "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+	blob, err := credentials.CertBlob(cert)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, cert, blob)
+
+	_, err = credentials.CertBlob("not a certificate")
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestCredentialSchema(t *testing.T) {
+	th.AssertJSONSchema(t, GetOutput, "identity/v3/credential.json")
+}
@@ -22,6 +22,18 @@ Example to List Domains
 		fmt.Printf("%+v\n", domain)
 	}
 
+Example to List Domains available to the current token
+
+	allPages, err := domains.ListAvailable(identityClient).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allDomains, err := domains.ExtractDomains(allPages)
+	if err != nil {
+		panic(err)
+	}
+
 Example to Create a Domain
 
 	createOpts := domains.CreateOpts{
@@ -55,5 +67,15 @@ Example to Delete a Domain
 	if err != nil {
 		panic(err)
 	}
+
+Example to Disable and Delete a Domain
+
+	// Keystone only deletes disabled domains. DisableAndDelete disables the
+	// domain first if needed, and ignores domains which are already gone.
+	domainID := "0fe36e73809d46aeae6705c39077b1b3"
+	err := domains.DisableAndDelete(context.TODO(), identityClient, domainID)
+	if err != nil {
+		panic(err)
+	}
 */
 package domains
@@ -0,0 +1,18 @@
+package domains
+
+import (
+	"fmt"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// ErrDomainEnabled is returned by DeleteIfDisabled when the domain is still
+// enabled. Keystone refuses to delete enabled domains.
+type ErrDomainEnabled struct {
+	gophercloud.BaseError
+	DomainID string
+}
+
+func (e ErrDomainEnabled) Error() string {
+	return fmt.Sprintf("Domain [%s] is enabled and must be disabled before it can be deleted", e.DomainID)
+}
@@ -138,3 +138,22 @@ func Update(ctx context.Context, client *gophercloud.ServiceClient, id string, o
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// IDFromName is a convenience function that returns a domain's ID given its
+// name. It returns a gophercloud.ErrResourceNotFound if no domain matches the
+// name, and a gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	pages, err := List(client, ListOpts{Name: name}).AllPages(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	all, err := ExtractDomains(pages)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.IDFromName(all, name, "domain", func(r Domain) (string, string) {
+		return r.Name, r.ID
+	})
+}
@@ -3,6 +3,7 @@ package testing
 import (
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/domains"
@@ -257,3 +258,35 @@ func HandleUpdateDomainSuccessfully(t *testing.T) {
 		fmt.Fprint(w, UpdateOutput)
 	})
 }
+
+// HandleDisableAndDeleteDomainSuccessfully creates an HTTP handler at
+// `/domains/9fe1d3` on the test handler mux that serves an enabled domain
+// until it is disabled, and then accepts its deletion.
+func HandleDisableAndDeleteDomainSuccessfully(t *testing.T) {
+	enabled := true
+	th.Mux.HandleFunc("/domains/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "GET":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, strings.Replace(GetOutput, `"enabled": true`, fmt.Sprintf(`"enabled": %t`, enabled), 1))
+		case "PATCH":
+			th.TestJSONRequest(t, r, `{"domain": {"enabled": false}}`)
+			enabled = false
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, strings.Replace(GetOutput, `"enabled": true`, `"enabled": false`, 1))
+		case "DELETE":
+			if enabled {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+}
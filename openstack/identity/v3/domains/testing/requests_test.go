@@ -2,6 +2,8 @@ package testing
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/domains"
@@ -109,3 +111,62 @@ func TestUpdateDomain(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.CheckDeepEquals(t, SecondDomainUpdated, *actual)
 }
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/domains", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, `
+{
+	"domains": [
+		{"id": "2bc7a2a9-7c2a-4a8c-9f0e-5d4b7a2b1c01", "name": "domain-1"},
+		{"id": "7f3c8e1d-51b4-4a8e-8a1e-3d9c0e6f4b02", "name": "domain-2"},
+		{"id": "c4d1f0e2-9b7a-4f6c-8d3e-1a2b5c6d7e03", "name": "domain-2"}
+	]
+}`)
+	})
+
+	id, err := domains.IDFromName(context.TODO(), client.ServiceClient(), "domain-1")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2bc7a2a9-7c2a-4a8c-9f0e-5d4b7a2b1c01", id)
+}
+
+func TestDisableAndDeleteDomain(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDisableAndDeleteDomainSuccessfully(t)
+
+	err := domains.DisableAndDelete(context.TODO(), client.ServiceClient(), "9fe1d3")
+	th.AssertNoErr(t, err)
+}
+
+func TestDisableAndDeleteDomainNotFound(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/domains/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	err := domains.DisableAndDelete(context.TODO(), client.ServiceClient(), "9fe1d3")
+	th.AssertNoErr(t, err)
+}
+
+func TestDeleteIfDisabledEnabledDomain(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetDomainSuccessfully(t)
+
+	err := domains.DeleteIfDisabled(context.TODO(), client.ServiceClient(), "9fe1d3")
+	if _, ok := err.(domains.ErrDomainEnabled); !ok {
+		t.Fatalf("Expected ErrDomainEnabled but got %v", err)
+	}
+}
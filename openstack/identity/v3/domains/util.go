@@ -0,0 +1,51 @@
+package domains
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// DeleteIfDisabled deletes a domain only if it is disabled. An
+// ErrDomainEnabled is returned, and no deletion is attempted, if the domain is
+// still enabled.
+func DeleteIfDisabled(ctx context.Context, client *gophercloud.ServiceClient, domainID string) error {
+	domain, err := Get(ctx, client, domainID).Extract()
+	if err != nil {
+		return err
+	}
+
+	if domain.Enabled {
+		return ErrDomainEnabled{DomainID: domainID}
+	}
+
+	return Delete(ctx, client, domainID).ExtractErr()
+}
+
+// DisableAndDelete disables a domain if needed and then deletes it, as
+// Keystone requires. It is idempotent: a domain which does not exist is
+// considered deleted and no error is returned.
+func DisableAndDelete(ctx context.Context, client *gophercloud.ServiceClient, domainID string) error {
+	domain, err := Get(ctx, client, domainID).Extract()
+	if err != nil {
+		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if domain.Enabled {
+		enabled := false
+		_, err := Update(ctx, client, domainID, UpdateOpts{Enabled: &enabled}).Extract()
+		if err != nil {
+			return err
+		}
+	}
+
+	err = Delete(ctx, client, domainID).ExtractErr()
+	if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		return nil
+	}
+	return err
+}
@@ -16,5 +16,50 @@ Example to Create an EC2 credential
 	if err != nil {
 		panic(err)
 	}
+
+	// the access/secret pair can be used with S3 compatible clients
+	fmt.Printf("Access: %s, Secret: %s\n", credential.Access, credential.Secret)
+
+Example to List EC2 credentials
+
+	allPages, err := ec2credentials.List(identityClient, userID).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allCredentials, err := ec2credentials.ExtractCredentials(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, credential := range allCredentials {
+		fmt.Printf("%+v\n", credential)
+	}
+
+Example to Delete an EC2 credential
+
+	err := ec2credentials.Delete(context.TODO(), identityClient, userID, credential.Access).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Sign an S3 Request with an EC2 credential
+
+	credential, err := ec2credentials.Get(context.TODO(), identityClient, userID, accessID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	req, err := http.NewRequest("GET", "https://rgw.example.com/bucket/object", nil)
+	if err != nil {
+		panic(err)
+	}
+
+	err = credential.SignS3Request(req, ec2credentials.S3SignOpts{})
+	if err != nil {
+		panic(err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
 */
 package ec2credentials
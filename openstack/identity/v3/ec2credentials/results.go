@@ -5,7 +5,7 @@ import (
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
 
-// Credential represents the application credential object
+// Credential represents the EC2 credential object
 type Credential struct {
 	// UserID contains a User ID of the EC2 credential owner.
 	UserID string `json:"user_id"`
@@ -17,7 +17,7 @@ type Credential struct {
 	Secret string `json:"secret"`
 	// TrustID contains an EC2 credential trust ID scope.
 	TrustID string `json:"trust_id"`
-	// Links contains referencing links to the application credential.
+	// Links contains referencing links to the EC2 credential.
 	Links map[string]any `json:"links"`
 }
 
@@ -0,0 +1,237 @@
+package ec2credentials
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/ec2tokens"
+)
+
+const (
+	// S3UnsignedPayload is the payload hash sent when the request body is
+	// not part of the signature.
+	S3UnsignedPayload = "UNSIGNED-PAYLOAD"
+
+	// DefaultS3Region is the region used to sign S3 requests when none is
+	// set. RadosGW and Swift3 accept it unless configured otherwise.
+	DefaultS3Region = "us-east-1"
+
+	// DefaultS3Service is the service name used to sign S3 requests.
+	DefaultS3Service = "s3"
+)
+
+// s3UnsignedHeaders are the headers left out of the signature, since they
+// may be changed by proxies or the HTTP client.
+var s3UnsignedHeaders = map[string]bool{
+	"authorization":  true,
+	"user-agent":     true,
+	"content-length": true,
+	"expect":         true,
+}
+
+// S3SignOpts specifies the parameters used by SignS3Request.
+type S3SignOpts struct {
+	// Region is the region of the S3 endpoint. Defaults to DefaultS3Region.
+	Region string
+
+	// Service is the service name of the S3 endpoint. Defaults to
+	// DefaultS3Service.
+	Service string
+
+	// PayloadHash is the hex encoded SHA256 hash of the request body, or
+	// S3UnsignedPayload. When empty, the body is hashed if it can be read
+	// again through the request GetBody, and left unsigned otherwise.
+	PayloadHash string
+
+	// Timestamp is the signing time. Defaults to the current time.
+	Timestamp *time.Time
+}
+
+// SignS3Request signs an HTTP request with the AWS signature V4 of the EC2
+// credential, so it can be sent to an S3 compatible endpoint such as RadosGW
+// or the Swift3/s3api middleware. It sets the Host, X-Amz-Date,
+// X-Amz-Content-Sha256 and Authorization headers of the request.
+func (c Credential) SignS3Request(req *http.Request, opts S3SignOpts) error {
+	if c.Access == "" {
+		return gophercloud.ErrMissingInput{Argument: "ec2credentials.Credential.Access"}
+	}
+	if c.Secret == "" {
+		return gophercloud.ErrMissingInput{Argument: "ec2credentials.Credential.Secret"}
+	}
+
+	region := opts.Region
+	if region == "" {
+		region = DefaultS3Region
+	}
+	service := opts.Service
+	if service == "" {
+		service = DefaultS3Service
+	}
+
+	date := time.Now().UTC()
+	if opts.Timestamp != nil {
+		date = opts.Timestamp.UTC()
+	}
+
+	payloadHash := opts.PayloadHash
+	if payloadHash == "" {
+		var err error
+		payloadHash, err = s3PayloadHash(req)
+		if err != nil {
+			return err
+		}
+	}
+
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	req.Header.Set("X-Amz-Date", date.Format(ec2tokens.EC2CredentialsTimestampFormatV4))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": host}
+	for k, v := range req.Header {
+		k = strings.ToLower(k)
+		if s3UnsignedHeaders[k] {
+			continue
+		}
+		values := make([]string, len(v))
+		for i := range v {
+			values[i] = strings.Join(strings.Fields(v[i]), " ")
+		}
+		headers[k] = strings.Join(values, ",")
+	}
+
+	var keys []string
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	signedHeaders := strings.Join(keys, ";")
+
+	var canonicalHeaders strings.Builder
+	for _, k := range keys {
+		canonicalHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		s3CanonicalURI(req.URL.Path),
+		s3CanonicalQueryString(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	hash := sha256.Sum256([]byte(canonicalRequest))
+
+	stringToSign := strings.Join([]string{
+		ec2tokens.EC2CredentialsAwsHmacV4,
+		date.Format(ec2tokens.EC2CredentialsTimestampFormatV4),
+		strings.Join([]string{
+			date.Format(ec2tokens.EC2CredentialsDateFormatV4),
+			region,
+			service,
+			ec2tokens.EC2CredentialsAwsRequestV4,
+		}, "/"),
+		hex.EncodeToString(hash[:]),
+	}, "\n")
+
+	key := ec2tokens.EC2CredentialsBuildSignatureKeyV4(c.Secret, region, service, date)
+	signature := ec2tokens.EC2CredentialsBuildSignatureV4(key, []byte(stringToSign))
+
+	authOpts := ec2tokens.AuthOptions{
+		Access:  c.Access,
+		Region:  region,
+		Service: service,
+	}
+	req.Header.Set("Authorization", ec2tokens.EC2CredentialsBuildAuthorizationHeaderV4(authOpts, signedHeaders, signature, date))
+
+	return nil
+}
+
+// s3PayloadHash returns the hex encoded SHA256 hash of the request body, or
+// S3UnsignedPayload when the body can't be read without consuming it.
+func s3PayloadHash(req *http.Request) (string, error) {
+	var body []byte
+	switch {
+	case req.Body == nil || req.Body == http.NoBody:
+	case req.GetBody != nil:
+		r, err := req.GetBody()
+		if err != nil {
+			return "", err
+		}
+		defer r.Close()
+
+		var buf bytes.Buffer
+		if _, err := io.Copy(&buf, r); err != nil {
+			return "", err
+		}
+		body = buf.Bytes()
+	default:
+		return S3UnsignedPayload, nil
+	}
+
+	hash := sha256.Sum256(body)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// s3CanonicalURI encodes every segment of a path as required by the AWS
+// signature V4 for S3, which doesn't normalize the path.
+func s3CanonicalURI(path string) string {
+	if path == "" {
+		return "/"
+	}
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		segments[i] = s3URIEncode(s)
+	}
+	return strings.Join(segments, "/")
+}
+
+// s3CanonicalQueryString builds the sorted and encoded query string of an
+// AWS signature V4.
+func s3CanonicalQueryString(query map[string][]string) string {
+	encoded := make(map[string][]string, len(query))
+	var keys []string
+	for k, values := range query {
+		ek := s3URIEncode(k)
+		keys = append(keys, ek)
+		for _, v := range values {
+			encoded[ek] = append(encoded[ek], s3URIEncode(v))
+		}
+		sort.Strings(encoded[ek])
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, k := range keys {
+		for _, v := range encoded[k] {
+			pairs = append(pairs, k+"="+v)
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// s3URIEncode percent-encodes every byte except the RFC 3986 unreserved
+// characters.
+func s3URIEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
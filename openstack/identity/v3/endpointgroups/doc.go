@@ -0,0 +1,128 @@
+/*
+Package endpointgroups manages endpoint groups and their project associations
+through the OS-EP-FILTER extension of the OpenStack Identity Service.
+
+An endpoint group selects endpoints by interface, service and region. Once
+associated with a project, the matching endpoints are the only ones listed in
+the catalog of tokens scoped to that project.
+
+For more information, see:
+https://docs.openstack.org/api-ref/identity/v3-ext/#os-ep-filter-api
+
+Example to List Endpoint Groups
+
+	allPages, err := endpointgroups.List(identityClient, nil).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allEndpointGroups, err := endpointgroups.ExtractEndpointGroups(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, endpointGroup := range allEndpointGroups {
+		fmt.Printf("%+v\n", endpointGroup)
+	}
+
+Example to Create an Endpoint Group
+
+	createOpts := endpointgroups.CreateOpts{
+		Name:        "public-region-one",
+		Description: "Public endpoints of RegionOne",
+		Filters: endpointgroups.Filters{
+			Availability: gophercloud.AvailabilityPublic,
+			RegionID:     "RegionOne",
+		},
+	}
+
+	endpointGroup, err := endpointgroups.Create(context.TODO(), identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update an Endpoint Group
+
+	endpointGroupID := "ac4861"
+
+	description := ""
+	updateOpts := endpointgroups.UpdateOpts{
+		Description: &description,
+	}
+
+	endpointGroup, err := endpointgroups.Update(context.TODO(), identityClient, endpointGroupID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Endpoint Group
+
+	endpointGroupID := "ac4861"
+	err := endpointgroups.Delete(context.TODO(), identityClient, endpointGroupID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Associate an Endpoint Group with a Project
+
+	endpointGroupID := "ac4861"
+	projectID := "e629d6e599d9489fb3ae5d9cc12eaea3"
+
+	err := endpointgroups.AssociateProject(context.TODO(), identityClient, endpointGroupID, projectID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Check an Endpoint Group Association
+
+	associated, err := endpointgroups.CheckProject(context.TODO(), identityClient, endpointGroupID, projectID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Endpoint group is associated: %v\n", associated)
+
+Example to List the Projects of an Endpoint Group
+
+	allPages, err := endpointgroups.ListProjects(identityClient, endpointGroupID).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allProjects, err := projects.ExtractProjects(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the Endpoints of an Endpoint Group
+
+	allPages, err := endpointgroups.ListEndpoints(identityClient, endpointGroupID).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allEndpoints, err := endpoints.ExtractEndpoints(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the Endpoint Groups of a Project
+
+	allPages, err := endpointgroups.ListForProject(identityClient, projectID).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allEndpointGroups, err := endpointgroups.ExtractEndpointGroups(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Disassociate an Endpoint Group from a Project
+
+	err := endpointgroups.DisassociateProject(context.TODO(), identityClient, endpointGroupID, projectID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package endpointgroups
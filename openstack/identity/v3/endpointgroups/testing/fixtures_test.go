@@ -0,0 +1,338 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/endpointgroups"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+// ListOutput provides a single page of EndpointGroup results.
+const ListOutput = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups"
+    },
+    "endpoint_groups": [
+        {
+            "id": "ac4861",
+            "name": "public-region-one",
+            "description": "Public endpoints of RegionOne",
+            "filters": {
+                "interface": "public",
+                "region_id": "RegionOne"
+            },
+            "links": {
+                "self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups/ac4861"
+            }
+        },
+        {
+            "id": "3de58c",
+            "name": "compute",
+            "description": "",
+            "filters": {
+                "service_id": "1b501a"
+            },
+            "links": {
+                "self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups/3de58c"
+            }
+        }
+    ]
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+    "endpoint_group": {
+        "id": "ac4861",
+        "name": "public-region-one",
+        "description": "Public endpoints of RegionOne",
+        "filters": {
+            "interface": "public",
+            "region_id": "RegionOne"
+        },
+        "links": {
+            "self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups/ac4861"
+        }
+    }
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+    "endpoint_group": {
+        "name": "public-region-one",
+        "description": "Public endpoints of RegionOne",
+        "filters": {
+            "interface": "public",
+            "region_id": "RegionOne"
+        }
+    }
+}
+`
+
+// UpdateRequest provides the input to an Update request.
+const UpdateRequest = `
+{
+    "endpoint_group": {
+        "description": "",
+        "filters": {
+            "interface": "internal"
+        }
+    }
+}
+`
+
+// UpdateOutput provides an Update result.
+const UpdateOutput = `
+{
+    "endpoint_group": {
+        "id": "ac4861",
+        "name": "public-region-one",
+        "description": "",
+        "filters": {
+            "interface": "internal"
+        },
+        "links": {
+            "self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups/ac4861"
+        }
+    }
+}
+`
+
+// ListProjectsOutput provides a single page of projects associated with an
+// endpoint group.
+const ListProjectsOutput = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups/ac4861/projects"
+    },
+    "projects": [
+        {
+            "id": "e629d6",
+            "name": "demo",
+            "domain_id": "default",
+            "enabled": true,
+            "is_domain": false,
+            "description": "",
+            "parent_id": "default"
+        }
+    ]
+}
+`
+
+// ListEndpointsOutput provides a single page of endpoints matching an
+// endpoint group.
+const ListEndpointsOutput = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups/ac4861/endpoints"
+    },
+    "endpoints": [
+        {
+            "id": "6fedc0",
+            "interface": "public",
+            "region": "RegionOne",
+            "region_id": "RegionOne",
+            "service_id": "1b501a",
+            "url": "http://example.com/compute/",
+            "enabled": true
+        }
+    ]
+}
+`
+
+// FirstEndpointGroup is the first endpoint group in the List request.
+var FirstEndpointGroup = endpointgroups.EndpointGroup{
+	ID:          "ac4861",
+	Name:        "public-region-one",
+	Description: "Public endpoints of RegionOne",
+	Filters: endpointgroups.Filters{
+		Availability: gophercloud.AvailabilityPublic,
+		RegionID:     "RegionOne",
+	},
+	Links: map[string]any{
+		"self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups/ac4861",
+	},
+}
+
+// SecondEndpointGroup is the second endpoint group in the List request.
+var SecondEndpointGroup = endpointgroups.EndpointGroup{
+	ID:          "3de58c",
+	Name:        "compute",
+	Description: "",
+	Filters: endpointgroups.Filters{
+		ServiceID: "1b501a",
+	},
+	Links: map[string]any{
+		"self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups/3de58c",
+	},
+}
+
+// FirstEndpointGroupUpdated is how FirstEndpointGroup should look after an
+// Update.
+var FirstEndpointGroupUpdated = endpointgroups.EndpointGroup{
+	ID:          "ac4861",
+	Name:        "public-region-one",
+	Description: "",
+	Filters: endpointgroups.Filters{
+		Availability: gophercloud.AvailabilityInternal,
+	},
+	Links: map[string]any{
+		"self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups/ac4861",
+	},
+}
+
+// ExpectedEndpointGroupsSlice is the slice of endpoint groups expected to be
+// returned from ListOutput.
+var ExpectedEndpointGroupsSlice = []endpointgroups.EndpointGroup{FirstEndpointGroup, SecondEndpointGroup}
+
+// HandleListEndpointGroupsSuccessfully creates an HTTP handler at
+// `/OS-EP-FILTER/endpoint_groups` on the test handler mux that responds with
+// a list of two endpoint groups.
+func HandleListEndpointGroupsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-EP-FILTER/endpoint_groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListOutput)
+	})
+}
+
+// HandleGetEndpointGroupSuccessfully creates an HTTP handler at
+// `/OS-EP-FILTER/endpoint_groups/ac4861` on the test handler mux that
+// responds with a single endpoint group.
+func HandleGetEndpointGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-EP-FILTER/endpoint_groups/ac4861", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleCreateEndpointGroupSuccessfully creates an HTTP handler at
+// `/OS-EP-FILTER/endpoint_groups` on the test handler mux that tests endpoint
+// group creation.
+func HandleCreateEndpointGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-EP-FILTER/endpoint_groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleUpdateEndpointGroupSuccessfully creates an HTTP handler at
+// `/OS-EP-FILTER/endpoint_groups/ac4861` on the test handler mux that tests
+// endpoint group updates.
+func HandleUpdateEndpointGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-EP-FILTER/endpoint_groups/ac4861", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateOutput)
+	})
+}
+
+// HandleDeleteEndpointGroupSuccessfully creates an HTTP handler at
+// `/OS-EP-FILTER/endpoint_groups/ac4861` on the test handler mux that tests
+// endpoint group deletion.
+func HandleDeleteEndpointGroupSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-EP-FILTER/endpoint_groups/ac4861", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleProjectAssociationSuccessfully creates an HTTP handler at
+// `/OS-EP-FILTER/endpoint_groups/ac4861/projects/e629d6` on the test handler
+// mux that tests associating, checking and disassociating a project. A HEAD
+// request for any other project responds with 404.
+func HandleProjectAssociationSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-EP-FILTER/endpoint_groups/ac4861/projects/e629d6", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "PUT", "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		case "HEAD":
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	th.Mux.HandleFunc("/OS-EP-FILTER/endpoint_groups/ac4861/projects/missing", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "HEAD")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNotFound)
+	})
+}
+
+// HandleListProjectsSuccessfully creates an HTTP handler at
+// `/OS-EP-FILTER/endpoint_groups/ac4861/projects` on the test handler mux
+// that responds with a list of projects.
+func HandleListProjectsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-EP-FILTER/endpoint_groups/ac4861/projects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListProjectsOutput)
+	})
+}
+
+// HandleListEndpointsSuccessfully creates an HTTP handler at
+// `/OS-EP-FILTER/endpoint_groups/ac4861/endpoints` on the test handler mux
+// that responds with a list of endpoints.
+func HandleListEndpointsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-EP-FILTER/endpoint_groups/ac4861/endpoints", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListEndpointsOutput)
+	})
+}
+
+// HandleListForProjectSuccessfully creates an HTTP handler at
+// `/OS-EP-FILTER/projects/e629d6/endpoint_groups` on the test handler mux
+// that responds with a list of two endpoint groups.
+func HandleListForProjectSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-EP-FILTER/projects/e629d6/endpoint_groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListOutput)
+	})
+}
@@ -0,0 +1,164 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/endpointgroups"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/endpoints"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/projects"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestListEndpointGroups(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListEndpointGroupsSuccessfully(t)
+
+	allPages, err := endpointgroups.List(client.ServiceClient(), nil).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := endpointgroups.ExtractEndpointGroups(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedEndpointGroupsSlice, actual)
+}
+
+func TestListEndpointGroupsOpts(t *testing.T) {
+	opts := endpointgroups.ListOpts{
+		Name: "compute",
+	}
+
+	query, err := opts.ToEndpointGroupListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "?name=compute", query)
+}
+
+func TestGetEndpointGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetEndpointGroupSuccessfully(t)
+
+	actual, err := endpointgroups.Get(context.TODO(), client.ServiceClient(), "ac4861").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstEndpointGroup, *actual)
+}
+
+func TestCreateEndpointGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateEndpointGroupSuccessfully(t)
+
+	createOpts := endpointgroups.CreateOpts{
+		Name:        "public-region-one",
+		Description: "Public endpoints of RegionOne",
+		Filters: endpointgroups.Filters{
+			Availability: gophercloud.AvailabilityPublic,
+			RegionID:     "RegionOne",
+		},
+	}
+
+	actual, err := endpointgroups.Create(context.TODO(), client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstEndpointGroup, *actual)
+}
+
+func TestCreateEndpointGroupMissingName(t *testing.T) {
+	createOpts := endpointgroups.CreateOpts{
+		Filters: endpointgroups.Filters{
+			ServiceID: "1b501a",
+		},
+	}
+
+	_, err := createOpts.ToEndpointGroupCreateMap()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("expected ErrMissingInput, got %v", err)
+	}
+}
+
+func TestUpdateEndpointGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateEndpointGroupSuccessfully(t)
+
+	description := ""
+	updateOpts := endpointgroups.UpdateOpts{
+		Description: &description,
+		Filters: &endpointgroups.Filters{
+			Availability: gophercloud.AvailabilityInternal,
+		},
+	}
+
+	actual, err := endpointgroups.Update(context.TODO(), client.ServiceClient(), "ac4861", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstEndpointGroupUpdated, *actual)
+}
+
+func TestDeleteEndpointGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteEndpointGroupSuccessfully(t)
+
+	res := endpointgroups.Delete(context.TODO(), client.ServiceClient(), "ac4861")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestProjectAssociation(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleProjectAssociationSuccessfully(t)
+
+	err := endpointgroups.AssociateProject(context.TODO(), client.ServiceClient(), "ac4861", "e629d6").ExtractErr()
+	th.AssertNoErr(t, err)
+
+	associated, err := endpointgroups.CheckProject(context.TODO(), client.ServiceClient(), "ac4861", "e629d6").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, associated)
+
+	associated, err = endpointgroups.CheckProject(context.TODO(), client.ServiceClient(), "ac4861", "missing").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, false, associated)
+
+	err = endpointgroups.DisassociateProject(context.TODO(), client.ServiceClient(), "ac4861", "e629d6").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestListProjects(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListProjectsSuccessfully(t)
+
+	allPages, err := endpointgroups.ListProjects(client.ServiceClient(), "ac4861").AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := projects.ExtractProjects(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(actual))
+	th.AssertEquals(t, "e629d6", actual[0].ID)
+	th.AssertEquals(t, "demo", actual[0].Name)
+}
+
+func TestListEndpoints(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListEndpointsSuccessfully(t)
+
+	allPages, err := endpointgroups.ListEndpoints(client.ServiceClient(), "ac4861").AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := endpoints.ExtractEndpoints(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(actual))
+	th.AssertEquals(t, "6fedc0", actual[0].ID)
+	th.AssertEquals(t, gophercloud.AvailabilityPublic, actual[0].Availability)
+}
+
+func TestListForProject(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListForProjectSuccessfully(t)
+
+	allPages, err := endpointgroups.ListForProject(client.ServiceClient(), "e629d6").AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := endpointgroups.ExtractEndpointGroups(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedEndpointGroupsSlice, actual)
+}
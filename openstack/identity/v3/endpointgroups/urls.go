@@ -0,0 +1,46 @@
+package endpointgroups
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+const (
+	rootPath      = "OS-EP-FILTER"
+	resourcePath  = "endpoint_groups"
+	projectsPath  = "projects"
+	endpointsPath = "endpoints"
+)
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(rootPath, resourcePath)
+}
+
+func getURL(client *gophercloud.ServiceClient, endpointGroupID string) string {
+	return client.ServiceURL(rootPath, resourcePath, endpointGroupID)
+}
+
+func createURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(rootPath, resourcePath)
+}
+
+func updateURL(client *gophercloud.ServiceClient, endpointGroupID string) string {
+	return client.ServiceURL(rootPath, resourcePath, endpointGroupID)
+}
+
+func deleteURL(client *gophercloud.ServiceClient, endpointGroupID string) string {
+	return client.ServiceURL(rootPath, resourcePath, endpointGroupID)
+}
+
+func projectURL(client *gophercloud.ServiceClient, endpointGroupID, projectID string) string {
+	return client.ServiceURL(rootPath, resourcePath, endpointGroupID, projectsPath, projectID)
+}
+
+func listProjectsURL(client *gophercloud.ServiceClient, endpointGroupID string) string {
+	return client.ServiceURL(rootPath, resourcePath, endpointGroupID, projectsPath)
+}
+
+func listEndpointsURL(client *gophercloud.ServiceClient, endpointGroupID string) string {
+	return client.ServiceURL(rootPath, resourcePath, endpointGroupID, endpointsPath)
+}
+
+func listForProjectURL(client *gophercloud.ServiceClient, projectID string) string {
+	return client.ServiceURL(rootPath, projectsPath, projectID, resourcePath)
+}
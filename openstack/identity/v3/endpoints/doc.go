@@ -64,5 +64,43 @@ Example to Delete an Endpoint
 	if err != nil {
 		panic(err)
 	}
+
+Example to Disable an Endpoint
+
+	enabled := false
+	updateOpts := endpoints.UpdateOpts{
+		Enabled: &enabled,
+	}
+
+	endpoint, err := endpoints.Update(context.TODO(), identityClient, endpointID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the Endpoints of a Service Type
+
+	listOpts := endpoints.ListOpts{
+		Availability: gophercloud.AvailabilityPublic,
+		RegionID:     "RegionOne",
+	}
+
+	computeEndpoints, err := endpoints.ListByServiceType(context.TODO(), identityClient, "compute", listOpts)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Replace the URL of an Endpoint
+
+	replaceOpts := endpoints.ReplaceOpts{
+		ServiceID:    "e629d6e599d9489fb3ae5d9cc12eaea3",
+		Availability: gophercloud.AvailabilityPublic,
+		RegionID:     "RegionOne",
+		URL:          "https://compute.example.com/v2.1",
+	}
+
+	endpoint, err := endpoints.ReplaceEndpoint(context.TODO(), identityClient, replaceOpts)
+	if err != nil {
+		panic(err)
+	}
 */
 package endpoints
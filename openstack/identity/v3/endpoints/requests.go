@@ -30,6 +30,10 @@ type CreateOpts struct {
 
 	// ServiceID is the ID of the service the Endpoint refers to.
 	ServiceID string `json:"service_id" required:"true"`
+
+	// Enabled is whether or not the Endpoint is enabled. Endpoints are
+	// enabled by default.
+	Enabled *bool `json:"enabled,omitempty"`
 }
 
 // ToEndpointCreateMap builds a request body from the Endpoint Create options.
@@ -79,11 +83,11 @@ func (opts ListOpts) ToEndpointListParams() (string, error) {
 func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
 	u := listURL(client)
 	if opts != nil {
-		q, err := gophercloud.BuildQueryString(opts)
+		q, err := opts.ToEndpointListParams()
 		if err != nil {
 			return pagination.Pager{Err: err}
 		}
-		u += q.String()
+		u += q
 	}
 	return pagination.NewPager(client, u, func(r pagination.PageResult) pagination.Page {
 		return EndpointPage{pagination.LinkedPageBase{PageResult: r}}
@@ -114,6 +118,9 @@ type UpdateOpts struct {
 
 	// ServiceID is the ID of the service the Endpoint refers to.
 	ServiceID string `json:"service_id,omitempty"`
+
+	// Enabled is whether or not the Endpoint is enabled.
+	Enabled *bool `json:"enabled,omitempty"`
 }
 
 // ToEndpointUpdateMap builds an update request body from the Update options.
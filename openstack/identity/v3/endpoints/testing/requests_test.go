@@ -222,3 +222,201 @@ func TestDeleteEndpoint(t *testing.T) {
 	res := endpoints.Delete(context.TODO(), client.ServiceClient(), "34")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestDisableEndpoint(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/endpoints/12", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"endpoint": {"enabled": false}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `
+		{
+			"endpoint": {
+				"id": "12",
+				"interface": "public",
+				"enabled": false,
+				"name": "the-endiest-of-points",
+				"region": "underground",
+				"service_id": "asdfasdfasdfasdf",
+				"url": "https://1.2.3.4:9000/"
+			}
+		}
+	`)
+	})
+
+	enabled := false
+	actual, err := endpoints.Update(context.TODO(), client.ServiceClient(), "12", endpoints.UpdateOpts{
+		Enabled: &enabled,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, false, actual.Enabled)
+}
+
+func TestListByServiceType(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/services", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"type": "compute"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `
+		{
+			"services": [
+				{"id": "asdfasdfasdfasdf", "type": "compute", "enabled": true}
+			],
+			"links": {"next": null, "previous": null}
+		}
+	`)
+	})
+
+	th.Mux.HandleFunc("/endpoints", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{
+			"service_id": "asdfasdfasdfasdf",
+			"interface":  "public",
+			"region_id":  "underground",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `
+		{
+			"endpoints": [
+				{
+					"id": "12",
+					"interface": "public",
+					"enabled": true,
+					"name": "the-endiest-of-points",
+					"region": "underground",
+					"service_id": "asdfasdfasdfasdf",
+					"url": "https://1.2.3.4:9000/"
+				}
+			],
+			"links": {"next": null, "previous": null}
+		}
+	`)
+	})
+
+	actual, err := endpoints.ListByServiceType(context.TODO(), client.ServiceClient(), "compute", endpoints.ListOpts{
+		Availability: gophercloud.AvailabilityPublic,
+		RegionID:     "underground",
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(actual))
+	th.AssertEquals(t, "12", actual[0].ID)
+}
+
+func TestReplaceEndpoint(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/endpoints", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{
+			"service_id": "asdfasdfasdfasdf",
+			"interface":  "public",
+			"region_id":  "underground",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `
+		{
+			"endpoints": [
+				{
+					"id": "12",
+					"interface": "public",
+					"enabled": true,
+					"name": "the-endiest-of-points",
+					"region": "underground",
+					"service_id": "asdfasdfasdfasdf",
+					"url": "https://1.2.3.4:9000/"
+				}
+			],
+			"links": {"next": null, "previous": null}
+		}
+	`)
+	})
+
+	th.Mux.HandleFunc("/endpoints/12", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestJSONRequest(t, r, `{"endpoint": {"url": "https://5.6.7.8:9000/"}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `
+		{
+			"endpoint": {
+				"id": "12",
+				"interface": "public",
+				"enabled": true,
+				"name": "the-endiest-of-points",
+				"region": "underground",
+				"service_id": "asdfasdfasdfasdf",
+				"url": "https://5.6.7.8:9000/"
+			}
+		}
+	`)
+	})
+
+	actual, err := endpoints.ReplaceEndpoint(context.TODO(), client.ServiceClient(), endpoints.ReplaceOpts{
+		ServiceID:    "asdfasdfasdfasdf",
+		Availability: gophercloud.AvailabilityPublic,
+		RegionID:     "underground",
+		URL:          "https://5.6.7.8:9000/",
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "https://5.6.7.8:9000/", actual.URL)
+}
+
+func TestReplaceEndpointAmbiguous(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/endpoints", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `
+		{
+			"endpoints": [
+				{"id": "12", "interface": "public", "region": "underground", "service_id": "asdfasdfasdfasdf", "url": "https://1.2.3.4:9000/"},
+				{"id": "14", "interface": "public", "region": "overground", "service_id": "asdfasdfasdfasdf", "url": "https://1.2.3.5:9000/"}
+			],
+			"links": {"next": null, "previous": null}
+		}
+	`)
+	})
+
+	_, err := endpoints.ReplaceEndpoint(context.TODO(), client.ServiceClient(), endpoints.ReplaceOpts{
+		ServiceID:    "asdfasdfasdfasdf",
+		Availability: gophercloud.AvailabilityPublic,
+		URL:          "https://5.6.7.8:9000/",
+	})
+	if _, ok := err.(gophercloud.ErrMultipleResourcesFound); !ok {
+		t.Fatalf("ErrMultipleResourcesFound was expected to occur, got %v", err)
+	}
+}
+
+func TestReplaceEndpointValidation(t *testing.T) {
+	for _, opts := range []endpoints.ReplaceOpts{
+		{ServiceID: "asdfasdfasdfasdf", Availability: "private", URL: "https://5.6.7.8:9000/"},
+		{ServiceID: "asdfasdfasdfasdf", Availability: gophercloud.AvailabilityPublic, URL: "5.6.7.8:9000"},
+	} {
+		_, err := endpoints.ReplaceEndpoint(context.TODO(), client.ServiceClient(), opts)
+		if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+			t.Fatalf("ErrInvalidInput was expected to occur for %+v, got %v", opts, err)
+		}
+	}
+
+	_, err := endpoints.ReplaceEndpoint(context.TODO(), client.ServiceClient(), endpoints.ReplaceOpts{
+		Availability: gophercloud.AvailabilityPublic,
+		URL:          "https://5.6.7.8:9000/",
+	})
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("ErrMissingInput was expected to occur, got %v", err)
+	}
+}
@@ -0,0 +1,138 @@
+package endpoints
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/services"
+)
+
+// ListByServiceType returns the endpoints of every service of the given
+// type, such as "compute", optionally filtered by opts. The ServiceID of
+// opts is ignored.
+func ListByServiceType(ctx context.Context, client *gophercloud.ServiceClient, serviceType string, opts ListOpts) ([]Endpoint, error) {
+	if serviceType == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "serviceType"}
+	}
+
+	allPages, err := services.List(client, services.ListOpts{ServiceType: serviceType}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allServices, err := services.ExtractServices(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var endpoints []Endpoint
+	for _, service := range allServices {
+		opts.ServiceID = service.ID
+		allPages, err := List(client, opts).AllPages(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		serviceEndpoints, err := ExtractEndpoints(allPages)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, serviceEndpoints...)
+	}
+
+	return endpoints, nil
+}
+
+// ReplaceOpts identifies an endpoint by its service, interface and region,
+// and provides its new URL.
+type ReplaceOpts struct {
+	// ServiceID is the ID of the service the Endpoint refers to.
+	ServiceID string
+
+	// Availability is the interface type of the Endpoint (admin, internal,
+	// or public).
+	Availability gophercloud.Availability
+
+	// RegionID is the ID of the region the Endpoint is located in. It can
+	// be left blank if the service has a single endpoint for the interface.
+	RegionID string
+
+	// URL is the new url of the Endpoint. It must be an absolute URL.
+	URL string
+}
+
+// validate checks the interface triple and the URL of a ReplaceOpts.
+func (opts ReplaceOpts) validate() error {
+	if opts.ServiceID == "" {
+		return gophercloud.ErrMissingInput{Argument: "endpoints.ReplaceOpts.ServiceID"}
+	}
+
+	switch opts.Availability {
+	case gophercloud.AvailabilityAdmin, gophercloud.AvailabilityInternal, gophercloud.AvailabilityPublic:
+	case "":
+		return gophercloud.ErrMissingInput{Argument: "endpoints.ReplaceOpts.Availability"}
+	default:
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "endpoints.ReplaceOpts.Availability"
+		err.Value = opts.Availability
+		err.Info = fmt.Sprintf("must be one of %q, %q or %q", gophercloud.AvailabilityAdmin, gophercloud.AvailabilityInternal, gophercloud.AvailabilityPublic)
+		return err
+	}
+
+	if opts.URL == "" {
+		return gophercloud.ErrMissingInput{Argument: "endpoints.ReplaceOpts.URL"}
+	}
+	u, err := url.Parse(opts.URL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "endpoints.ReplaceOpts.URL"
+		err.Value = opts.URL
+		err.Info = "must be an absolute URL"
+		return err
+	}
+
+	return nil
+}
+
+// ReplaceEndpoint changes the URL of the single endpoint matching the
+// service, interface and region of opts. The URL is changed in place with a
+// single update request, so the endpoint never disappears from the catalog.
+// An ErrResourceNotFound or ErrMultipleResourcesFound error is returned if
+// the triple does not match exactly one endpoint.
+func ReplaceEndpoint(ctx context.Context, client *gophercloud.ServiceClient, opts ReplaceOpts) (*Endpoint, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	allPages, err := List(client, ListOpts{
+		Availability: opts.Availability,
+		ServiceID:    opts.ServiceID,
+		RegionID:     opts.RegionID,
+	}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allEndpoints, err := ExtractEndpoints(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	name := fmt.Sprintf("%s/%s/%s", opts.ServiceID, opts.Availability, opts.RegionID)
+	switch count := len(allEndpoints); count {
+	case 0:
+		return nil, gophercloud.ErrResourceNotFound{Name: name, ResourceType: "endpoint"}
+	case 1:
+	default:
+		return nil, gophercloud.ErrMultipleResourcesFound{Name: name, Count: count, ResourceType: "endpoint"}
+	}
+
+	endpoint := allEndpoints[0]
+	if endpoint.URL == opts.URL {
+		return &endpoint, nil
+	}
+
+	return Update(ctx, client, endpoint.ID, UpdateOpts{URL: opts.URL}).Extract()
+}
@@ -101,5 +101,81 @@ Example to Delete a Mapping
 	if err != nil {
 		panic(err)
 	}
+
+Example to List Identity Providers
+
+	allPages, err := federation.ListIdentityProviders(identityClient, nil).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allIdentityProviders, err := federation.ExtractIdentityProviders(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create an Identity Provider
+
+	enabled := true
+	createOpts := federation.CreateIdentityProviderOpts{
+		Description: "Stores ACME identities",
+		Enabled:     &enabled,
+		RemoteIDs:   []string{"https://idp.acme.example/saml2"},
+	}
+	identityProvider, err := federation.CreateIdentityProvider(context.TODO(), identityClient, "ACME", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Identity Provider
+
+	err := federation.DeleteIdentityProvider(context.TODO(), identityClient, "ACME").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Protocols of an Identity Provider
+
+	allPages, err := federation.ListProtocols(identityClient, "ACME").AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allProtocols, err := federation.ExtractProtocols(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Protocol
+
+	createOpts := federation.CreateProtocolOpts{
+		MappingID: "ACME",
+	}
+	protocol, err := federation.CreateProtocol(context.TODO(), identityClient, "ACME", "saml2", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Authenticate with an OpenID Connect Access Token
+
+	authOptions := &federation.AuthOptions{
+		IdentityProviderID: "ACME",
+		ProtocolID:         "openid",
+		AccessToken:        accessToken,
+		Scope: tokens.Scope{
+			ProjectID: "263fd9",
+		},
+		AllowReauth: true,
+	}
+
+	providerClient, err := openstack.NewClient("https://keystone.example.com/v3")
+	if err != nil {
+		panic(err)
+	}
+
+	err = openstack.AuthenticateV3(context.TODO(), providerClient, authOptions, gophercloud.EndpointOpts{})
+	if err != nil {
+		panic(err)
+	}
 */
 package federation
@@ -2,8 +2,10 @@ package federation
 
 import (
 	"context"
+	"strings"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/tokens"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
 
@@ -89,3 +91,359 @@ func DeleteMapping(ctx context.Context, client *gophercloud.ServiceClient, mappi
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// ListIdentityProvidersOptsBuilder allows extensions to add additional
+// parameters to the ListIdentityProviders request.
+type ListIdentityProvidersOptsBuilder interface {
+	ToIdentityProviderListQuery() (string, error)
+}
+
+// ListIdentityProvidersOpts provides options to filter the
+// ListIdentityProviders results.
+type ListIdentityProvidersOpts struct {
+	// ID filters the response by identity provider ID.
+	ID string `q:"id"`
+
+	// Enabled filters the response by enabled identity providers.
+	Enabled *bool `q:"enabled"`
+}
+
+// ToIdentityProviderListQuery formats a ListIdentityProvidersOpts into a
+// query string.
+func (opts ListIdentityProvidersOpts) ToIdentityProviderListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// ListIdentityProviders enumerates the identity providers.
+func ListIdentityProviders(client *gophercloud.ServiceClient, opts ListIdentityProvidersOptsBuilder) pagination.Pager {
+	url := identityProvidersRootURL(client)
+	if opts != nil {
+		query, err := opts.ToIdentityProviderListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return IdentityProvidersPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateIdentityProviderOptsBuilder allows extensions to add additional
+// parameters to the CreateIdentityProvider request.
+type CreateIdentityProviderOptsBuilder interface {
+	ToIdentityProviderCreateMap() (map[string]any, error)
+}
+
+// CreateIdentityProviderOpts provides options for creating an identity
+// provider.
+type CreateIdentityProviderOpts struct {
+	// DomainID is the ID of the domain federated users are created in.
+	DomainID string `json:"domain_id,omitempty"`
+
+	// Description is the description of the identity provider.
+	Description string `json:"description,omitempty"`
+
+	// Enabled sets the identity provider status to enabled or disabled.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// RemoteIDs are the valid remote IDs of the identity provider.
+	RemoteIDs []string `json:"remote_ids,omitempty"`
+
+	// AuthorizationTTL is the number of minutes group memberships obtained
+	// through the identity provider remain valid.
+	AuthorizationTTL *int `json:"authorization_ttl,omitempty"`
+}
+
+// ToIdentityProviderCreateMap formats a CreateIdentityProviderOpts into a
+// create request.
+func (opts CreateIdentityProviderOpts) ToIdentityProviderCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "identity_provider")
+}
+
+// CreateIdentityProvider registers a new identity provider with the given ID.
+func CreateIdentityProvider(ctx context.Context, client *gophercloud.ServiceClient, idpID string, opts CreateIdentityProviderOptsBuilder) (r CreateIdentityProviderResult) {
+	b, err := opts.ToIdentityProviderCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(ctx, identityProvidersResourceURL(client, idpID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetIdentityProvider retrieves details on a single identity provider, by ID.
+func GetIdentityProvider(ctx context.Context, client *gophercloud.ServiceClient, idpID string) (r GetIdentityProviderResult) {
+	resp, err := client.Get(ctx, identityProvidersResourceURL(client, idpID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateIdentityProviderOptsBuilder allows extensions to add additional
+// parameters to the UpdateIdentityProvider request.
+type UpdateIdentityProviderOptsBuilder interface {
+	ToIdentityProviderUpdateMap() (map[string]any, error)
+}
+
+// UpdateIdentityProviderOpts provides options for updating an identity
+// provider.
+type UpdateIdentityProviderOpts struct {
+	// Description is the description of the identity provider.
+	Description *string `json:"description,omitempty"`
+
+	// Enabled sets the identity provider status to enabled or disabled.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// RemoteIDs are the valid remote IDs of the identity provider.
+	RemoteIDs *[]string `json:"remote_ids,omitempty"`
+
+	// AuthorizationTTL is the number of minutes group memberships obtained
+	// through the identity provider remain valid.
+	AuthorizationTTL *int `json:"authorization_ttl,omitempty"`
+}
+
+// ToIdentityProviderUpdateMap formats a UpdateIdentityProviderOpts into an
+// update request.
+func (opts UpdateIdentityProviderOpts) ToIdentityProviderUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "identity_provider")
+}
+
+// UpdateIdentityProvider updates an existing identity provider.
+func UpdateIdentityProvider(ctx context.Context, client *gophercloud.ServiceClient, idpID string, opts UpdateIdentityProviderOptsBuilder) (r UpdateIdentityProviderResult) {
+	b, err := opts.ToIdentityProviderUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(ctx, identityProvidersResourceURL(client, idpID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteIdentityProvider deletes an identity provider, along with its
+// protocols.
+func DeleteIdentityProvider(ctx context.Context, client *gophercloud.ServiceClient, idpID string) (r DeleteIdentityProviderResult) {
+	resp, err := client.Delete(ctx, identityProvidersResourceURL(client, idpID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListProtocols enumerates the protocols of an identity provider.
+func ListProtocols(client *gophercloud.ServiceClient, idpID string) pagination.Pager {
+	return pagination.NewPager(client, protocolsRootURL(client, idpID), func(r pagination.PageResult) pagination.Page {
+		return ProtocolsPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateProtocolOptsBuilder allows extensions to add additional parameters to
+// the CreateProtocol request.
+type CreateProtocolOptsBuilder interface {
+	ToProtocolCreateMap() (map[string]any, error)
+}
+
+// CreateProtocolOpts provides options for creating a protocol.
+type CreateProtocolOpts struct {
+	// MappingID is the ID of the mapping applied to users authenticating
+	// through the protocol.
+	MappingID string `json:"mapping_id" required:"true"`
+
+	// RemoteIDAttribute is the attribute holding the remote ID of the
+	// identity provider.
+	RemoteIDAttribute string `json:"remote_id_attribute,omitempty"`
+}
+
+// ToProtocolCreateMap formats a CreateProtocolOpts into a create request.
+func (opts CreateProtocolOpts) ToProtocolCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "protocol")
+}
+
+// CreateProtocol adds a protocol with the given ID to an identity provider.
+func CreateProtocol(ctx context.Context, client *gophercloud.ServiceClient, idpID, protocolID string, opts CreateProtocolOptsBuilder) (r CreateProtocolResult) {
+	b, err := opts.ToProtocolCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(ctx, protocolsResourceURL(client, idpID, protocolID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetProtocol retrieves details on a single protocol of an identity provider.
+func GetProtocol(ctx context.Context, client *gophercloud.ServiceClient, idpID, protocolID string) (r GetProtocolResult) {
+	resp, err := client.Get(ctx, protocolsResourceURL(client, idpID, protocolID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateProtocolOptsBuilder allows extensions to add additional parameters to
+// the UpdateProtocol request.
+type UpdateProtocolOptsBuilder interface {
+	ToProtocolUpdateMap() (map[string]any, error)
+}
+
+// UpdateProtocolOpts provides options for updating a protocol.
+type UpdateProtocolOpts struct {
+	// MappingID is the ID of the mapping applied to users authenticating
+	// through the protocol.
+	MappingID string `json:"mapping_id,omitempty"`
+
+	// RemoteIDAttribute is the attribute holding the remote ID of the
+	// identity provider.
+	RemoteIDAttribute *string `json:"remote_id_attribute,omitempty"`
+}
+
+// ToProtocolUpdateMap formats a UpdateProtocolOpts into an update request.
+func (opts UpdateProtocolOpts) ToProtocolUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "protocol")
+}
+
+// UpdateProtocol updates an existing protocol of an identity provider.
+func UpdateProtocol(ctx context.Context, client *gophercloud.ServiceClient, idpID, protocolID string, opts UpdateProtocolOptsBuilder) (r UpdateProtocolResult) {
+	b, err := opts.ToProtocolUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(ctx, protocolsResourceURL(client, idpID, protocolID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteProtocol deletes a protocol of an identity provider.
+func DeleteProtocol(ctx context.Context, client *gophercloud.ServiceClient, idpID, protocolID string) (r DeleteProtocolResult) {
+	resp, err := client.Delete(ctx, protocolsResourceURL(client, idpID, protocolID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// AuthOptions represents options for authenticating a user through an
+// identity provider, using a credential obtained from it out of band.
+type AuthOptions struct {
+	// IdentityProviderID is the ID of the identity provider which issued the
+	// credential.
+	IdentityProviderID string
+
+	// ProtocolID is the ID of the federation protocol, such as "openid" or
+	// "saml2".
+	ProtocolID string
+
+	// AccessToken is an OpenID Connect access token. It is sent as a bearer
+	// token.
+	AccessToken string
+
+	// SAMLAssertion is a SAML2 ECP assertion. It is sent as the request body.
+	SAMLAssertion string
+
+	// Scope, when set, re-scopes the unscoped token returned by the identity
+	// provider.
+	Scope tokens.Scope
+
+	// AllowReauth allows Gophercloud to re-authenticate automatically if the
+	// token expires. The credential must still be valid at that time.
+	AllowReauth bool
+}
+
+// ToTokenV3CreateMap allows AuthOptions to satisfy the tokens.AuthOptionsBuilder
+// interface. Federated authentication requests have no JSON body.
+func (opts *AuthOptions) ToTokenV3CreateMap(map[string]any) (map[string]any, error) {
+	if opts.IdentityProviderID == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "IdentityProviderID"}
+	}
+	if opts.ProtocolID == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "ProtocolID"}
+	}
+	if opts.AccessToken == "" && opts.SAMLAssertion == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "AccessToken or SAMLAssertion"}
+	}
+	if opts.AccessToken != "" && opts.SAMLAssertion != "" {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "AccessToken and SAMLAssertion"
+		err.Info = "only one of AccessToken or SAMLAssertion can be provided"
+		return nil, err
+	}
+	return nil, nil
+}
+
+// ToTokenV3HeadersMap allows AuthOptions to satisfy the
+// tokens.AuthOptionsBuilder interface.
+func (opts *AuthOptions) ToTokenV3HeadersMap(map[string]any) (map[string]string, error) {
+	if opts.AccessToken != "" {
+		return map[string]string{"Authorization": "Bearer " + opts.AccessToken}, nil
+	}
+	return map[string]string{"Content-Type": "application/vnd.paos+xml"}, nil
+}
+
+// ToTokenV3ScopeMap allows AuthOptions to satisfy the tokens.AuthOptionsBuilder
+// interface.
+func (opts *AuthOptions) ToTokenV3ScopeMap() (map[string]any, error) {
+	if opts.Scope == (tokens.Scope{}) {
+		return nil, nil
+	}
+	o := tokens.AuthOptions{Scope: opts.Scope}
+	return o.ToTokenV3ScopeMap()
+}
+
+// CanReauth allows AuthOptions to satisfy the tokens.AuthOptionsBuilder
+// interface.
+func (opts *AuthOptions) CanReauth() bool {
+	return opts.AllowReauth
+}
+
+// Create exchanges the credential of an identity provider for an unscoped
+// token. When a Scope is given, the unscoped token is then exchanged for a
+// scoped one.
+func Create(ctx context.Context, client *gophercloud.ServiceClient, opts *AuthOptions) (r tokens.CreateResult) {
+	if _, err := opts.ToTokenV3CreateMap(nil); err != nil {
+		r.Err = err
+		return
+	}
+
+	scope, err := opts.ToTokenV3ScopeMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	h, err := opts.ToTokenV3HeadersMap(nil)
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	reqOpts := &gophercloud.RequestOpts{
+		MoreHeaders: h,
+		OmitHeaders: []string{"X-Auth-Token"},
+		OkCodes:     []int{201},
+	}
+	if opts.SAMLAssertion != "" {
+		reqOpts.RawBody = strings.NewReader(opts.SAMLAssertion)
+	}
+
+	resp, err := client.Post(ctx, authURL(client, opts.IdentityProviderID, opts.ProtocolID), nil, &r.Body, reqOpts)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	if r.Err != nil || scope == nil {
+		return
+	}
+
+	tokenID, err := r.ExtractTokenID()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	return tokens.Create(ctx, client, &tokens.AuthOptions{
+		TokenID: tokenID,
+		Scope:   opts.Scope,
+	})
+}
@@ -207,3 +207,201 @@ func ExtractMappings(r pagination.Page) ([]Mapping, error) {
 	err := (r.(MappingsPage)).ExtractInto(&s)
 	return s.Mappings, err
 }
+
+// IdentityProvider is a trusted source of federated identities.
+type IdentityProvider struct {
+	// ID is the unique ID of the identity provider.
+	ID string `json:"id"`
+
+	// DomainID is the ID of the domain federated users are created in.
+	DomainID string `json:"domain_id"`
+
+	// Description is the description of the identity provider.
+	Description string `json:"description"`
+
+	// Enabled is whether or not the identity provider is enabled.
+	Enabled bool `json:"enabled"`
+
+	// RemoteIDs are the valid remote IDs of the identity provider.
+	RemoteIDs []string `json:"remote_ids"`
+
+	// AuthorizationTTL is the number of minutes group memberships obtained
+	// through the identity provider remain valid.
+	AuthorizationTTL *int `json:"authorization_ttl"`
+
+	// Links contains referencing links to the identity provider.
+	Links map[string]any `json:"links"`
+}
+
+type identityProviderResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any identityProviderResult as an IdentityProvider.
+func (c identityProviderResult) Extract() (*IdentityProvider, error) {
+	var s struct {
+		IdentityProvider *IdentityProvider `json:"identity_provider"`
+	}
+	err := c.ExtractInto(&s)
+	return s.IdentityProvider, err
+}
+
+// CreateIdentityProviderResult is the response from a CreateIdentityProvider
+// operation. Call its Extract method to interpret it as an IdentityProvider.
+type CreateIdentityProviderResult struct {
+	identityProviderResult
+}
+
+// GetIdentityProviderResult is the response from a GetIdentityProvider
+// operation. Call its Extract method to interpret it as an IdentityProvider.
+type GetIdentityProviderResult struct {
+	identityProviderResult
+}
+
+// UpdateIdentityProviderResult is the response from a UpdateIdentityProvider
+// operation. Call its Extract method to interpret it as an IdentityProvider.
+type UpdateIdentityProviderResult struct {
+	identityProviderResult
+}
+
+// DeleteIdentityProviderResult is the response from a DeleteIdentityProvider
+// operation. Call its ExtractErr to determine if the request succeeded or
+// failed.
+type DeleteIdentityProviderResult struct {
+	gophercloud.ErrResult
+}
+
+// IdentityProvidersPage is a single page of IdentityProvider results.
+type IdentityProvidersPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a page of IdentityProviders contains any
+// results.
+func (c IdentityProvidersPage) IsEmpty() (bool, error) {
+	if c.StatusCode == 204 {
+		return true, nil
+	}
+
+	identityProviders, err := ExtractIdentityProviders(c)
+	return len(identityProviders) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (c IdentityProvidersPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+	err := c.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return s.Links.Next, err
+}
+
+// ExtractIdentityProviders returns a slice of IdentityProviders contained in
+// a single page of results.
+func ExtractIdentityProviders(r pagination.Page) ([]IdentityProvider, error) {
+	var s struct {
+		IdentityProviders []IdentityProvider `json:"identity_providers"`
+	}
+	err := (r.(IdentityProvidersPage)).ExtractInto(&s)
+	return s.IdentityProviders, err
+}
+
+// Protocol binds a federation protocol of an identity provider to a mapping.
+type Protocol struct {
+	// ID is the name of the protocol, such as "saml2" or "openid".
+	ID string `json:"id"`
+
+	// MappingID is the ID of the mapping applied to users authenticating
+	// through the protocol.
+	MappingID string `json:"mapping_id"`
+
+	// RemoteIDAttribute is the attribute holding the remote ID of the
+	// identity provider.
+	RemoteIDAttribute string `json:"remote_id_attribute"`
+
+	// Links contains referencing links to the protocol.
+	Links map[string]any `json:"links"`
+}
+
+type protocolResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any protocolResult as a Protocol.
+func (c protocolResult) Extract() (*Protocol, error) {
+	var s struct {
+		Protocol *Protocol `json:"protocol"`
+	}
+	err := c.ExtractInto(&s)
+	return s.Protocol, err
+}
+
+// CreateProtocolResult is the response from a CreateProtocol operation.
+// Call its Extract method to interpret it as a Protocol.
+type CreateProtocolResult struct {
+	protocolResult
+}
+
+// GetProtocolResult is the response from a GetProtocol operation.
+// Call its Extract method to interpret it as a Protocol.
+type GetProtocolResult struct {
+	protocolResult
+}
+
+// UpdateProtocolResult is the response from a UpdateProtocol operation.
+// Call its Extract method to interpret it as a Protocol.
+type UpdateProtocolResult struct {
+	protocolResult
+}
+
+// DeleteProtocolResult is the response from a DeleteProtocol operation.
+// Call its ExtractErr to determine if the request succeeded or failed.
+type DeleteProtocolResult struct {
+	gophercloud.ErrResult
+}
+
+// ProtocolsPage is a single page of Protocol results.
+type ProtocolsPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a page of Protocols contains any results.
+func (c ProtocolsPage) IsEmpty() (bool, error) {
+	if c.StatusCode == 204 {
+		return true, nil
+	}
+
+	protocols, err := ExtractProtocols(c)
+	return len(protocols) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (c ProtocolsPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+	err := c.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return s.Links.Next, err
+}
+
+// ExtractProtocols returns a slice of Protocols contained in a single page of
+// results.
+func ExtractProtocols(r pagination.Page) ([]Protocol, error) {
+	var s struct {
+		Protocols []Protocol `json:"protocols"`
+	}
+	err := (r.(ProtocolsPage)).ExtractInto(&s)
+	return s.Protocols, err
+}
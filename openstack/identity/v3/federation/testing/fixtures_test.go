@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/federation"
+	tokens "github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/tokens/testing"
 	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
 	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
 )
@@ -343,3 +344,404 @@ func HandleDeleteMappingSuccessfully(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 }
+
+const ListIdentityProvidersOutput = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers"
+    },
+    "identity_providers": [
+        {
+            "id": "ACME",
+            "domain_id": "1789d1",
+            "description": "Stores ACME identities",
+            "enabled": true,
+            "remote_ids": [
+                "https://idp.acme.example/saml2"
+            ],
+            "authorization_ttl": null,
+            "links": {
+                "protocols": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME/protocols",
+                "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME"
+            }
+        }
+    ]
+}
+`
+
+const CreateIdentityProviderRequest = `
+{
+    "identity_provider": {
+        "domain_id": "1789d1",
+        "description": "Stores ACME identities",
+        "enabled": true,
+        "remote_ids": [
+            "https://idp.acme.example/saml2"
+        ]
+    }
+}
+`
+
+const GetIdentityProviderOutput = `
+{
+    "identity_provider": {
+        "id": "ACME",
+        "domain_id": "1789d1",
+        "description": "Stores ACME identities",
+        "enabled": true,
+        "remote_ids": [
+            "https://idp.acme.example/saml2"
+        ],
+        "authorization_ttl": null,
+        "links": {
+            "protocols": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME/protocols",
+            "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME"
+        }
+    }
+}
+`
+
+const UpdateIdentityProviderRequest = `
+{
+    "identity_provider": {
+        "enabled": false,
+        "authorization_ttl": 60
+    }
+}
+`
+
+const UpdateIdentityProviderOutput = `
+{
+    "identity_provider": {
+        "id": "ACME",
+        "domain_id": "1789d1",
+        "description": "Stores ACME identities",
+        "enabled": false,
+        "remote_ids": [
+            "https://idp.acme.example/saml2"
+        ],
+        "authorization_ttl": 60,
+        "links": {
+            "protocols": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME/protocols",
+            "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME"
+        }
+    }
+}
+`
+
+const ListProtocolsOutput = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME/protocols"
+    },
+    "protocols": [
+        {
+            "id": "saml2",
+            "mapping_id": "ACME",
+            "remote_id_attribute": "",
+            "links": {
+                "identity_provider": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME",
+                "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME/protocols/saml2"
+            }
+        }
+    ]
+}
+`
+
+const CreateProtocolRequest = `
+{
+    "protocol": {
+        "mapping_id": "ACME"
+    }
+}
+`
+
+const GetProtocolOutput = `
+{
+    "protocol": {
+        "id": "saml2",
+        "mapping_id": "ACME",
+        "remote_id_attribute": "",
+        "links": {
+            "identity_provider": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME",
+            "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME/protocols/saml2"
+        }
+    }
+}
+`
+
+const UpdateProtocolRequest = `
+{
+    "protocol": {
+        "mapping_id": "ACME-v2",
+        "remote_id_attribute": "Shib-Identity-Provider"
+    }
+}
+`
+
+const UpdateProtocolOutput = `
+{
+    "protocol": {
+        "id": "saml2",
+        "mapping_id": "ACME-v2",
+        "remote_id_attribute": "Shib-Identity-Provider",
+        "links": {
+            "identity_provider": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME",
+            "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME/protocols/saml2"
+        }
+    }
+}
+`
+
+var IdentityProviderACME = federation.IdentityProvider{
+	ID:          "ACME",
+	DomainID:    "1789d1",
+	Description: "Stores ACME identities",
+	Enabled:     true,
+	RemoteIDs:   []string{"https://idp.acme.example/saml2"},
+	Links: map[string]any{
+		"protocols": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME/protocols",
+		"self":      "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME",
+	},
+}
+
+var authorizationTTL = 60
+
+var IdentityProviderACMEUpdated = federation.IdentityProvider{
+	ID:               "ACME",
+	DomainID:         "1789d1",
+	Description:      "Stores ACME identities",
+	Enabled:          false,
+	RemoteIDs:        []string{"https://idp.acme.example/saml2"},
+	AuthorizationTTL: &authorizationTTL,
+	Links: map[string]any{
+		"protocols": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME/protocols",
+		"self":      "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME",
+	},
+}
+
+// ExpectedIdentityProvidersSlice is the slice of identity providers expected
+// to be returned from ListIdentityProvidersOutput.
+var ExpectedIdentityProvidersSlice = []federation.IdentityProvider{IdentityProviderACME}
+
+var ProtocolSAML2 = federation.Protocol{
+	ID:        "saml2",
+	MappingID: "ACME",
+	Links: map[string]any{
+		"identity_provider": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME",
+		"self":              "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME/protocols/saml2",
+	},
+}
+
+var ProtocolSAML2Updated = federation.Protocol{
+	ID:                "saml2",
+	MappingID:         "ACME-v2",
+	RemoteIDAttribute: "Shib-Identity-Provider",
+	Links: map[string]any{
+		"identity_provider": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME",
+		"self":              "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME/protocols/saml2",
+	},
+}
+
+// ExpectedProtocolsSlice is the slice of protocols expected to be returned
+// from ListProtocolsOutput.
+var ExpectedProtocolsSlice = []federation.Protocol{ProtocolSAML2}
+
+// HandleListIdentityProvidersSuccessfully creates an HTTP handler at
+// `/identity_providers` on the test handler mux that responds with a list of
+// identity providers.
+func HandleListIdentityProvidersSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"enabled": "true"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListIdentityProvidersOutput)
+	})
+}
+
+// HandleCreateIdentityProviderSuccessfully creates an HTTP handler at
+// `/identity_providers` on the test handler mux that tests identity provider
+// creation.
+func HandleCreateIdentityProviderSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/ACME", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateIdentityProviderRequest)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetIdentityProviderOutput)
+	})
+}
+
+// HandleGetIdentityProviderSuccessfully creates an HTTP handler at
+// `/identity_providers` on the test handler mux that responds with a single
+// identity provider.
+func HandleGetIdentityProviderSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/ACME", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetIdentityProviderOutput)
+	})
+}
+
+// HandleUpdateIdentityProviderSuccessfully creates an HTTP handler at
+// `/identity_providers` on the test handler mux that tests identity provider
+// update.
+func HandleUpdateIdentityProviderSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/ACME", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateIdentityProviderRequest)
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateIdentityProviderOutput)
+	})
+}
+
+// HandleDeleteIdentityProviderSuccessfully creates an HTTP handler at
+// `/identity_providers` on the test handler mux that tests identity provider
+// deletion.
+func HandleDeleteIdentityProviderSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/ACME", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleListProtocolsSuccessfully creates an HTTP handler at `/protocols` on
+// the test handler mux that responds with a list of protocols.
+func HandleListProtocolsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/ACME/protocols", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListProtocolsOutput)
+	})
+}
+
+// HandleCreateProtocolSuccessfully creates an HTTP handler at `/protocols` on
+// the test handler mux that tests protocol creation.
+func HandleCreateProtocolSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/ACME/protocols/saml2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateProtocolRequest)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetProtocolOutput)
+	})
+}
+
+// HandleGetProtocolSuccessfully creates an HTTP handler at `/protocols` on
+// the test handler mux that responds with a single protocol.
+func HandleGetProtocolSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/ACME/protocols/saml2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetProtocolOutput)
+	})
+}
+
+// HandleUpdateProtocolSuccessfully creates an HTTP handler at `/protocols` on
+// the test handler mux that tests protocol update.
+func HandleUpdateProtocolSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/ACME/protocols/saml2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateProtocolRequest)
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateProtocolOutput)
+	})
+}
+
+// HandleDeleteProtocolSuccessfully creates an HTTP handler at `/protocols` on
+// the test handler mux that tests protocol deletion.
+func HandleDeleteProtocolSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/ACME/protocols/saml2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+const SAMLAssertion = `<S:Envelope xmlns:S="http://schemas.xmlsoap.org/soap/envelope/"><S:Body/></S:Envelope>`
+
+// HandleAuthenticateSuccessfully creates an HTTP handler at `/auth` on the
+// test handler mux that tests federated authentication with an OpenID
+// Connect access token.
+func HandleAuthenticateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/ACME/protocols/openid/auth", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "Authorization", "Bearer a1b2c3")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Subject-Token", "unscoped")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, tokens.TokenOutput)
+	})
+}
+
+// HandleAuthenticateScopedSuccessfully creates HTTP handlers on the test
+// handler mux that test federated authentication with a SAML2 assertion,
+// followed by re-scoping of the unscoped token.
+func HandleAuthenticateScopedSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/ACME/protocols/saml2/auth", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "Content-Type", "application/vnd.paos+xml")
+		th.TestBody(t, r, SAMLAssertion)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Subject-Token", "unscoped")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, tokens.TokenOutput)
+	})
+
+	th.Mux.HandleFunc("/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+			{
+				"auth": {
+					"identity": {
+						"methods": ["token"],
+						"token": {
+							"id": "unscoped"
+						}
+					},
+					"scope": {
+						"project": {
+							"id": "263fd9"
+						}
+					}
+				}
+			}
+		`)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Subject-Token", "scoped")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, tokens.TokenOutput)
+	})
+}
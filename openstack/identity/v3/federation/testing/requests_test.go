@@ -4,7 +4,9 @@ import (
 	"context"
 	"testing"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/federation"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/tokens"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
 	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
@@ -142,3 +144,193 @@ func TestDeleteMapping(t *testing.T) {
 	res := federation.DeleteMapping(context.TODO(), client.ServiceClient(), "ACME")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestListIdentityProviders(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListIdentityProvidersSuccessfully(t)
+
+	iTrue := true
+	listOpts := federation.ListIdentityProvidersOpts{
+		Enabled: &iTrue,
+	}
+	allPages, err := federation.ListIdentityProviders(client.ServiceClient(), listOpts).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := federation.ExtractIdentityProviders(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedIdentityProvidersSlice, actual)
+}
+
+func TestCreateIdentityProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateIdentityProviderSuccessfully(t)
+
+	iTrue := true
+	createOpts := federation.CreateIdentityProviderOpts{
+		DomainID:    "1789d1",
+		Description: "Stores ACME identities",
+		Enabled:     &iTrue,
+		RemoteIDs:   []string{"https://idp.acme.example/saml2"},
+	}
+
+	actual, err := federation.CreateIdentityProvider(context.TODO(), client.ServiceClient(), "ACME", createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, IdentityProviderACME, *actual)
+}
+
+func TestGetIdentityProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetIdentityProviderSuccessfully(t)
+
+	actual, err := federation.GetIdentityProvider(context.TODO(), client.ServiceClient(), "ACME").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, IdentityProviderACME, *actual)
+}
+
+func TestUpdateIdentityProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateIdentityProviderSuccessfully(t)
+
+	iFalse := false
+	ttl := 60
+	updateOpts := federation.UpdateIdentityProviderOpts{
+		Enabled:          &iFalse,
+		AuthorizationTTL: &ttl,
+	}
+
+	actual, err := federation.UpdateIdentityProvider(context.TODO(), client.ServiceClient(), "ACME", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, IdentityProviderACMEUpdated, *actual)
+}
+
+func TestDeleteIdentityProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteIdentityProviderSuccessfully(t)
+
+	res := federation.DeleteIdentityProvider(context.TODO(), client.ServiceClient(), "ACME")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestListProtocols(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListProtocolsSuccessfully(t)
+
+	allPages, err := federation.ListProtocols(client.ServiceClient(), "ACME").AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := federation.ExtractProtocols(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedProtocolsSlice, actual)
+}
+
+func TestCreateProtocol(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateProtocolSuccessfully(t)
+
+	createOpts := federation.CreateProtocolOpts{
+		MappingID: "ACME",
+	}
+
+	actual, err := federation.CreateProtocol(context.TODO(), client.ServiceClient(), "ACME", "saml2", createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ProtocolSAML2, *actual)
+}
+
+func TestGetProtocol(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetProtocolSuccessfully(t)
+
+	actual, err := federation.GetProtocol(context.TODO(), client.ServiceClient(), "ACME", "saml2").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ProtocolSAML2, *actual)
+}
+
+func TestUpdateProtocol(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateProtocolSuccessfully(t)
+
+	remoteIDAttribute := "Shib-Identity-Provider"
+	updateOpts := federation.UpdateProtocolOpts{
+		MappingID:         "ACME-v2",
+		RemoteIDAttribute: &remoteIDAttribute,
+	}
+
+	actual, err := federation.UpdateProtocol(context.TODO(), client.ServiceClient(), "ACME", "saml2", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ProtocolSAML2Updated, *actual)
+}
+
+func TestDeleteProtocol(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteProtocolSuccessfully(t)
+
+	res := federation.DeleteProtocol(context.TODO(), client.ServiceClient(), "ACME", "saml2")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestAuthenticate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAuthenticateSuccessfully(t)
+
+	options := &federation.AuthOptions{
+		IdentityProviderID: "ACME",
+		ProtocolID:         "openid",
+		AccessToken:        "a1b2c3",
+	}
+
+	res := federation.Create(context.TODO(), client.ServiceClient(), options)
+	th.AssertNoErr(t, res.Err)
+
+	tokenID, err := res.ExtractTokenID()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "unscoped", tokenID)
+}
+
+func TestAuthenticateScoped(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAuthenticateScopedSuccessfully(t)
+
+	options := &federation.AuthOptions{
+		IdentityProviderID: "ACME",
+		ProtocolID:         "saml2",
+		SAMLAssertion:      SAMLAssertion,
+		Scope: tokens.Scope{
+			ProjectID: "263fd9",
+		},
+	}
+
+	res := federation.Create(context.TODO(), client.ServiceClient(), options)
+	th.AssertNoErr(t, res.Err)
+
+	tokenID, err := res.ExtractTokenID()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "scoped", tokenID)
+}
+
+func TestAuthenticateInvalidOptions(t *testing.T) {
+	options := &federation.AuthOptions{
+		IdentityProviderID: "ACME",
+		ProtocolID:         "openid",
+	}
+	_, err := options.ToTokenV3CreateMap(nil)
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("Expected gophercloud.ErrMissingInput, got %T", err)
+	}
+
+	options.AccessToken = "a1b2c3"
+	options.SAMLAssertion = SAMLAssertion
+	_, err = options.ToTokenV3CreateMap(nil)
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("Expected gophercloud.ErrInvalidInput, got %T", err)
+	}
+}
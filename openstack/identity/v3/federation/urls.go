@@ -3,8 +3,10 @@ package federation
 import "github.com/vnpaycloud-console/gophercloud/v2"
 
 const (
-	rootPath     = "OS-FEDERATION"
-	mappingsPath = "mappings"
+	rootPath              = "OS-FEDERATION"
+	mappingsPath          = "mappings"
+	identityProvidersPath = "identity_providers"
+	protocolsPath         = "protocols"
 )
 
 func mappingsRootURL(c *gophercloud.ServiceClient) string {
@@ -14,3 +16,23 @@ func mappingsRootURL(c *gophercloud.ServiceClient) string {
 func mappingsResourceURL(c *gophercloud.ServiceClient, mappingID string) string {
 	return c.ServiceURL(rootPath, mappingsPath, mappingID)
 }
+
+func identityProvidersRootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, identityProvidersPath)
+}
+
+func identityProvidersResourceURL(c *gophercloud.ServiceClient, idpID string) string {
+	return c.ServiceURL(rootPath, identityProvidersPath, idpID)
+}
+
+func protocolsRootURL(c *gophercloud.ServiceClient, idpID string) string {
+	return c.ServiceURL(rootPath, identityProvidersPath, idpID, protocolsPath)
+}
+
+func protocolsResourceURL(c *gophercloud.ServiceClient, idpID, protocolID string) string {
+	return c.ServiceURL(rootPath, identityProvidersPath, idpID, protocolsPath, protocolID)
+}
+
+func authURL(c *gophercloud.ServiceClient, idpID, protocolID string) string {
+	return c.ServiceURL(rootPath, identityProvidersPath, idpID, protocolsPath, protocolID, "auth")
+}
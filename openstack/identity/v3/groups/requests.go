@@ -183,3 +183,22 @@ func Delete(ctx context.Context, client *gophercloud.ServiceClient, groupID stri
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// IDFromName is a convenience function that returns a group's ID given its
+// name. It returns a gophercloud.ErrResourceNotFound if no group matches the
+// name, and a gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	pages, err := List(client, ListOpts{Name: name}).AllPages(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	all, err := ExtractGroups(pages)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.IDFromName(all, name, "group", func(r Group) (string, string) {
+		return r.Name, r.ID
+	})
+}
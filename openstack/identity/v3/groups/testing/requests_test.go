@@ -2,6 +2,8 @@ package testing
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/groups"
@@ -133,3 +135,29 @@ func TestDeleteGroup(t *testing.T) {
 	res := groups.Delete(context.TODO(), client.ServiceClient(), "9fe1d3")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, `
+{
+	"groups": [
+		{"id": "2bc7a2a9-7c2a-4a8c-9f0e-5d4b7a2b1c01", "name": "group-1"},
+		{"id": "7f3c8e1d-51b4-4a8e-8a1e-3d9c0e6f4b02", "name": "group-2"},
+		{"id": "c4d1f0e2-9b7a-4f6c-8d3e-1a2b5c6d7e03", "name": "group-2"}
+	]
+}`)
+	})
+
+	id, err := groups.IDFromName(context.TODO(), client.ServiceClient(), "group-1")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2bc7a2a9-7c2a-4a8c-9f0e-5d4b7a2b1c01", id)
+}
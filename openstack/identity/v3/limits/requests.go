@@ -7,7 +7,7 @@ import (
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
 
-// Get retrieves details on a single limit, by ID.
+// GetEnforcementModel retrieves the limit enforcement model of the deployment.
 func GetEnforcementModel(ctx context.Context, client *gophercloud.ServiceClient) (r EnforcementModelResult) {
 	resp, err := client.Get(ctx, enforcementModelURL(client), &r.Body, nil)
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
@@ -14,6 +14,23 @@ Example to Create an OAuth1 Consumer
 	// NOTE: Consumer secret is available only on create response
 	fmt.Printf("Consumer: %+v\n", consumer)
 
+Example to Update an OAuth1 Consumer
+
+	updateConsumerOpts := oauth1.UpdateConsumerOpts{
+		Description: "My new consumer description",
+	}
+	consumer, err := oauth1.UpdateConsumer(context.TODO(), identityClient, consumer.ID, updateConsumerOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an OAuth1 Consumer
+
+	err := oauth1.DeleteConsumer(context.TODO(), identityClient, consumer.ID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Request an unauthorized OAuth1 token
 
 	requestTokenOpts := oauth1.RequestTokenOpts{
@@ -77,6 +94,13 @@ Example to List User's OAuth1 Access Tokens
 		fmt.Printf("Access Token: %+v\n", accessToken)
 	}
 
+Example to Revoke an OAuth1 Access Token
+
+	err := oauth1.RevokeAccessToken(context.TODO(), identityClient, userID, accessToken.OAuthToken).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Authenticate a client using OAuth1 method
 
 	client, err := openstack.NewClient("http://localhost:5000/v3")
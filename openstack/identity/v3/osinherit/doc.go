@@ -10,13 +10,38 @@ Example to Assign a Inherited Role to a User to a Domain
 
 	err := osinherit.Assign(context.TODO(), identityClient, roleID, osinherit.AssignOpts{
 		UserID:   userID,
-		domainID: domainID,
+		DomainID: domainID,
 	}).ExtractErr()
 
 	if err != nil {
 		panic(err)
 	}
 
+Example to List the Inherited Roles of a User on a Domain
+
+	domainID := "a99e9b4e620e4db09a2dfb6e42a01e66"
+	userID := "9df1a02f5eb2416a9781e8b0c022d3ae"
+
+	allPages, err := osinherit.List(identityClient, osinherit.ListOpts{
+		UserID:   userID,
+		DomainID: domainID,
+	}).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allRoles, err := roles.ExtractRoles(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, role := range allRoles {
+		fmt.Printf("%+v\n", role)
+	}
+
+Inherited role assignments can also be listed with roles.ListAssignments by
+setting ScopeInheritedTo to "projects".
+
 Example to Assign a Inherited Role to a User to a Project's subtree
 
 	projectID := "a99e9b4e620e4db09a2dfb6e42a01e66"
@@ -38,7 +63,7 @@ Example to validate a Inherited Role to a User to a Project's subtree
 	userID := "9df1a02f5eb2416a9781e8b0c022d3ae"
 	roleID := "9fe2ff9ee4384b1894a90878d3e92bab"
 
-	err := osinherit.Validate(context.TODO(), identityClient, roleID, osinherit.validateOpts{
+	err := osinherit.Validate(context.TODO(), identityClient, roleID, osinherit.ValidateOpts{
 		UserID:    userID,
 		ProjectID: projectID,
 	}).ExtractErr()
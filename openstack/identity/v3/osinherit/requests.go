@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/roles"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
 
 // AssignOpts provides options to assign an inherited role
@@ -63,6 +65,46 @@ type UnassignOpts struct {
 	DomainID string `xor:"ProjectID"`
 }
 
+// ListOpts provides options to list the inherited roles of a user/group
+type ListOpts struct {
+	// UserID is the ID of a user to list the inherited roles of
+	// Note: exactly one of UserID or GroupID must be provided
+	UserID string `xor:"GroupID"`
+
+	// GroupID is the ID of a group to list the inherited roles of
+	// Note: exactly one of UserID or GroupID must be provided
+	GroupID string `xor:"UserID"`
+
+	// DomainID is the ID of the domain the inherited roles are assigned on
+	DomainID string `required:"true"`
+}
+
+// List is the operation responsible for listing the roles a user/group
+// inherits on the projects of a domain. Use roles.ExtractRoles to interpret
+// the pages.
+func List(client *gophercloud.ServiceClient, opts ListOpts) pagination.Pager {
+	// Check xor and required conditions
+	_, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+
+	var actorID string
+	var actorType string
+	if opts.UserID != "" {
+		actorID = opts.UserID
+		actorType = "users"
+	} else {
+		actorID = opts.GroupID
+		actorType = "groups"
+	}
+
+	url := listURL(client, "domains", opts.DomainID, actorType, actorID)
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return roles.RolePage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
 // Assign is the operation responsible for assigning an inherited role
 // to a user/group on a project/domain.
 func Assign(ctx context.Context, client *gophercloud.ServiceClient, roleID string, opts AssignOpts) (r AssignmentResult) {
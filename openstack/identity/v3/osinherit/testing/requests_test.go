@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/osinherit"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/roles"
 	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
 	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
 )
@@ -134,3 +135,40 @@ func TestUnassign(t *testing.T) {
 	}).ExtractErr()
 	th.AssertErr(t, err)
 }
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	allPages, err := osinherit.List(client.ServiceClient(), osinherit.ListOpts{
+		UserID:   "{user_id}",
+		DomainID: "{domain_id}",
+	}).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := roles.ExtractRoles(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(actual))
+	th.AssertEquals(t, "9fe2ff9ee4384b1894a90878d3e92bab", actual[0].ID)
+	th.AssertEquals(t, "member", actual[0].Name)
+
+	allPages, err = osinherit.List(client.ServiceClient(), osinherit.ListOpts{
+		GroupID:  "{group_id}",
+		DomainID: "{domain_id}",
+	}).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err = roles.ExtractRoles(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(actual))
+
+	_, err = osinherit.List(client.ServiceClient(), osinherit.ListOpts{
+		GroupID: "{group_id}",
+		UserID:  "{user_id}",
+	}).AllPages(context.TODO())
+	th.AssertErr(t, err)
+
+	_, err = osinherit.List(client.ServiceClient(), osinherit.ListOpts{
+		UserID: "{user_id}",
+	}).AllPages(context.TODO())
+	th.AssertErr(t, err)
+}
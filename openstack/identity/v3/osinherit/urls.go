@@ -9,3 +9,7 @@ const (
 func assignURL(client *gophercloud.ServiceClient, targetType, targetID, actorType, actorID, roleID string) string {
 	return client.ServiceURL(inheritPath, targetType, targetID, actorType, actorID, "roles", roleID, "inherited_to_projects")
 }
+
+func listURL(client *gophercloud.ServiceClient, targetType, targetID, actorType, actorID string) string {
+	return client.ServiceURL(inheritPath, targetType, targetID, actorType, actorID, "roles", "inherited_to_projects")
+}
@@ -1,5 +1,5 @@
 /*
-Package endpoints provides information and interaction with the service
+Package projectendpoints provides information and interaction with the service
 OS-EP-FILTER/endpoints API resource in the OpenStack Identity service.
 
 For more information, see:
@@ -7,7 +7,7 @@ https://docs.openstack.org/api-ref/identity/v3-ext/#list-associations-by-project
 
 Example to List Project Endpoints
 
-	projectD := "e629d6e599d9489fb3ae5d9cc12eaea3"
+	projectID := "e629d6e599d9489fb3ae5d9cc12eaea3"
 
 	allPages, err := projectendpoints.List(identityClient, projectID).AllPages(context.TODO())
 	if err != nil {
@@ -22,5 +22,30 @@ Example to List Project Endpoints
 	for _, endpoint := range allEndpoints {
 		fmt.Printf("%+v\n", endpoint)
 	}
+
+Example to Associate an Endpoint with a Project
+
+	err := projectendpoints.Create(context.TODO(), identityClient, projectID, endpointID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Check an Endpoint Association
+
+	associated, err := projectendpoints.Check(context.TODO(), identityClient, projectID, endpointID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Endpoint is associated: %v\n", associated)
+
+Example to Remove an Endpoint Association
+
+	err := projectendpoints.Delete(context.TODO(), identityClient, projectID, endpointID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+To associate endpoints with projects in bulk, see the endpointgroups package.
 */
 package projectendpoints
@@ -27,6 +27,13 @@ func List(client *gophercloud.ServiceClient, projectID string) pagination.Pager
 	})
 }
 
+// Check checks whether an endpoint is associated with a project.
+func Check(ctx context.Context, client *gophercloud.ServiceClient, projectID, endpointID string) (r CheckResult) {
+	resp, err := client.Head(ctx, checkURL(client, projectID, endpointID), &gophercloud.RequestOpts{OkCodes: []int{204}})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // Delete removes an endpoint from the service catalog.
 func Delete(ctx context.Context, client *gophercloud.ServiceClient, projectID string, endpointID string) (r DeleteResult) {
 	resp, err := client.Delete(ctx, deleteURL(client, projectID, endpointID), &gophercloud.RequestOpts{OkCodes: []int{204}})
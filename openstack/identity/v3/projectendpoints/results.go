@@ -1,6 +1,8 @@
 package projectendpoints
 
 import (
+	"net/http"
+
 	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
@@ -17,6 +19,24 @@ type DeleteResult struct {
 	gophercloud.ErrResult
 }
 
+// CheckResult is the response from a Check operation. Call its Extract method
+// to determine if the endpoint is associated with the project.
+type CheckResult struct {
+	gophercloud.ErrResult
+}
+
+// Extract reports whether the endpoint is associated with the project. A 404
+// response is not treated as an error.
+func (r CheckResult) Extract() (bool, error) {
+	if r.Err == nil {
+		return true, nil
+	}
+	if gophercloud.ResponseCodeIs(r.Err, http.StatusNotFound) {
+		return false, nil
+	}
+	return false, r.Err
+}
+
 // Endpoint describes the entry point for another service's API.
 type Endpoint struct {
 	// ID is the unique ID of the endpoint.
@@ -116,3 +116,30 @@ func TestDeleteEndpoint(t *testing.T) {
 	res := projectendpoints.Delete(context.TODO(), client.ServiceClient(), "project-id", "endpoint-id")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestCheckSuccessful(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/OS-EP-FILTER/projects/project-id/endpoints/endpoint-id", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "HEAD")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	th.Mux.HandleFunc("/OS-EP-FILTER/projects/project-id/endpoints/missing", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "HEAD")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	associated, err := projectendpoints.Check(context.TODO(), client.ServiceClient(), "project-id", "endpoint-id").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, associated)
+
+	associated, err = projectendpoints.Check(context.TODO(), client.ServiceClient(), "project-id", "missing").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, false, associated)
+}
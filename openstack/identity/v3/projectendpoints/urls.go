@@ -10,6 +10,10 @@ func createURL(client *gophercloud.ServiceClient, projectID, endpointID string)
 	return client.ServiceURL("OS-EP-FILTER", "projects", projectID, "endpoints", endpointID)
 }
 
+func checkURL(client *gophercloud.ServiceClient, projectID, endpointID string) string {
+	return client.ServiceURL("OS-EP-FILTER", "projects", projectID, "endpoints", endpointID)
+}
+
 func deleteURL(client *gophercloud.ServiceClient, projectID, endpointID string) string {
 	return client.ServiceURL("OS-EP-FILTER", "projects", projectID, "endpoints", endpointID)
 }
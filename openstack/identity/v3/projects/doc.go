@@ -22,6 +22,18 @@ Example to List Projects
 		fmt.Printf("%+v\n", project)
 	}
 
+Example to List Projects available to the current token
+
+	allPages, err := projects.ListAvailable(identityClient).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allProjects, err := projects.ExtractProjects(allPages)
+	if err != nil {
+		panic(err)
+	}
+
 Example to Create a Project
 
 	createOpts := projects.CreateOpts{
@@ -68,16 +80,18 @@ Example to Delete a Project
 Example to List all tags of a Project
 
 	projectID := "966b3c7d36a24facaf20b7e458bf2192"
-	err := projects.ListTags(context.TODO(), identityClient, projectID).Extract()
+	tags, err := projects.ListTags(context.TODO(), identityClient, projectID).Extract()
 	if err != nil {
 		panic(err)
 	}
 
-Example to  modify all tags of a Project
+Example to Modify all tags of a Project
 
 	projectID := "966b3c7d36a24facaf20b7e458bf2192"
-	tags := ["foo", "bar"]
-	projects, err := projects.ModifyTags(context.TODO(), identityClient, projectID, tags).Extract()
+	modifyOpts := projects.ModifyTagsOpts{
+		Tags: []string{"foo", "bar"},
+	}
+	projectTags, err := projects.ModifyTags(context.TODO(), identityClient, projectID, modifyOpts).Extract()
 	if err != nil {
 		panic(err)
 	}
@@ -89,5 +103,35 @@ Example to Delete all tags of a Project
 	if err != nil {
 		panic(err)
 	}
+
+Example to Add, Check and Remove a single tag of a Project
+
+	projectID := "966b3c7d36a24facaf20b7e458bf2192"
+	err := projects.AddTag(context.TODO(), identityClient, projectID, "foo").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	exists, err := projects.CheckTag(context.TODO(), identityClient, projectID, "foo").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = projects.DeleteTag(context.TODO(), identityClient, projectID, "foo").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Projects by tags
+
+	listOpts := projects.ListOpts{
+		TagsAny: "foo,bar",
+		NotTags: "deprecated",
+	}
+
+	allPages, err := projects.List(identityClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
 */
 package projects
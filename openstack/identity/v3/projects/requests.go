@@ -244,7 +244,7 @@ func Update(ctx context.Context, client *gophercloud.ServiceClient, id string, o
 	return
 }
 
-// CheckTags lists tags for a project.
+// ListTags lists tags for a project.
 func ListTags(ctx context.Context, client *gophercloud.ServiceClient, projectID string) (r ListTagsResult) {
 	resp, err := client.Get(ctx, listTagsURL(client, projectID), &r.Body, &gophercloud.RequestOpts{
 		OkCodes: []int{200},
@@ -253,7 +253,7 @@ func ListTags(ctx context.Context, client *gophercloud.ServiceClient, projectID
 	return
 }
 
-// Tags represents a list of Tags object.
+// ModifyTagsOpts represents the full list of tags to set on a project.
 type ModifyTagsOpts struct {
 	// Tags is the list of tags associated with the project.
 	Tags []string `json:"tags,omitempty"`
@@ -276,8 +276,7 @@ func (opts ModifyTagsOpts) ToModifyTagsCreateMap() (map[string]any, error) {
 }
 
 // ModifyTags deletes all tags of a project and adds new ones.
-func ModifyTags(ctx context.Context, client *gophercloud.ServiceClient, projectID string, opts ModifyTagsOpts) (r ModifyTagsResult) {
-
+func ModifyTags(ctx context.Context, client *gophercloud.ServiceClient, projectID string, opts ModifyTagsOptsBuilder) (r ModifyTagsResult) {
 	b, err := opts.ToModifyTagsCreateMap()
 	if err != nil {
 		r.Err = err
@@ -290,7 +289,7 @@ func ModifyTags(ctx context.Context, client *gophercloud.ServiceClient, projectI
 	return
 }
 
-// DeleteTag deletes a tag from a project.
+// DeleteTags deletes all tags from a project.
 func DeleteTags(ctx context.Context, client *gophercloud.ServiceClient, projectID string) (r DeleteTagsResult) {
 	resp, err := client.Delete(ctx, deleteTagsURL(client, projectID), &gophercloud.RequestOpts{
 		OkCodes: []int{204},
@@ -298,3 +297,49 @@ func DeleteTags(ctx context.Context, client *gophercloud.ServiceClient, projectI
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// AddTag adds a single tag to a project.
+func AddTag(ctx context.Context, client *gophercloud.ServiceClient, projectID, tag string) (r AddTagResult) {
+	resp, err := client.Put(ctx, tagURL(client, projectID, tag), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CheckTag checks whether a project has a given tag.
+func CheckTag(ctx context.Context, client *gophercloud.ServiceClient, projectID, tag string) (r CheckTagResult) {
+	resp, err := client.Head(ctx, tagURL(client, projectID, tag), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteTag removes a single tag from a project.
+func DeleteTag(ctx context.Context, client *gophercloud.ServiceClient, projectID, tag string) (r DeleteTagResult) {
+	resp, err := client.Delete(ctx, tagURL(client, projectID, tag), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// IDFromName is a convenience function that returns a project's ID given its
+// name. It returns a gophercloud.ErrResourceNotFound if no project matches the
+// name, and a gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	pages, err := List(client, ListOpts{Name: name}).AllPages(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	all, err := ExtractProjects(pages)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.IDFromName(all, name, "project", func(r Project) (string, string) {
+		return r.Name, r.ID
+	})
+}
@@ -2,6 +2,7 @@ package projects
 
 import (
 	"encoding/json"
+	"net/http"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
@@ -182,8 +183,8 @@ type ProjectTags struct {
 	Links map[string]any `json:"links"`
 }
 
-// ModifyTagsResLinksult is the result of a  Tags request. Call its Extract method to
-// interpret it as a project of tags.
+// ModifyTagsResult is the result of a ModifyTags request. Call its Extract
+// method to interpret it as a ProjectTags.
 type ModifyTagsResult struct {
 	gophercloud.Result
 }
@@ -200,3 +201,33 @@ func (r ModifyTagsResult) Extract() (*ProjectTags, error) {
 type DeleteTagsResult struct {
 	gophercloud.ErrResult
 }
+
+// AddTagResult is the result of an AddTag request. Call its ExtractErr method
+// to determine if the request succeeded or failed.
+type AddTagResult struct {
+	gophercloud.ErrResult
+}
+
+// CheckTagResult is the result of a CheckTag request. Call its Extract method
+// to determine if the tag is present on the project.
+type CheckTagResult struct {
+	gophercloud.ErrResult
+}
+
+// Extract reports whether the tag exists on the project. A 404 response is
+// not treated as an error.
+func (r CheckTagResult) Extract() (bool, error) {
+	if r.Err == nil {
+		return true, nil
+	}
+	if gophercloud.ResponseCodeIs(r.Err, http.StatusNotFound) {
+		return false, nil
+	}
+	return false, r.Err
+}
+
+// DeleteTagResult is the result of a DeleteTag request. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteTagResult struct {
+	gophercloud.ErrResult
+}
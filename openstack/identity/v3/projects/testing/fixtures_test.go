@@ -382,3 +382,28 @@ func HandleDeleteProjectTagsSuccessfully(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 }
+
+// HandleProjectTagSuccessfully creates an HTTP handler at
+// `/projects/966b3c7d36a24facaf20b7e458bf2192/tags/foo` on the test handler
+// mux that tests adding, checking and removing a single project tag.
+func HandleProjectTagSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/projects/966b3c7d36a24facaf20b7e458bf2192/tags/foo", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "PUT":
+			w.WriteHeader(http.StatusCreated)
+		case "HEAD", "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	th.Mux.HandleFunc("/projects/966b3c7d36a24facaf20b7e458bf2192/tags/missing", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "HEAD")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNotFound)
+	})
+}
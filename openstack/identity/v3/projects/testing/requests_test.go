@@ -2,6 +2,8 @@ package testing
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/projects"
@@ -82,6 +84,19 @@ func TestListGroupsFiltersCheck(t *testing.T) {
 	}
 }
 
+func TestListProjectsTagsFilters(t *testing.T) {
+	listOpts := projects.ListOpts{
+		Tags:       "foo,bar",
+		TagsAny:    "baz",
+		NotTags:    "qux",
+		NotTagsAny: "quux",
+	}
+
+	actual, err := listOpts.ToProjectListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "?not-tags=qux&not-tags-any=quux&tags=foo%2Cbar&tags-any=baz", actual)
+}
+
 func TestGetProject(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -168,3 +183,51 @@ func TestDeleteTags(t *testing.T) {
 	err := projects.DeleteTags(context.TODO(), client.ServiceClient(), "966b3c7d36a24facaf20b7e458bf2192").ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestProjectTag(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleProjectTagSuccessfully(t)
+
+	projectID := "966b3c7d36a24facaf20b7e458bf2192"
+
+	err := projects.AddTag(context.TODO(), client.ServiceClient(), projectID, "foo").ExtractErr()
+	th.AssertNoErr(t, err)
+
+	exists, err := projects.CheckTag(context.TODO(), client.ServiceClient(), projectID, "foo").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, exists)
+
+	exists, err = projects.CheckTag(context.TODO(), client.ServiceClient(), projectID, "missing").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, false, exists)
+
+	err = projects.DeleteTag(context.TODO(), client.ServiceClient(), projectID, "foo").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/projects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, `
+{
+	"projects": [
+		{"id": "2bc7a2a9-7c2a-4a8c-9f0e-5d4b7a2b1c01", "name": "project-1"},
+		{"id": "7f3c8e1d-51b4-4a8e-8a1e-3d9c0e6f4b02", "name": "project-2"},
+		{"id": "c4d1f0e2-9b7a-4f6c-8d3e-1a2b5c6d7e03", "name": "project-2"}
+	]
+}`)
+	})
+
+	id, err := projects.IDFromName(context.TODO(), client.ServiceClient(), "project-1")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2bc7a2a9-7c2a-4a8c-9f0e-5d4b7a2b1c01", id)
+}
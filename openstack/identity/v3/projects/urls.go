@@ -37,3 +37,7 @@ func modifyTagsURL(client *gophercloud.ServiceClient, projectID string) string {
 func deleteTagsURL(client *gophercloud.ServiceClient, projectID string) string {
 	return client.ServiceURL("projects", projectID, "tags")
 }
+
+func tagURL(client *gophercloud.ServiceClient, projectID, tag string) string {
+	return client.ServiceURL("projects", projectID, "tags", tag)
+}
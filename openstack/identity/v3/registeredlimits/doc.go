@@ -2,6 +2,13 @@
 Package registeredlimits provides information and interaction with registered limits for the
 Openstack Identity service.
 
+Example to Get EnforcementModel
+
+	model, err := registeredlimits.GetEnforcementModel(context.TODO(), identityClient).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to List RegisteredLimits
 
 	listOpts := registeredlimits.ListOpts{
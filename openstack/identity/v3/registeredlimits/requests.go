@@ -7,6 +7,14 @@ import (
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
 
+// GetEnforcementModel retrieves the limit enforcement model of the deployment.
+// It is the same as limits.GetEnforcementModel.
+func GetEnforcementModel(ctx context.Context, client *gophercloud.ServiceClient) (r EnforcementModelResult) {
+	resp, err := client.Get(ctx, enforcementModelURL(client), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // ListOptsBuilder allows extensions to add additional parameters to
 // the List request
 type ListOptsBuilder interface {
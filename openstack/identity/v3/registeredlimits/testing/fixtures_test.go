@@ -10,6 +10,15 @@ import (
 	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
 )
 
+const GetEnforcementModelOutput = `
+{
+    "model": {
+        "description": "Limit enforcement and validation does not take project hierarchy into consideration.",
+        "name": "flat"
+    }
+}
+`
+
 // ListOutput provides a single page of List results.
 const ListOutput = `
 {
@@ -155,6 +164,26 @@ var ExpectedRegisteredLimitsSlice = []registeredlimits.RegisteredLimit{FirstRegi
 
 // HandleListRegisteredLimitsSuccessfully creates an HTTP handler at `/registered_limits` on the
 // test handler mux that responds with a list of two registered limits.
+// Model is the enforcement model in the GetEnforcementModel request.
+var Model = registeredlimits.EnforcementModel{
+	Name:        "flat",
+	Description: "Limit enforcement and validation does not take project hierarchy into consideration.",
+}
+
+// HandleGetEnforcementModelSuccessfully creates an HTTP handler at `/limits/model` on the
+// test handler mux that responds with a enforcement model.
+func HandleGetEnforcementModelSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits/model", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetEnforcementModelOutput)
+	})
+}
+
 func HandleListRegisteredLimitsSuccessfully(t *testing.T) {
 	th.Mux.HandleFunc("/registered_limits", func(w http.ResponseWriter, r *http.Request) {
 		th.TestMethod(t, r, "GET")
@@ -10,6 +10,16 @@ import (
 	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
 )
 
+func TestGetEnforcementModel(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetEnforcementModelSuccessfully(t)
+
+	actual, err := registeredlimits.GetEnforcementModel(context.TODO(), client.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, Model, *actual)
+}
+
 func TestListRegisteredLimits(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
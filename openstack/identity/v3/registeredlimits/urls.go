@@ -4,9 +4,14 @@ import "github.com/vnpaycloud-console/gophercloud/v2"
 
 const (
 	rootPath             = "registered_limits"
+	limitsPath           = "limits"
 	enforcementModelPath = "model"
 )
 
+func enforcementModelURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(limitsPath, enforcementModelPath)
+}
+
 func rootURL(client *gophercloud.ServiceClient) string {
 	return client.ServiceURL(rootPath)
 }
@@ -6,6 +6,9 @@ Example to List Roles
 
 	listOpts := roles.ListOpts{
 		DomainID: "default",
+		Filters: map[string]string{
+			"name__contains": "admin",
+		},
 	}
 
 	allPages, err := roles.List(identityClient, listOpts).AllPages(context.TODO())
@@ -79,6 +82,22 @@ Example to List Role Assignments
 		fmt.Printf("%+v\n", role)
 	}
 
+Example to Count Role Assignments
+
+	err := roles.ListAssignments(identityClient, listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		total, ok, err := page.(roles.RoleAssignmentPage).Total()
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			fmt.Printf("%d role assignments\n", total)
+		}
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 Example to List Role Assignments for a User on a Project
 
 	projectID := "a99e9b4e620e4db09a2dfb6e42a01e66"
@@ -132,6 +151,64 @@ Example to Unassign a Role From a User in a Project
 		panic(err)
 	}
 
+Example to Assign a System Role to a User
+
+	userID := "9df1a02f5eb2416a9781e8b0c022d3ae"
+	roleID := "9fe2ff9ee4384b1894a90878d3e92bab"
+
+	err := roles.AssignSystem(context.TODO(), identityClient, roleID, roles.SystemOpts{
+		UserID: userID,
+	}).ExtractErr()
+
+	if err != nil {
+		panic(err)
+	}
+
+Example to Check a System Role of a Group
+
+	groupID := "9df1a02f5eb2416a9781e8b0c022d3ae"
+	roleID := "9fe2ff9ee4384b1894a90878d3e92bab"
+
+	assigned, err := roles.CheckSystem(context.TODO(), identityClient, roleID, roles.SystemOpts{
+		GroupID: groupID,
+	}).Extract()
+
+	if err != nil {
+		panic(err)
+	}
+
+Example to List System Role Assignments
+
+	listAssignmentsOpts := roles.ListAssignmentsOpts{
+		ScopeSystem: "all",
+	}
+
+	allPages, err := roles.ListAssignments(identityClient, listAssignmentsOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allRoles, err := roles.ExtractRoleAssignments(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Grant several Roles to a Group on every Project of a Domain
+
+	err := roles.GrantGroupRoles(context.TODO(), identityClient, roles.GrantGroupRolesOpts{
+		GroupID:  "9df1a02f5eb2416a9781e8b0c022d3ae",
+		RoleIDs:  []string{"9fe2ff9ee4384b1894a90878d3e92bab", "1e0c7d9e1d674d2c8f2b7e4d5a7c6b3a"},
+		DomainID: "default",
+	})
+	if err != nil {
+		if e, ok := err.(roles.ErrGrantGroupRoles); ok {
+			for _, failure := range e.Errors {
+				fmt.Printf("%s on %s: %s\n", failure.RoleID, failure.ProjectID, failure.Err)
+			}
+		}
+		panic(err)
+	}
+
 Example to Create a Role Inference Rule
 
 	priorRoleID := "7ceab6192ea34a548cc71b24f72e762c"
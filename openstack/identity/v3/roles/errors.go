@@ -1,8 +1,13 @@
 package roles
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
 
-// InvalidListFilter is returned by the ToUserListQuery method when validation of
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// InvalidListFilter is returned by the ToRoleListQuery method when validation of
 // a filter does not pass
 type InvalidListFilter struct {
 	FilterName string
@@ -10,8 +15,50 @@ type InvalidListFilter struct {
 
 func (e InvalidListFilter) Error() string {
 	s := fmt.Sprintf(
-		"Invalid filter name [%s]: it must be in format of NAME__COMPARATOR",
+		"Invalid filter name [%s]: it must be in format of NAME__COMPARATOR with a supported comparator",
 		e.FilterName,
 	)
 	return s
 }
+
+// GrantError describes a single failed role assignment of GrantGroupRoles.
+type GrantError struct {
+	ProjectID string
+	RoleID    string
+	Err       error
+}
+
+func (e GrantError) Error() string {
+	return fmt.Sprintf("Unable to grant role [%s] on project [%s]: %s", e.RoleID, e.ProjectID, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e GrantError) Unwrap() error {
+	return e.Err
+}
+
+// ErrGrantGroupRoles is returned by GrantGroupRoles when at least one of the
+// role assignments failed. Errors lists every failed assignment.
+type ErrGrantGroupRoles struct {
+	gophercloud.BaseError
+	GroupID string
+	Errors  []GrantError
+}
+
+func (e ErrGrantGroupRoles) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("Failed to grant %d role assignment(s) to group [%s]: %s",
+		len(e.Errors), e.GroupID, strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the errors of the failed role assignments.
+func (e ErrGrantGroupRoles) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
+}
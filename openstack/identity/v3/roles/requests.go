@@ -15,6 +15,19 @@ type ListOptsBuilder interface {
 	ToRoleListQuery() (string, error)
 }
 
+// validFilterComparators are the comparators the Identity service accepts in
+// the custom filters of ListOpts.
+var validFilterComparators = map[string]bool{
+	"contains":    true,
+	"icontains":   true,
+	"startswith":  true,
+	"istartswith": true,
+	"endswith":    true,
+	"iendswith":   true,
+	"equals":      true,
+	"iequals":     true,
+}
+
 // ListOpts provides options to filter the List results.
 type ListOpts struct {
 	// DomainID filters the response by a domain ID.
@@ -23,9 +36,15 @@ type ListOpts struct {
 	// Name filters the response by role name.
 	Name string `q:"name"`
 
+	// PerPage is the maximum number of roles returned in a single page. It is
+	// only honoured by deployments which paginate the role list.
+	PerPage int `q:"per_page"`
+
 	// Filters filters the response by custom filters such as
-	// 'name__contains=foo'
-	Filters map[string]string `q:"-"`
+	// 'name__contains=foo'. The comparator must be one of contains,
+	// icontains, startswith, istartswith, endswith, iendswith, equals or
+	// iequals.
+	Filters map[string]string
 }
 
 // ToRoleListQuery formats a ListOpts into a query string.
@@ -35,14 +54,21 @@ func (opts ListOpts) ToRoleListQuery() (string, error) {
 		return "", err
 	}
 
+	if opts.PerPage < 0 {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "roles.ListOpts.PerPage"
+		err.Value = opts.PerPage
+		err.Info = "PerPage must not be negative"
+		return "", err
+	}
+
 	params := q.Query()
 	for k, v := range opts.Filters {
 		i := strings.Index(k, "__")
-		if i > 0 && i < len(k)-2 {
-			params.Add(k, v)
-		} else {
+		if i <= 0 || !validFilterComparators[k[i+2:]] {
 			return "", InvalidListFilter{FilterName: k}
 		}
+		params.Add(k, v)
 	}
 
 	q = &url.URL{RawQuery: params.Encode()}
@@ -199,6 +225,14 @@ type ListAssignmentsOpts struct {
 	// ScopeProjectID filters the results by the given Project ID.
 	ScopeProjectID string `q:"scope.project.id"`
 
+	// ScopeSystem filters the results by system scoped assignments. The only
+	// valid value is "all".
+	ScopeSystem string `q:"scope.system"`
+
+	// ScopeInheritedTo filters the results by inherited assignments. The only
+	// valid value is "projects".
+	ScopeInheritedTo string `q:"scope.OS-INHERIT:inherited_to"`
+
 	// UserID filterst he results by the given User ID.
 	UserID string `q:"user.id"`
 
@@ -407,6 +441,92 @@ func Unassign(ctx context.Context, client *gophercloud.ServiceClient, roleID str
 	return
 }
 
+// SystemOpts provides options to manage system scoped role assignments.
+type SystemOpts struct {
+	// UserID is the ID of a user to manage a system role for
+	// Note: exactly one of UserID or GroupID must be provided
+	UserID string `xor:"GroupID"`
+
+	// GroupID is the ID of a group to manage a system role for
+	// Note: exactly one of UserID or GroupID must be provided
+	GroupID string `xor:"UserID"`
+}
+
+func (opts SystemOpts) actor() (string, string, error) {
+	// Check xor conditions
+	_, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return "", "", err
+	}
+
+	if opts.UserID != "" {
+		return "users", opts.UserID, nil
+	}
+	return "groups", opts.GroupID, nil
+}
+
+// ListSystemAssignments is the operation responsible for listing the system
+// scoped roles of a user/group.
+func ListSystemAssignments(client *gophercloud.ServiceClient, opts SystemOpts) pagination.Pager {
+	actorType, actorID, err := opts.actor()
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+
+	url := listSystemAssignmentsURL(client, actorType, actorID)
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return RolePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// AssignSystem is the operation responsible for assigning a system scoped
+// role to a user/group.
+func AssignSystem(ctx context.Context, client *gophercloud.ServiceClient, roleID string, opts SystemOpts) (r AssignmentResult) {
+	actorType, actorID, err := opts.actor()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Put(ctx, systemAssignURL(client, actorType, actorID, roleID), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CheckSystem is the operation responsible for checking whether a user/group
+// has a system scoped role.
+func CheckSystem(ctx context.Context, client *gophercloud.ServiceClient, roleID string, opts SystemOpts) (r CheckAssignmentResult) {
+	actorType, actorID, err := opts.actor()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Head(ctx, systemAssignURL(client, actorType, actorID, roleID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UnassignSystem is the operation responsible for unassigning a system scoped
+// role from a user/group.
+func UnassignSystem(ctx context.Context, client *gophercloud.ServiceClient, roleID string, opts SystemOpts) (r UnassignmentResult) {
+	actorType, actorID, err := opts.actor()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Delete(ctx, systemAssignURL(client, actorType, actorID, roleID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 func CreateRoleInferenceRule(ctx context.Context, client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) (r CreateImpliedRoleResult) {
 	resp, err := client.Put(ctx, createRoleInferenceRuleURL(client, priorRoleID, impliedRoleID), nil, &r.Body, &gophercloud.RequestOpts{
 		OkCodes: []int{201},
@@ -2,6 +2,7 @@ package roles
 
 import (
 	"encoding/json"
+	"net/http"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
@@ -150,6 +151,16 @@ type AssignedRole struct {
 type Scope struct {
 	Domain  Domain  `json:"domain,omitempty"`
 	Project Project `json:"project,omitempty"`
+	System  System  `json:"system,omitempty"`
+
+	// InheritedTo is set to "projects" when the role assignment is inherited
+	// by the projects of the scope, see the osinherit package.
+	InheritedTo string `json:"OS-INHERIT:inherited_to,omitempty"`
+}
+
+// System represents a system in a role assignment scope.
+type System struct {
+	All bool `json:"all,omitempty"`
 }
 
 // Domain represents a domain in a role assignment scope.
@@ -206,6 +217,29 @@ func (r RoleAssignmentPage) NextPageURL() (string, error) {
 	return s.Links.Next, err
 }
 
+// Total returns the total number of role assignments matching the request,
+// when the service reports it. ok is false if the response carries no total.
+func (r RoleAssignmentPage) Total() (total int, ok bool, err error) {
+	var s struct {
+		Total *int `json:"total"`
+	}
+	err = r.ExtractInto(&s)
+	if err != nil || s.Total == nil {
+		return 0, false, err
+	}
+	return *s.Total, true, nil
+}
+
+// Truncated reports whether the service truncated the list of role
+// assignments because it exceeded its configured list limit.
+func (r RoleAssignmentPage) Truncated() (bool, error) {
+	var s struct {
+		Truncated bool `json:"truncated"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Truncated, err
+}
+
 // ExtractRoleAssignments extracts a slice of RoleAssignments from a Collection
 // acquired from List.
 func ExtractRoleAssignments(r pagination.Page) ([]RoleAssignment, error) {
@@ -222,6 +256,24 @@ type AssignmentResult struct {
 	gophercloud.ErrResult
 }
 
+// CheckAssignmentResult represents the result of a check operation.
+// Call Extract method to determine if the role is assigned.
+type CheckAssignmentResult struct {
+	gophercloud.ErrResult
+}
+
+// Extract reports whether the role is assigned. A 404 response is not
+// treated as an error.
+func (r CheckAssignmentResult) Extract() (bool, error) {
+	if r.Err == nil {
+		return true, nil
+	}
+	if gophercloud.ResponseCodeIs(r.Err, http.StatusNotFound) {
+		return false, nil
+	}
+	return false, r.Err
+}
+
 // UnassignmentResult represents the result of an unassign operation.
 // Call ExtractErr method to determine if the request succeeded or failed.
 type UnassignmentResult struct {
@@ -151,6 +151,41 @@ const ListAssignmentOutput = `
 }
 `
 
+// ListAssignmentWithTotalOutput provides a result of a ListAssignments request
+// from a service reporting the total number of assignments.
+const ListAssignmentWithTotalOutput = `
+{
+    "role_assignments": [
+        {
+            "links": {
+                "assignment": "http://identity:35357/v3/domains/161718/users/313233/roles/123456"
+            },
+            "role": {
+                "id": "123456"
+            },
+            "scope": {
+                "domain": {
+                    "id": "161718"
+                }
+            },
+            "user": {
+                "domain": {
+                  "id": "161718"
+                },
+                "id": "313233"
+            }
+        }
+    ],
+    "total": 42,
+    "truncated": true,
+    "links": {
+        "self": "http://identity:35357/v3/role_assignments",
+        "previous": null,
+        "next": null
+    }
+}
+`
+
 // ListAssignmentWithNamesOutput provides a result of ListAssignment request with IncludeNames option.
 const ListAssignmentWithNamesOutput = `
 {
@@ -503,6 +538,20 @@ func HandleListRoleAssignmentsSuccessfully(t *testing.T) {
 	})
 }
 
+// HandleListRoleAssignmentsWithTotalSuccessfully creates an HTTP handler at
+// `/role_assignments` on the test handler mux that responds with a truncated
+// list of role assignments and their total.
+func HandleListRoleAssignmentsWithTotalSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/role_assignments", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListAssignmentWithTotalOutput)
+	})
+}
+
 // HandleListRoleAssignmentsSuccessfully creates an HTTP handler at `/role_assignments` on the
 // test handler mux that responds with a list of two role assignments.
 func HandleListRoleAssignmentsWithNamesSuccessfully(t *testing.T) {
@@ -533,6 +582,65 @@ func HandleListRoleAssignmentsWithSubtreeSuccessfully(t *testing.T) {
 	})
 }
 
+// ListInheritedAssignmentOutput provides a result of ListAssignments request
+// filtered by inherited assignments.
+const ListInheritedAssignmentOutput = `
+{
+    "role_assignments": [
+        {
+            "links": {
+                "assignment": "http://identity:35357/v3/OS-INHERIT/domains/161718/users/313233/roles/123456/inherited_to_projects"
+            },
+            "role": {
+                "id": "123456"
+            },
+            "scope": {
+                "domain": {
+                    "id": "161718"
+                },
+                "OS-INHERIT:inherited_to": "projects"
+            },
+            "user": {
+                "domain": {
+                  "id": "161718"
+                },
+                "id": "313233"
+            }
+        }
+    ],
+    "links": {
+        "self": "http://identity:35357/v3/role_assignments?scope.OS-INHERIT:inherited_to=projects",
+        "previous": null,
+        "next": null
+    }
+}
+`
+
+// InheritedRoleAssignment is the role assignment in the
+// ListInheritedAssignmentOutput.
+var InheritedRoleAssignment = roles.RoleAssignment{
+	Role:  roles.AssignedRole{ID: "123456"},
+	Scope: roles.Scope{Domain: roles.Domain{ID: "161718"}, InheritedTo: "projects"},
+	User:  roles.User{Domain: roles.Domain{ID: "161718"}, ID: "313233"},
+	Group: roles.Group{},
+}
+
+// HandleListInheritedRoleAssignmentsSuccessfully creates an HTTP handler at
+// `/role_assignments` on the test handler mux that responds with a list of
+// inherited role assignments.
+func HandleListInheritedRoleAssignmentsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/role_assignments", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.AssertEquals(t, "projects", r.URL.Query().Get("scope.OS-INHERIT:inherited_to"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListInheritedAssignmentOutput)
+	})
+}
+
 // RoleOnResource is the role in the ListAssignmentsOnResource request.
 var RoleOnResource = roles.Role{
 	ID: "9fe1d3",
@@ -605,6 +713,98 @@ func HandleListAssignmentsOnResourceSuccessfully_DomainsGroups(t *testing.T) {
 	th.Mux.HandleFunc("/domains/{domain_id}/groups/{group_id}/roles", fn)
 }
 
+// ListSystemAssignmentOutput provides a result of a ListAssignments request
+// filtered by system scope.
+const ListSystemAssignmentOutput = `
+{
+    "role_assignments": [
+        {
+            "links": {
+                "assignment": "http://identity:35357/v3/system/users/313233/roles/123456"
+            },
+            "role": {
+                "id": "123456"
+            },
+            "scope": {
+                "system": {
+                    "all": true
+                }
+            },
+            "user": {
+                "domain": {
+                    "id": "161718"
+                },
+                "id": "313233"
+            }
+        }
+    ],
+    "links": {
+        "self": "http://identity:35357/v3/role_assignments?scope.system=all",
+        "previous": null,
+        "next": null
+    }
+}
+`
+
+// SystemRoleAssignment is the role assignment in the ListSystemAssignmentOutput.
+var SystemRoleAssignment = roles.RoleAssignment{
+	Role:  roles.AssignedRole{ID: "123456"},
+	Scope: roles.Scope{System: roles.System{All: true}},
+	User:  roles.User{Domain: roles.Domain{ID: "161718"}, ID: "313233"},
+	Group: roles.Group{},
+}
+
+// HandleListSystemRoleAssignmentsSuccessfully creates an HTTP handler at
+// `/role_assignments` on the test handler mux that responds with a system
+// scoped role assignment.
+func HandleListSystemRoleAssignmentsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/role_assignments", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.AssertEquals(t, "scope.system=all", r.URL.RawQuery)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListSystemAssignmentOutput)
+	})
+}
+
+func HandleListSystemAssignmentsSuccessfully(t *testing.T) {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListAssignmentsOnResourceOutput)
+	}
+
+	th.Mux.HandleFunc("/system/users/{user_id}/roles", fn)
+	th.Mux.HandleFunc("/system/groups/{group_id}/roles", fn)
+}
+
+func HandleSystemAssignmentSuccessfully(t *testing.T) {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		switch r.Method {
+		case "PUT", "HEAD", "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	}
+
+	th.Mux.HandleFunc("/system/users/{user_id}/roles/{role_id}", fn)
+	th.Mux.HandleFunc("/system/groups/{group_id}/roles/{role_id}", fn)
+	th.Mux.HandleFunc("/system/users/{user_id}/roles/missing", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "HEAD")
+		w.WriteHeader(http.StatusNotFound)
+	})
+}
+
 var expectedRoleInferenceRule = roles.RoleInferenceRule{
 	RoleInference: roles.RoleInference{
 		PriorRole: roles.PriorRole{
@@ -742,3 +942,34 @@ func HandleGetRoleInferenceRule(t *testing.T) {
 
 	th.Mux.HandleFunc("/roles/7ceab6192ea34a548cc71b24f72e762c/implies/97e2f5d38bc94842bc3da818c16762ed", fn)
 }
+
+// HandleGrantGroupRolesSuccessfully creates HTTP handlers on the test handler
+// mux for the requests performed by GrantGroupRoles. Assignments of role
+// "forbidden" fail with a 403 response.
+func HandleGrantGroupRolesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/projects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.AssertEquals(t, "domain_id=d1", r.URL.RawQuery)
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"projects": [
+				{"id": "p1", "domain_id": "d1"},
+				{"id": "p3", "domain_id": "d1"}
+			],
+			"links": {"next": null}
+		}`)
+	})
+
+	th.Mux.HandleFunc("/projects/{project_id}/groups/g1/roles/{role_id}", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		if r.PathValue("role_id") == "forbidden" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
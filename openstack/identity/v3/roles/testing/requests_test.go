@@ -2,8 +2,10 @@ package testing
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/roles"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
@@ -54,6 +56,8 @@ func TestListUsersFiltersCheck(t *testing.T) {
 		{"foo_contains", true},
 		{"foo__", true},
 		{"__foo", true},
+		{"foo__bar", true},
+		{"name__iendswith", false},
 	}
 
 	var listOpts roles.ListOpts
@@ -142,12 +146,36 @@ func TestListAssignmentsSinglePage(t *testing.T) {
 
 		th.CheckDeepEquals(t, ExpectedRoleAssignmentsSlice, actual)
 
+		_, ok, err := page.(roles.RoleAssignmentPage).Total()
+		th.AssertNoErr(t, err)
+		th.CheckEquals(t, false, ok)
+
 		return true, nil
 	})
 	th.AssertNoErr(t, err)
 	th.CheckEquals(t, count, 1)
 }
 
+func TestListAssignmentsTotal(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListRoleAssignmentsWithTotalSuccessfully(t)
+
+	err := roles.ListAssignments(client.ServiceClient(), roles.ListAssignmentsOpts{}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		total, ok, err := page.(roles.RoleAssignmentPage).Total()
+		th.AssertNoErr(t, err)
+		th.CheckEquals(t, true, ok)
+		th.CheckEquals(t, 42, total)
+
+		truncated, err := page.(roles.RoleAssignmentPage).Truncated()
+		th.AssertNoErr(t, err)
+		th.CheckEquals(t, true, truncated)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+}
+
 func TestListAssignmentsWithNamesSinglePage(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -196,6 +224,22 @@ func TestListAssignmentsWithSubtreeSinglePage(t *testing.T) {
 	th.CheckEquals(t, count, 1)
 }
 
+func TestListInheritedAssignments(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListInheritedRoleAssignmentsSuccessfully(t)
+
+	listOpts := roles.ListAssignmentsOpts{
+		ScopeInheritedTo: "projects",
+	}
+
+	allPages, err := roles.ListAssignments(client.ServiceClient(), listOpts).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := roles.ExtractRoleAssignments(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []roles.RoleAssignment{InheritedRoleAssignment}, actual)
+}
+
 func TestListAssignmentsOnResource_ProjectsUsers(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -344,6 +388,65 @@ func TestUnassign(t *testing.T) {
 	th.AssertNoErr(t, err)
 }
 
+func TestListSystemRoleAssignments(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSystemRoleAssignmentsSuccessfully(t)
+
+	allPages, err := roles.ListAssignments(client.ServiceClient(), roles.ListAssignmentsOpts{
+		ScopeSystem: "all",
+	}).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := roles.ExtractRoleAssignments(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []roles.RoleAssignment{SystemRoleAssignment}, actual)
+}
+
+func TestListSystemAssignments(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSystemAssignmentsSuccessfully(t)
+
+	for _, opts := range []roles.SystemOpts{{UserID: "{user_id}"}, {GroupID: "{group_id}"}} {
+		allPages, err := roles.ListSystemAssignments(client.ServiceClient(), opts).AllPages(context.TODO())
+		th.AssertNoErr(t, err)
+		actual, err := roles.ExtractRoles(allPages)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, ExpectedRolesOnResourceSlice, actual)
+	}
+
+	_, err := roles.ListSystemAssignments(client.ServiceClient(), roles.SystemOpts{}).AllPages(context.TODO())
+	th.AssertErr(t, err)
+}
+
+func TestSystemAssignment(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSystemAssignmentSuccessfully(t)
+
+	for _, opts := range []roles.SystemOpts{{UserID: "{user_id}"}, {GroupID: "{group_id}"}} {
+		err := roles.AssignSystem(context.TODO(), client.ServiceClient(), "{role_id}", opts).ExtractErr()
+		th.AssertNoErr(t, err)
+
+		exists, err := roles.CheckSystem(context.TODO(), client.ServiceClient(), "{role_id}", opts).Extract()
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, true, exists)
+
+		err = roles.UnassignSystem(context.TODO(), client.ServiceClient(), "{role_id}", opts).ExtractErr()
+		th.AssertNoErr(t, err)
+	}
+
+	exists, err := roles.CheckSystem(context.TODO(), client.ServiceClient(), "missing", roles.SystemOpts{UserID: "{user_id}"}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, false, exists)
+
+	err = roles.AssignSystem(context.TODO(), client.ServiceClient(), "{role_id}", roles.SystemOpts{
+		UserID:  "{user_id}",
+		GroupID: "{group_id}",
+	}).ExtractErr()
+	th.AssertErr(t, err)
+}
+
 func TestCreateRoleInferenceRule(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -382,3 +485,67 @@ func TestGetInferenceRule(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.CheckDeepEquals(t, expectedRoleInferenceRule, *actual)
 }
+
+func TestGrantGroupRoles(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGrantGroupRolesSuccessfully(t)
+
+	err := roles.GrantGroupRoles(context.TODO(), client.ServiceClient(), roles.GrantGroupRolesOpts{
+		GroupID:    "g1",
+		RoleIDs:    []string{"r1", "r2"},
+		ProjectIDs: []string{"p1", "p2"},
+		DomainID:   "d1",
+	})
+	th.AssertNoErr(t, err)
+}
+
+func TestGrantGroupRolesPartialFailure(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGrantGroupRolesSuccessfully(t)
+
+	err := roles.GrantGroupRoles(context.TODO(), client.ServiceClient(), roles.GrantGroupRolesOpts{
+		GroupID:    "g1",
+		RoleIDs:    []string{"r1", "forbidden"},
+		ProjectIDs: []string{"p2", "p1"},
+	})
+	grantErr, ok := err.(roles.ErrGrantGroupRoles)
+	if !ok {
+		t.Fatalf("expected ErrGrantGroupRoles, got %v", err)
+	}
+	th.AssertEquals(t, 2, len(grantErr.Errors))
+	th.AssertEquals(t, "p1", grantErr.Errors[0].ProjectID)
+	th.AssertEquals(t, "p2", grantErr.Errors[1].ProjectID)
+	th.AssertEquals(t, "forbidden", grantErr.Errors[0].RoleID)
+	th.AssertEquals(t, true, gophercloud.ResponseCodeIs(err, http.StatusForbidden))
+}
+
+func TestGrantGroupRolesMissingInput(t *testing.T) {
+	err := roles.GrantGroupRoles(context.TODO(), client.ServiceClient(), roles.GrantGroupRolesOpts{
+		GroupID: "g1",
+		RoleIDs: []string{"r1"},
+	})
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("expected ErrMissingInput, got %v", err)
+	}
+}
+
+func TestListRolesOpts(t *testing.T) {
+	listOpts := roles.ListOpts{
+		DomainID: "default",
+		PerPage:  20,
+		Filters: map[string]string{
+			"name__contains": "admin",
+		},
+	}
+	query, err := listOpts.ToRoleListQuery()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "?domain_id=default&name__contains=admin&per_page=20", query)
+
+	listOpts = roles.ListOpts{PerPage: -1}
+	_, err = listOpts.ToRoleListQuery()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+}
@@ -38,6 +38,14 @@ func assignURL(client *gophercloud.ServiceClient, targetType, targetID, actorTyp
 	return client.ServiceURL(targetType, targetID, actorType, actorID, rolePath, roleID)
 }
 
+func listSystemAssignmentsURL(client *gophercloud.ServiceClient, actorType, actorID string) string {
+	return client.ServiceURL("system", actorType, actorID, rolePath)
+}
+
+func systemAssignURL(client *gophercloud.ServiceClient, actorType, actorID, roleID string) string {
+	return client.ServiceURL("system", actorType, actorID, rolePath, roleID)
+}
+
 func createRoleInferenceRuleURL(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) string {
 	return client.ServiceURL(rolePath, priorRoleID, "implies", impliedRoleID)
 }
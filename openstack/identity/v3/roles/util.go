@@ -0,0 +1,113 @@
+package roles
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/projects"
+)
+
+// grantConcurrency is the maximum number of role assignments performed in
+// parallel by GrantGroupRoles.
+const grantConcurrency = 8
+
+// GrantGroupRolesOpts provides options to grant a set of roles to a group on
+// several projects at once.
+type GrantGroupRolesOpts struct {
+	// GroupID is the ID of the group to grant the roles to.
+	GroupID string
+
+	// RoleIDs is the list of roles to grant.
+	RoleIDs []string
+
+	// ProjectIDs is the list of projects to grant the roles on.
+	ProjectIDs []string
+
+	// DomainID, if set, adds every project of the given domain to the list
+	// of targets.
+	DomainID string
+}
+
+// GrantGroupRoles assigns every role of opts.RoleIDs to opts.GroupID on every
+// project of opts.ProjectIDs and, if opts.DomainID is set, on every project
+// of that domain. Assignments are performed concurrently. All assignments are
+// attempted even if some of them fail; failures are reported together in an
+// ErrGrantGroupRoles error.
+func GrantGroupRoles(ctx context.Context, client *gophercloud.ServiceClient, opts GrantGroupRolesOpts) error {
+	if opts.GroupID == "" {
+		return gophercloud.ErrMissingInput{Argument: "GroupID"}
+	}
+	if len(opts.RoleIDs) == 0 {
+		return gophercloud.ErrMissingInput{Argument: "RoleIDs"}
+	}
+
+	projectIDs := opts.ProjectIDs
+	if opts.DomainID != "" {
+		allPages, err := projects.List(client, projects.ListOpts{DomainID: opts.DomainID}).AllPages(ctx)
+		if err != nil {
+			return err
+		}
+
+		allProjects, err := projects.ExtractProjects(allPages)
+		if err != nil {
+			return err
+		}
+
+		seen := make(map[string]struct{}, len(projectIDs))
+		for _, id := range projectIDs {
+			seen[id] = struct{}{}
+		}
+		for _, p := range allProjects {
+			if _, ok := seen[p.ID]; !ok {
+				projectIDs = append(projectIDs, p.ID)
+			}
+		}
+	}
+
+	if len(projectIDs) == 0 {
+		return gophercloud.ErrMissingInput{Argument: "ProjectIDs"}
+	}
+
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		errs []GrantError
+	)
+	sem := make(chan struct{}, grantConcurrency)
+
+	for _, projectID := range projectIDs {
+		for _, roleID := range opts.RoleIDs {
+			wg.Add(1)
+			go func(projectID, roleID string) {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+
+				err := Assign(ctx, client, roleID, AssignOpts{
+					GroupID:   opts.GroupID,
+					ProjectID: projectID,
+				}).ExtractErr()
+				if err != nil {
+					mu.Lock()
+					errs = append(errs, GrantError{ProjectID: projectID, RoleID: roleID, Err: err})
+					mu.Unlock()
+				}
+			}(projectID, roleID)
+		}
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		sort.Slice(errs, func(i, j int) bool {
+			if errs[i].ProjectID != errs[j].ProjectID {
+				return errs[i].ProjectID < errs[j].ProjectID
+			}
+			return errs[i].RoleID < errs[j].RoleID
+		})
+		return ErrGrantGroupRoles{GroupID: opts.GroupID, Errors: errs}
+	}
+
+	return nil
+}
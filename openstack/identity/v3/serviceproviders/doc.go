@@ -0,0 +1,68 @@
+/*
+Package serviceproviders manages the service providers of the OpenStack
+Identity service, used for Keystone to Keystone federation.
+
+Example to List Service Providers
+
+	allPages, err := serviceproviders.List(identityClient, nil).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allServiceProviders, err := serviceproviders.ExtractServiceProviders(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, sp := range allServiceProviders {
+		fmt.Printf("%+v\n", sp)
+	}
+
+Example to Create a Service Provider
+
+	createOpts := serviceproviders.CreateOpts{
+		AuthURL: "https://sp1.example.com/identity/v3/OS-FEDERATION/identity_providers/acme/protocols/saml2/auth",
+		SPURL:   "https://sp1.example.com/Shibboleth.sso/SAML2/ECP",
+		Enabled: gophercloud.Enabled,
+	}
+
+	sp, err := serviceproviders.Create(context.TODO(), identityClient, "sp1", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Service Provider
+
+	updateOpts := serviceproviders.UpdateOpts{
+		Enabled: gophercloud.Disabled,
+	}
+
+	sp, err := serviceproviders.Update(context.TODO(), identityClient, "sp1", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Service Provider
+
+	err := serviceproviders.Delete(context.TODO(), identityClient, "sp1").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Request an ECP wrapped SAML Assertion for a Service Provider
+
+	ecpOpts := serviceproviders.ECPAssertionOpts{
+		TokenID:           identityClient.Token(),
+		ServiceProviderID: "sp1",
+	}
+
+	res := serviceproviders.GetECPAssertion(context.TODO(), identityClient, ecpOpts)
+	assertion, err := res.Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	// The assertion can now be posted to the SPURL of the service provider.
+	spURL := res.Header.Get("X-Sp-Url")
+*/
+package serviceproviders
@@ -0,0 +1,206 @@
+package serviceproviders
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request.
+type ListOptsBuilder interface {
+	ToServiceProviderListQuery() (string, error)
+}
+
+// ListOpts provides options to filter the List results.
+type ListOpts struct {
+	// ID filters the response by a service provider ID.
+	ID string `q:"id"`
+
+	// Enabled filters the response by the enabled status.
+	Enabled *bool `q:"enabled"`
+}
+
+// ToServiceProviderListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToServiceProviderListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the service providers.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToServiceProviderListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ServiceProviderPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToServiceProviderCreateMap() (map[string]any, error)
+}
+
+// CreateOpts provides options used to register a service provider.
+type CreateOpts struct {
+	// AuthURL is the URL to authenticate against the service provider.
+	AuthURL string `json:"auth_url" required:"true"`
+
+	// SPURL is the URL of the SAML2 ECP endpoint of the service provider.
+	SPURL string `json:"sp_url" required:"true"`
+
+	// Description is the description of the service provider.
+	Description string `json:"description,omitempty"`
+
+	// Enabled is whether or not the service provider is enabled.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// RelayStatePrefix is the prefix of the RelayState SAML attribute.
+	RelayStatePrefix string `json:"relay_state_prefix,omitempty"`
+}
+
+// ToServiceProviderCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToServiceProviderCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "service_provider")
+}
+
+// Create registers a new service provider with the given ID.
+func Create(ctx context.Context, client *gophercloud.ServiceClient, spID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToServiceProviderCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(ctx, resourceURL(client, spID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves details on a single service provider, by ID.
+func Get(ctx context.Context, client *gophercloud.ServiceClient, spID string) (r GetResult) {
+	resp, err := client.Get(ctx, resourceURL(client, spID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateOptsBuilder interface {
+	ToServiceProviderUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts provides options used to update a service provider.
+type UpdateOpts struct {
+	// AuthURL is the URL to authenticate against the service provider.
+	AuthURL string `json:"auth_url,omitempty"`
+
+	// SPURL is the URL of the SAML2 ECP endpoint of the service provider.
+	SPURL string `json:"sp_url,omitempty"`
+
+	// Description is the description of the service provider.
+	Description *string `json:"description,omitempty"`
+
+	// Enabled is whether or not the service provider is enabled.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// RelayStatePrefix is the prefix of the RelayState SAML attribute.
+	RelayStatePrefix *string `json:"relay_state_prefix,omitempty"`
+}
+
+// ToServiceProviderUpdateMap formats an UpdateOpts into an update request.
+func (opts UpdateOpts) ToServiceProviderUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "service_provider")
+}
+
+// Update modifies the attributes of a service provider.
+func Update(ctx context.Context, client *gophercloud.ServiceClient, spID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToServiceProviderUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(ctx, resourceURL(client, spID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete deletes a service provider.
+func Delete(ctx context.Context, client *gophercloud.ServiceClient, spID string) (r DeleteResult) {
+	resp, err := client.Delete(ctx, resourceURL(client, spID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ECPAssertionOptsBuilder allows extensions to add additional parameters to
+// the GetECPAssertion request.
+type ECPAssertionOptsBuilder interface {
+	ToECPAssertionMap() (map[string]any, error)
+}
+
+// ECPAssertionOpts provides options used to request an ECP wrapped SAML
+// assertion for a service provider.
+type ECPAssertionOpts struct {
+	// TokenID is the token the assertion is generated for.
+	TokenID string `json:"-"`
+
+	// ServiceProviderID is the ID of the service provider the assertion is
+	// issued to.
+	ServiceProviderID string `json:"-"`
+}
+
+// ToECPAssertionMap formats an ECPAssertionOpts into a request body.
+func (opts ECPAssertionOpts) ToECPAssertionMap() (map[string]any, error) {
+	if opts.TokenID == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "TokenID"}
+	}
+	if opts.ServiceProviderID == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "ServiceProviderID"}
+	}
+
+	return map[string]any{
+		"auth": map[string]any{
+			"identity": map[string]any{
+				"methods": []string{"token"},
+				"token": map[string]any{
+					"id": opts.TokenID,
+				},
+			},
+			"scope": map[string]any{
+				"service_provider": map[string]any{
+					"id": opts.ServiceProviderID,
+				},
+			},
+		},
+	}, nil
+}
+
+// GetECPAssertion requests a SAML assertion wrapped in an ECP envelope, which
+// can be posted to the SPURL of the service provider to obtain a token from
+// it. The URLs of the service provider are returned in the X-Sp-Url and
+// X-Auth-Url headers of the result. The assertion is requested as text/xml.
+func GetECPAssertion(ctx context.Context, client *gophercloud.ServiceClient, opts ECPAssertionOptsBuilder) (r ECPAssertionResult) {
+	b, err := opts.ToECPAssertionMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, ecpAssertionURL(client), b, nil, &gophercloud.RequestOpts{
+		OkCodes:          []int{200},
+		MoreHeaders:      map[string]string{"Accept": "text/xml"},
+		KeepResponseBody: true,
+	})
+	r.Body, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,127 @@
+package serviceproviders
+
+import (
+	"io"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// ServiceProvider is a remote Keystone trusting this one as an identity
+// provider (Keystone to Keystone federation).
+type ServiceProvider struct {
+	// ID is the unique ID of the service provider.
+	ID string `json:"id"`
+
+	// AuthURL is the URL to authenticate against the service provider.
+	AuthURL string `json:"auth_url"`
+
+	// SPURL is the URL of the SAML2 ECP endpoint of the service provider.
+	SPURL string `json:"sp_url"`
+
+	// Description is the description of the service provider.
+	Description string `json:"description"`
+
+	// Enabled is whether or not the service provider is enabled.
+	Enabled bool `json:"enabled"`
+
+	// RelayStatePrefix is the prefix of the RelayState SAML attribute.
+	RelayStatePrefix string `json:"relay_state_prefix"`
+
+	// Links contains referencing links to the service provider.
+	Links map[string]any `json:"links"`
+}
+
+type serviceProviderResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any serviceProviderResult as a ServiceProvider.
+func (r serviceProviderResult) Extract() (*ServiceProvider, error) {
+	var s struct {
+		ServiceProvider *ServiceProvider `json:"service_provider"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ServiceProvider, err
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a ServiceProvider.
+type CreateResult struct {
+	serviceProviderResult
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a ServiceProvider.
+type GetResult struct {
+	serviceProviderResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a ServiceProvider.
+type UpdateResult struct {
+	serviceProviderResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ServiceProviderPage is a single page of ServiceProvider results.
+type ServiceProviderPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a ServiceProviderPage contains any
+// results.
+func (r ServiceProviderPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	serviceProviders, err := ExtractServiceProviders(r)
+	return len(serviceProviders) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r ServiceProviderPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return s.Links.Next, err
+}
+
+// ExtractServiceProviders returns a slice of ServiceProviders contained in a
+// single page of results.
+func ExtractServiceProviders(r pagination.Page) ([]ServiceProvider, error) {
+	var s struct {
+		ServiceProviders []ServiceProvider `json:"service_providers"`
+	}
+	err := (r.(ServiceProviderPage)).ExtractInto(&s)
+	return s.ServiceProviders, err
+}
+
+// ECPAssertionResult is the response from a GetECPAssertion operation. Call
+// its Extract method to read the ECP wrapped SAML assertion.
+type ECPAssertionResult struct {
+	gophercloud.Result
+	Body io.ReadCloser
+}
+
+// Extract reads the ECP wrapped SAML assertion from the response body.
+func (r ECPAssertionResult) Extract() ([]byte, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	defer r.Body.Close()
+	return io.ReadAll(r.Body)
+}
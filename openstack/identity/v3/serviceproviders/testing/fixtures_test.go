@@ -0,0 +1,191 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/serviceproviders"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+// ListOutput provides a single page of ServiceProvider results.
+const ListOutput = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/OS-FEDERATION/service_providers"
+    },
+    "service_providers": [
+        {
+            "auth_url": "https://sp1.example.com/identity/v3/OS-FEDERATION/identity_providers/acme/protocols/saml2/auth",
+            "description": "Stores ACME identities",
+            "enabled": true,
+            "id": "sp1",
+            "links": {
+                "self": "http://example.com/identity/v3/OS-FEDERATION/service_providers/sp1"
+            },
+            "relay_state_prefix": "ss:mem:",
+            "sp_url": "https://sp1.example.com/Shibboleth.sso/SAML2/ECP"
+        }
+    ]
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+    "service_provider": {
+        "auth_url": "https://sp1.example.com/identity/v3/OS-FEDERATION/identity_providers/acme/protocols/saml2/auth",
+        "description": "Stores ACME identities",
+        "enabled": true,
+        "id": "sp1",
+        "links": {
+            "self": "http://example.com/identity/v3/OS-FEDERATION/service_providers/sp1"
+        },
+        "relay_state_prefix": "ss:mem:",
+        "sp_url": "https://sp1.example.com/Shibboleth.sso/SAML2/ECP"
+    }
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+    "service_provider": {
+        "auth_url": "https://sp1.example.com/identity/v3/OS-FEDERATION/identity_providers/acme/protocols/saml2/auth",
+        "description": "Stores ACME identities",
+        "enabled": true,
+        "relay_state_prefix": "ss:mem:",
+        "sp_url": "https://sp1.example.com/Shibboleth.sso/SAML2/ECP"
+    }
+}
+`
+
+// UpdateRequest provides the input to an Update request.
+const UpdateRequest = `
+{
+    "service_provider": {
+        "enabled": false
+    }
+}
+`
+
+// UpdateOutput provides an Update result.
+const UpdateOutput = `
+{
+    "service_provider": {
+        "auth_url": "https://sp1.example.com/identity/v3/OS-FEDERATION/identity_providers/acme/protocols/saml2/auth",
+        "description": "Stores ACME identities",
+        "enabled": false,
+        "id": "sp1",
+        "links": {
+            "self": "http://example.com/identity/v3/OS-FEDERATION/service_providers/sp1"
+        },
+        "relay_state_prefix": "ss:mem:",
+        "sp_url": "https://sp1.example.com/Shibboleth.sso/SAML2/ECP"
+    }
+}
+`
+
+// ECPAssertionRequest provides the input to a GetECPAssertion request.
+const ECPAssertionRequest = `
+{
+    "auth": {
+        "identity": {
+            "methods": ["token"],
+            "token": {
+                "id": "cbc36478b0bd8e67e89469c7749d4127"
+            }
+        },
+        "scope": {
+            "service_provider": {
+                "id": "sp1"
+            }
+        }
+    }
+}
+`
+
+// ECPAssertionOutput provides a GetECPAssertion result.
+const ECPAssertionOutput = `<soap11:Envelope xmlns:soap11="http://schemas.xmlsoap.org/soap/envelope/"><soap11:Body><samlp:Response/></soap11:Body></soap11:Envelope>`
+
+// FirstServiceProvider is the first service provider in the List request.
+var FirstServiceProvider = serviceproviders.ServiceProvider{
+	ID:               "sp1",
+	AuthURL:          "https://sp1.example.com/identity/v3/OS-FEDERATION/identity_providers/acme/protocols/saml2/auth",
+	SPURL:            "https://sp1.example.com/Shibboleth.sso/SAML2/ECP",
+	Description:      "Stores ACME identities",
+	Enabled:          true,
+	RelayStatePrefix: "ss:mem:",
+	Links: map[string]any{
+		"self": "http://example.com/identity/v3/OS-FEDERATION/service_providers/sp1",
+	},
+}
+
+// ExpectedServiceProvidersSlice is the slice of service providers expected to
+// be returned from ListOutput.
+var ExpectedServiceProvidersSlice = []serviceproviders.ServiceProvider{FirstServiceProvider}
+
+// HandleListServiceProvidersSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/service_providers` on the test handler mux that responds
+// with a list of service providers.
+func HandleListServiceProvidersSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/service_providers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListOutput)
+	})
+}
+
+// HandleServiceProviderSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/service_providers/sp1` on the test handler mux that tests
+// service provider creation, retrieval, update and deletion.
+func HandleServiceProviderSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/service_providers/sp1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "PUT":
+			th.TestJSONRequest(t, r, CreateRequest)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, GetOutput)
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, GetOutput)
+		case "PATCH":
+			th.TestJSONRequest(t, r, UpdateRequest)
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, UpdateOutput)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleGetECPAssertionSuccessfully creates an HTTP handler at
+// `/auth/OS-FEDERATION/saml2/ecp` on the test handler mux that responds with
+// an ECP wrapped SAML assertion.
+func HandleGetECPAssertionSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/auth/OS-FEDERATION/saml2/ecp", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "text/xml")
+		th.TestJSONRequest(t, r, ECPAssertionRequest)
+
+		w.Header().Set("Content-Type", "text/xml")
+		w.Header().Set("X-Sp-Url", FirstServiceProvider.SPURL)
+		w.Header().Set("X-Auth-Url", FirstServiceProvider.AuthURL)
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ECPAssertionOutput)
+	})
+}
@@ -0,0 +1,131 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/serviceproviders"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestListServiceProviders(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListServiceProvidersSuccessfully(t)
+
+	allPages, err := serviceproviders.List(client.ServiceClient(), nil).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := serviceproviders.ExtractServiceProviders(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedServiceProvidersSlice, actual)
+}
+
+func TestListServiceProvidersQuery(t *testing.T) {
+	enabled := true
+	actual, err := serviceproviders.ListOpts{ID: "sp1", Enabled: &enabled}.ToServiceProviderListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "?enabled=true&id=sp1", actual)
+}
+
+func TestCreateServiceProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServiceProviderSuccessfully(t)
+
+	createOpts := serviceproviders.CreateOpts{
+		AuthURL:          FirstServiceProvider.AuthURL,
+		SPURL:            FirstServiceProvider.SPURL,
+		Description:      "Stores ACME identities",
+		Enabled:          gophercloud.Enabled,
+		RelayStatePrefix: "ss:mem:",
+	}
+
+	actual, err := serviceproviders.Create(context.TODO(), client.ServiceClient(), "sp1", createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstServiceProvider, *actual)
+}
+
+func TestCreateServiceProviderMissingURL(t *testing.T) {
+	_, err := serviceproviders.Create(context.TODO(), client.ServiceClient(), "sp1", serviceproviders.CreateOpts{
+		AuthURL: FirstServiceProvider.AuthURL,
+	}).Extract()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("expected ErrMissingInput, got %v", err)
+	}
+}
+
+func TestGetServiceProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServiceProviderSuccessfully(t)
+
+	actual, err := serviceproviders.Get(context.TODO(), client.ServiceClient(), "sp1").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstServiceProvider, *actual)
+}
+
+func TestUpdateServiceProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServiceProviderSuccessfully(t)
+
+	updateOpts := serviceproviders.UpdateOpts{
+		Enabled: gophercloud.Disabled,
+	}
+
+	actual, err := serviceproviders.Update(context.TODO(), client.ServiceClient(), "sp1", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, false, actual.Enabled)
+}
+
+func TestDeleteServiceProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServiceProviderSuccessfully(t)
+
+	err := serviceproviders.Delete(context.TODO(), client.ServiceClient(), "sp1").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestGetECPAssertion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetECPAssertionSuccessfully(t)
+
+	res := serviceproviders.GetECPAssertion(context.TODO(), client.ServiceClient(), serviceproviders.ECPAssertionOpts{
+		TokenID:           client.TokenID,
+		ServiceProviderID: "sp1",
+	})
+	th.AssertNoErr(t, res.Err)
+	th.AssertEquals(t, FirstServiceProvider.SPURL, res.Header.Get("X-Sp-Url"))
+
+	actual, err := res.Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, ECPAssertionOutput, string(actual))
+}
+
+func TestGetECPAssertionMissingInput(t *testing.T) {
+	tests := []struct {
+		opts     serviceproviders.ECPAssertionOpts
+		argument string
+	}{
+		{serviceproviders.ECPAssertionOpts{TokenID: client.TokenID}, "ServiceProviderID"},
+		{serviceproviders.ECPAssertionOpts{ServiceProviderID: "ACME"}, "TokenID"},
+	}
+
+	for _, test := range tests {
+		res := serviceproviders.GetECPAssertion(context.TODO(), client.ServiceClient(), test.opts)
+		missing, ok := res.Err.(gophercloud.ErrMissingInput)
+		if !ok {
+			t.Fatalf("expected ErrMissingInput, got %v", res.Err)
+		}
+		th.AssertEquals(t, test.argument, missing.Argument)
+	}
+}
+
+func TestServiceProviderSchema(t *testing.T) {
+	th.AssertJSONSchema(t, GetOutput, "identity/v3/service_provider.json")
+	th.AssertJSONSchema(t, UpdateOutput, "identity/v3/service_provider.json")
+}
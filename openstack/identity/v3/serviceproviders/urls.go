@@ -0,0 +1,20 @@
+package serviceproviders
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+const (
+	rootPath             = "OS-FEDERATION"
+	serviceProvidersPath = "service_providers"
+)
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, serviceProvidersPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, spID string) string {
+	return c.ServiceURL(rootPath, serviceProvidersPath, spID)
+}
+
+func ecpAssertionURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("auth", rootPath, "saml2", "ecp")
+}
@@ -60,5 +60,40 @@ Example to List a Trust
 	for _, trust := range allTrusts {
 		fmt.Printf("%+v\n", region)
 	}
+
+Example to Impersonate a Trustor
+
+	impersonateOpts := trusts.ImpersonateOpts{
+	    Trust: trusts.CreateOpts{
+	        Impersonation: true,
+	        ProjectID:     "9b71012f5a4a4aef9193f1995fe159b2",
+	        Roles: []trusts.Role{
+	            {
+	                Name: "member",
+	            },
+	        },
+	        TrusteeUserID: "ecb37e88cc86431c99d0332208cb6fbf",
+	        TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+	    },
+	    TrusteeAuthOptions: gophercloud.AuthOptions{
+	        UserID:   "ecb37e88cc86431c99d0332208cb6fbf",
+	        Password: "secret",
+	    },
+	    Authenticate: openstack.AuthenticatedClient,
+	}
+
+	provider, trust, err := trusts.Impersonate(context.TODO(), identityClient, impersonateOpts)
+	if err != nil {
+	    panic(err)
+	}
+
+	fmt.Printf("Trust: %+v\n", trust)
+
+	computeClient, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{
+	    Region: "RegionOne",
+	})
+	if err != nil {
+	    panic(err)
+	}
 */
 package trusts
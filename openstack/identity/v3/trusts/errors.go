@@ -0,0 +1,35 @@
+package trusts
+
+import (
+	"fmt"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// ErrImpersonation is returned by Impersonate when the trustee cannot be
+// authenticated with the created trust.
+type ErrImpersonation struct {
+	gophercloud.BaseError
+
+	// TrustID is the ID of the created trust.
+	TrustID string
+
+	// Err is the authentication error.
+	Err error
+
+	// DeleteErr is the error which occurred while deleting the trust, if any.
+	// When set, the trust has to be deleted manually.
+	DeleteErr error
+}
+
+func (e ErrImpersonation) Error() string {
+	if e.DeleteErr != nil {
+		return fmt.Sprintf("Unable to authenticate with trust [%s]: %s; unable to delete the trust: %s", e.TrustID, e.Err, e.DeleteErr)
+	}
+	return fmt.Sprintf("Unable to authenticate with trust [%s]: %s", e.TrustID, e.Err)
+}
+
+// Unwrap returns the authentication error.
+func (e ErrImpersonation) Unwrap() error {
+	return e.Err
+}
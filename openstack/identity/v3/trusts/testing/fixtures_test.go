@@ -360,3 +360,44 @@ func HandleCheckTrustRoleSuccessfully(t *testing.T) {
 		w.WriteHeader(http.StatusOK)
 	})
 }
+
+const TrustScopedAuthRequest = `
+{
+    "auth": {
+        "identity": {
+            "methods": ["password"],
+            "password": {
+                "user": {
+                    "id": "ecb37e88cc86431c99d0332208cb6fbf",
+                    "password": "secret"
+                }
+            }
+        },
+        "scope": {
+            "OS-TRUST:trust": {
+                "id": "3422b7c113894f5d90665e1a79655e23"
+            }
+        }
+    }
+}
+`
+
+// HandleTrustScopedAuth creates an HTTP handler at `/v3/auth/tokens` on the
+// test handler mux that tests the authentication of the trustee with the
+// created trust, and responds with the given status code.
+func HandleTrustScopedAuth(t *testing.T, status int) {
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, TrustScopedAuthRequest)
+
+		if status != http.StatusCreated {
+			w.WriteHeader(status)
+			return
+		}
+
+		w.Header().Add("X-Subject-Token", "trust-scoped-token")
+		w.WriteHeader(http.StatusCreated)
+		_, err := fmt.Fprint(w, `{"token": {"expires_at": "2019-12-01T14:00:00.000000Z", "OS-TRUST:trust": {"id": "3422b7c113894f5d90665e1a79655e23"}}}`)
+		th.AssertNoErr(t, err)
+	})
+}
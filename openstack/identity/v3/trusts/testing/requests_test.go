@@ -2,9 +2,13 @@ package testing
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/trusts"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
@@ -170,3 +174,131 @@ func TestCheckTrustRole(t *testing.T) {
 	err := trusts.CheckRole(context.TODO(), client.ServiceClient(), "987fe8", "c1648e").ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+// authenticateV3 authenticates against the identity v3 API of the test
+// server, without version discovery.
+func authenticateV3(ctx context.Context, options gophercloud.AuthOptions) (*gophercloud.ProviderClient, error) {
+	provider, err := openstack.NewClient(options.IdentityEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	if err := openstack.AuthenticateV3(ctx, provider, &options, gophercloud.EndpointOpts{}); err != nil {
+		return nil, err
+	}
+	return provider, nil
+}
+
+func TestImpersonate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateTrust(t)
+	HandleTrustScopedAuth(t, http.StatusCreated)
+
+	expiresAt := time.Date(2019, 12, 1, 14, 0, 0, 0, time.UTC)
+	provider, trust, err := trusts.Impersonate(context.TODO(), client.ServiceClient(), trusts.ImpersonateOpts{
+		Trust: trusts.CreateOpts{
+			ExpiresAt:         &expiresAt,
+			AllowRedelegation: true,
+			ProjectID:         "9b71012f5a4a4aef9193f1995fe159b2",
+			Roles: []trusts.Role{
+				{
+					Name: "member",
+				},
+			},
+			TrusteeUserID: "ecb37e88cc86431c99d0332208cb6fbf",
+			TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+		},
+		TrusteeAuthOptions: gophercloud.AuthOptions{
+			IdentityEndpoint: th.Endpoint(),
+			UserID:           "ecb37e88cc86431c99d0332208cb6fbf",
+			Password:         "secret",
+			TenantID:         "9b71012f5a4a4aef9193f1995fe159b2",
+		},
+		Authenticate: authenticateV3,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, CreatedTrust, *trust)
+	th.AssertEquals(t, "trust-scoped-token", provider.Token())
+}
+
+func TestImpersonateAuthFailure(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateTrust(t)
+	HandleTrustScopedAuth(t, http.StatusUnauthorized)
+	HandleDeleteTrust(t)
+
+	expiresAt := time.Date(2019, 12, 1, 14, 0, 0, 0, time.UTC)
+	_, _, err := trusts.Impersonate(context.TODO(), client.ServiceClient(), trusts.ImpersonateOpts{
+		Trust: trusts.CreateOpts{
+			ExpiresAt:         &expiresAt,
+			AllowRedelegation: true,
+			ProjectID:         "9b71012f5a4a4aef9193f1995fe159b2",
+			Roles: []trusts.Role{
+				{
+					Name: "member",
+				},
+			},
+			TrusteeUserID: "ecb37e88cc86431c99d0332208cb6fbf",
+			TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+		},
+		TrusteeAuthOptions: gophercloud.AuthOptions{
+			IdentityEndpoint: th.Endpoint(),
+			UserID:           "ecb37e88cc86431c99d0332208cb6fbf",
+			Password:         "secret",
+		},
+		Authenticate: authenticateV3,
+	})
+
+	var impersonationErr trusts.ErrImpersonation
+	if !errors.As(err, &impersonationErr) {
+		t.Fatalf("Expected ErrImpersonation, got %v", err)
+	}
+	th.AssertEquals(t, "3422b7c113894f5d90665e1a79655e23", impersonationErr.TrustID)
+	th.AssertNoErr(t, impersonationErr.DeleteErr)
+	th.AssertEquals(t, true, gophercloud.ResponseCodeIs(err, http.StatusUnauthorized))
+}
+
+func TestImpersonateAuthFailureCancelled(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateTrust(t)
+	HandleDeleteTrust(t)
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	expiresAt := time.Date(2019, 12, 1, 14, 0, 0, 0, time.UTC)
+	_, _, err := trusts.Impersonate(ctx, client.ServiceClient(), trusts.ImpersonateOpts{
+		Trust: trusts.CreateOpts{
+			ExpiresAt:         &expiresAt,
+			AllowRedelegation: true,
+			ProjectID:         "9b71012f5a4a4aef9193f1995fe159b2",
+			Roles: []trusts.Role{
+				{
+					Name: "member",
+				},
+			},
+			TrusteeUserID: "ecb37e88cc86431c99d0332208cb6fbf",
+			TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+		},
+		Authenticate: func(context.Context, gophercloud.AuthOptions) (*gophercloud.ProviderClient, error) {
+			cancel()
+			return nil, context.Canceled
+		},
+	})
+
+	var impersonationErr trusts.ErrImpersonation
+	if !errors.As(err, &impersonationErr) {
+		t.Fatalf("Expected ErrImpersonation, got %v", err)
+	}
+	th.AssertNoErr(t, impersonationErr.DeleteErr)
+	th.AssertEquals(t, context.Canceled, impersonationErr.Err)
+}
+
+func TestImpersonateWithoutAuthenticate(t *testing.T) {
+	_, _, err := trusts.Impersonate(context.TODO(), client.ServiceClient(), trusts.ImpersonateOpts{})
+	var missing gophercloud.ErrMissingInput
+	th.AssertEquals(t, true, errors.As(err, &missing))
+	th.AssertEquals(t, "Authenticate", missing.Argument)
+}
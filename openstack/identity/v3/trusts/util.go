@@ -0,0 +1,61 @@
+package trusts
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// ImpersonateOpts provides options to Impersonate.
+type ImpersonateOpts struct {
+	// Trust holds the options used to create the trust. Its TrusteeUserID
+	// must be the user authenticated by TrusteeAuthOptions.
+	Trust CreateOpts
+
+	// TrusteeAuthOptions holds the credentials of the trustee, typically a
+	// service user. Its scope is replaced by the created trust. If its
+	// IdentityEndpoint is empty, the identity endpoint of the client is used.
+	TrusteeAuthOptions gophercloud.AuthOptions
+
+	// Authenticate authenticates the trustee with the trust-scoped
+	// TrusteeAuthOptions and returns the resulting ProviderClient. It is
+	// typically openstack.AuthenticatedClient.
+	Authenticate func(ctx context.Context, options gophercloud.AuthOptions) (*gophercloud.ProviderClient, error)
+}
+
+// Impersonate creates a trust on behalf of the trustor, then authenticates the
+// trustee with a token scoped to that trust. It returns a ProviderClient ready
+// to act on behalf of the trustor, along with the created trust.
+//
+// If the trustee cannot be authenticated, the trust is deleted and an
+// ErrImpersonation error is returned. The trust is deleted even if ctx is
+// cancelled.
+func Impersonate(ctx context.Context, client *gophercloud.ServiceClient, opts ImpersonateOpts) (*gophercloud.ProviderClient, *Trust, error) {
+	if opts.Authenticate == nil {
+		return nil, nil, gophercloud.ErrMissingInput{Argument: "Authenticate"}
+	}
+
+	trust, err := Create(ctx, client, opts.Trust).Extract()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	authOpts := opts.TrusteeAuthOptions
+	authOpts.TenantID = ""
+	authOpts.TenantName = ""
+	authOpts.Scope = &gophercloud.AuthScope{TrustID: trust.ID}
+	if authOpts.IdentityEndpoint == "" {
+		authOpts.IdentityEndpoint = client.IdentityEndpoint
+	}
+
+	provider, err := opts.Authenticate(ctx, authOpts)
+	if err != nil {
+		return nil, nil, ErrImpersonation{
+			TrustID:   trust.ID,
+			Err:       err,
+			DeleteErr: Delete(context.WithoutCancel(ctx), client, trust.ID).ExtractErr(),
+		}
+	}
+
+	return provider, trust, nil
+}
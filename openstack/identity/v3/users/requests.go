@@ -342,3 +342,22 @@ func ListInGroup(client *gophercloud.ServiceClient, groupID string, opts ListOpt
 		return UserPage{pagination.LinkedPageBase{PageResult: r}}
 	})
 }
+
+// IDFromName is a convenience function that returns a user's ID given its
+// name. It returns a gophercloud.ErrResourceNotFound if no user matches the
+// name, and a gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	pages, err := List(client, ListOpts{Name: name}).AllPages(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	all, err := ExtractUsers(pages)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.IDFromName(all, name, "user", func(r User) (string, string) {
+		return r.Name, r.ID
+	})
+}
@@ -4,7 +4,7 @@ Image Service.
 
 Example to List Images
 
-	images.ListOpts{
+	listOpts := images.ListOpts{
 		Owner: "a7509e1ae65945fda83f3e52c6296017",
 	}
 
@@ -22,6 +22,57 @@ Example to List Images
 		fmt.Printf("%+v\n", image)
 	}
 
+Example to List active or queued Images created since a given date
+
+	listOpts := images.ListOpts{
+		Statuses: []images.ImageStatus{images.ImageStatusActive, images.ImageStatusQueued},
+		CreatedAtQuery: &images.ImageDateQuery{
+			Date:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			Filter: images.FilterGTE,
+		},
+	}
+
+	allPages, err := images.List(imagesClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Images sorted by name, then by newest
+
+	listOpts := images.ListOpts{
+		Sorts: []images.ImageSort{
+			{Key: "name", Dir: images.SortAsc},
+			{Key: "created_at", Dir: images.SortDesc},
+		},
+		Limit: 20,
+	}
+
+	err := images.List(imagesClient, listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		pageImages, err := images.ExtractImages(page)
+		if err != nil {
+			return false, err
+		}
+
+		for _, image := range pageImages {
+			fmt.Printf("%+v\n", image)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+Example to Count Images
+
+	count, err := images.Count(context.TODO(), imagesClient, images.ListOpts{
+		Owner: "a7509e1ae65945fda83f3e52c6296017",
+		Limit: 1000,
+	})
+	if err != nil {
+		panic(err)
+	}
+
 Example to Create an Image
 
 	createOpts := images.CreateOpts{
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
@@ -56,6 +57,13 @@ type ListOpts struct {
 	// such as "in:saving,queued".
 	Status ImageStatus `q:"status"`
 
+	// Statuses filters on any of the given statuses. It is encoded as an
+	// "in:" query and cannot be combined with Status.
+	Statuses []ImageStatus
+
+	// Protected filters on the protected status of the image.
+	Protected *bool `q:"protected"`
+
 	// SizeMin filters on the size_min image property.
 	SizeMin int64 `q:"size_min"`
 
@@ -68,6 +76,12 @@ type ListOpts struct {
 	// Sort cannot be used with the classic sort options (sort_key and sort_dir).
 	Sort string `q:"sort"`
 
+	// Sorts sorts the results by several image properties, using the new
+	// style of sorting. It is encoded as "name:asc,created_at:desc".
+	//
+	// Sorts cannot be used with Sort, or with the classic sort options.
+	Sorts []ImageSort
+
 	// SortKey will sort the results based on a specified image property.
 	SortKey string `q:"sort_key"`
 
@@ -97,8 +111,69 @@ type ListOpts struct {
 // ToImageListQuery formats a ListOpts into a query string.
 func (opts ListOpts) ToImageListQuery() (string, error) {
 	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
 	params := q.Query()
 
+	if len(opts.Statuses) > 0 {
+		if opts.Status != "" {
+			err := gophercloud.ErrInvalidInput{}
+			err.Argument = "images.ListOpts.Statuses"
+			err.Info = "Status and Statuses are mutually exclusive"
+			return "", err
+		}
+
+		statuses := make([]string, len(opts.Statuses))
+		for i, v := range opts.Statuses {
+			statuses[i] = string(v)
+		}
+		params.Add("status", "in:"+strings.Join(statuses, ","))
+	}
+
+	if opts.Sort != "" || len(opts.Sorts) > 0 {
+		if opts.SortKey != "" || opts.SortDir != "" {
+			err := gophercloud.ErrInvalidInput{}
+			err.Argument = "images.ListOpts.Sort"
+			err.Info = "Sort and Sorts cannot be used with SortKey and SortDir"
+			return "", err
+		}
+	}
+
+	if len(opts.Sorts) > 0 {
+		if opts.Sort != "" {
+			err := gophercloud.ErrInvalidInput{}
+			err.Argument = "images.ListOpts.Sorts"
+			err.Info = "Sort and Sorts are mutually exclusive"
+			return "", err
+		}
+
+		sorts := make([]string, len(opts.Sorts))
+		for i, v := range opts.Sorts {
+			if v.Key == "" || strings.ContainsAny(v.Key, ":,") {
+				err := gophercloud.ErrInvalidInput{}
+				err.Argument = "images.ListOpts.Sorts"
+				err.Value = v.Key
+				err.Info = "sort keys must not be empty or contain ':' or ','"
+				return "", err
+			}
+
+			switch v.Dir {
+			case "":
+				sorts[i] = v.Key
+			case SortAsc, SortDesc:
+				sorts[i] = v.Key + ":" + string(v.Dir)
+			default:
+				err := gophercloud.ErrInvalidInput{}
+				err.Argument = "images.ListOpts.Sorts"
+				err.Value = v.Dir
+				err.Info = "sort directions must be asc or desc"
+				return "", err
+			}
+		}
+		params.Add("sort", strings.Join(sorts, ","))
+	}
+
 	if opts.CreatedAtQuery != nil {
 		createdAt := opts.CreatedAtQuery.Date.Format(time.RFC3339)
 		if v := opts.CreatedAtQuery.Filter; v != "" {
@@ -119,7 +194,7 @@ func (opts ListOpts) ToImageListQuery() (string, error) {
 
 	q = &url.URL{RawQuery: params.Encode()}
 
-	return q.String(), err
+	return q.String(), nil
 }
 
 // List implements image list request.
@@ -417,3 +492,43 @@ func (r UpdateImageProperty) ToImagePatchMap() map[string]any {
 
 	return updateMap
 }
+
+// IDFromName is a convenience function that returns a image's ID given its
+// name. It returns a gophercloud.ErrResourceNotFound if no image matches the
+// name, and a gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	pages, err := List(client, ListOpts{Name: name}).AllPages(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	all, err := ExtractImages(pages)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.IDFromName(all, name, "image", func(r Image) (string, string) {
+		return r.Name, r.ID
+	})
+}
+
+// Count returns the number of images matching the given options. The Image
+// service does not return a total, so Count follows the pages of the listing
+// using their markers, which requires one request per page. Set a larger
+// Limit in the options to reduce the number of requests.
+func Count(ctx context.Context, client *gophercloud.ServiceClient, opts ListOptsBuilder) (int, error) {
+	count := 0
+	err := List(client, opts).EachPage(ctx, func(_ context.Context, page pagination.Page) (bool, error) {
+		var s struct {
+			Images []struct {
+				ID string `json:"id"`
+			} `json:"images"`
+		}
+		if err := page.(ImagePage).ExtractInto(&s); err != nil {
+			return false, err
+		}
+		count += len(s.Images)
+		return true, nil
+	})
+	return count, err
+}
@@ -230,6 +230,24 @@ func (r ImagePage) NextPageURL() (string, error) {
 	return nextPageURL(r.serviceURL, s.Next)
 }
 
+// FirstPageURL uses the response's embedded link reference to build the URL
+// of the first page of results, for example to restart a listing.
+func (r ImagePage) FirstPageURL() (string, error) {
+	var s struct {
+		First string `json:"first"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+
+	if s.First == "" {
+		return "", nil
+	}
+
+	return nextPageURL(r.serviceURL, s.First)
+}
+
 // ExtractImages interprets the results of a single page from a List() call,
 // producing a slice of Image entities.
 func ExtractImages(r pagination.Page) ([]Image, error) {
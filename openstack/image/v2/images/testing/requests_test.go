@@ -2,9 +2,12 @@ package testing
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/image/v2/images"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
@@ -57,6 +60,35 @@ func TestAllPagesImage(t *testing.T) {
 	th.AssertEquals(t, 3, len(images))
 }
 
+func TestCountImages(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleImageListSuccessfully(t)
+
+	count, err := images.Count(context.TODO(), fakeclient.ServiceClient(), images.ListOpts{Limit: 2})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, count)
+}
+
+func TestImagePageFirstPageURL(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleImageListSuccessfully(t)
+
+	pages := 0
+	err := images.List(fakeclient.ServiceClient(), images.ListOpts{Limit: 1}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		pages++
+		first, err := page.(images.ImagePage).FirstPageURL()
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, th.Endpoint()+"images?limit=1", first)
+		return false, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, pages)
+}
+
 func TestCreateImage(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -341,6 +373,55 @@ func TestImageDateQuery(t *testing.T) {
 	th.AssertEquals(t, expectedQueryString, actualQueryString)
 }
 
+func TestImageListMultipleStatusesQuery(t *testing.T) {
+	protected := true
+	listOpts := images.ListOpts{
+		Statuses:     []images.ImageStatus{images.ImageStatusActive, images.ImageStatusQueued},
+		Protected:    &protected,
+		MemberStatus: images.ImageMemberStatusAccepted,
+	}
+
+	expectedQueryString := "?member_status=accepted&protected=true&status=in%3Aactive%2Cqueued"
+	actualQueryString, err := listOpts.ToImageListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, expectedQueryString, actualQueryString)
+
+	listOpts.Status = images.ImageStatusActive
+	_, err = listOpts.ToImageListQuery()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestImageListSortsQuery(t *testing.T) {
+	listOpts := images.ListOpts{
+		Sorts: []images.ImageSort{
+			{Key: "name", Dir: images.SortAsc},
+			{Key: "created_at", Dir: images.SortDesc},
+			{Key: "id"},
+		},
+	}
+
+	expectedQueryString := "?sort=name%3Aasc%2Ccreated_at%3Adesc%2Cid"
+	actualQueryString, err := listOpts.ToImageListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, expectedQueryString, actualQueryString)
+
+	for _, invalid := range []images.ListOpts{
+		{Sorts: listOpts.Sorts, Sort: "name:asc"},
+		{Sorts: listOpts.Sorts, SortKey: "name"},
+		{Sort: "name:asc", SortDir: "asc"},
+		{Sorts: []images.ImageSort{{Key: "name", Dir: "up"}}},
+		{Sorts: []images.ImageSort{{Key: "name:asc"}}},
+		{Sorts: []images.ImageSort{{Dir: images.SortAsc}}},
+	} {
+		_, err = invalid.ToImageListQuery()
+		if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+			t.Fatalf("expected ErrInvalidInput for %+v, got %v", invalid, err)
+		}
+	}
+}
+
 func TestImageListByTags(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -476,3 +557,29 @@ func TestUpdateImageProperties(t *testing.T) {
 
 	th.AssertDeepEquals(t, &expectedImage, actualImage)
 }
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/images", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, `
+{
+	"images": [
+		{"id": "2bc7a2a9-7c2a-4a8c-9f0e-5d4b7a2b1c01", "name": "image-1"},
+		{"id": "7f3c8e1d-51b4-4a8e-8a1e-3d9c0e6f4b02", "name": "image-2"},
+		{"id": "c4d1f0e2-9b7a-4f6c-8d3e-1a2b5c6d7e03", "name": "image-2"}
+	]
+}`)
+	})
+
+	id, err := images.IDFromName(context.TODO(), fakeclient.ServiceClient(), "image-1")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2bc7a2a9-7c2a-4a8c-9f0e-5d4b7a2b1c01", id)
+}
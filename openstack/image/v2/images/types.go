@@ -106,3 +106,18 @@ type ImageDateQuery struct {
 	Date   time.Time
 	Filter ImageDateFilter
 }
+
+// ImageSortDir is the direction of an ImageSort.
+type ImageSortDir string
+
+const (
+	SortAsc  ImageSortDir = "asc"
+	SortDesc ImageSortDir = "desc"
+)
+
+// ImageSort represents a sort key to be used for listing images. If no
+// direction is specified, the Image service sorts in descending order.
+type ImageSort struct {
+	Key string
+	Dir ImageSortDir
+}
@@ -38,6 +38,32 @@ Example to Create a Container
 
 	fmt.Printf("%v\n", container)
 
+Example to Create a Certificate Container
+
+	createOpts := containers.CertificateContainerOpts{
+		Name:        "mycertificate",
+		Certificate: certificateSecret.SecretRef,
+		PrivateKey:  privateKeySecret.SecretRef,
+	}
+
+	container, err := containers.Create(context.TODO(), client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create an RSA Container
+
+	createOpts := containers.RSAContainerOpts{
+		Name:       "myrsa",
+		PublicKey:  publicKeySecret.SecretRef,
+		PrivateKey: privateKeySecret.SecretRef,
+	}
+
+	container, err := containers.Create(context.TODO(), client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Delete a Container
 
 	err := containers.Delete(context.TODO(), client, containerID).ExtractErr()
@@ -2,6 +2,7 @@ package containers
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
@@ -85,6 +86,146 @@ func (opts CreateOpts) ToContainerCreateMap() (map[string]any, error) {
 	return gophercloud.BuildRequestBody(opts, "")
 }
 
+// Secret reference names used by certificate and RSA containers.
+const (
+	SecretRefCertificate          = "certificate"
+	SecretRefIntermediates        = "intermediates"
+	SecretRefPrivateKey           = "private_key"
+	SecretRefPrivateKeyPassphrase = "private_key_passphrase"
+	SecretRefPublicKey            = "public_key"
+)
+
+// CertificateContainerOpts provides options used to create a certificate
+// container.
+type CertificateContainerOpts struct {
+	// Name is the name of the container.
+	Name string
+
+	// Certificate is the reference of the secret holding the certificate.
+	Certificate string
+
+	// PrivateKey is the reference of the secret holding the private key.
+	PrivateKey string
+
+	// PrivateKeyPassphrase is the reference of the secret holding the
+	// passphrase of the private key.
+	PrivateKeyPassphrase string
+
+	// Intermediates is the reference of the secret holding the
+	// intermediate certificates.
+	Intermediates string
+}
+
+// ToContainerCreateMap formats a CertificateContainerOpts into a create
+// request. The Certificate reference is required, and a
+// PrivateKeyPassphrase requires a PrivateKey.
+func (opts CertificateContainerOpts) ToContainerCreateMap() (map[string]any, error) {
+	if opts.Certificate == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "containers.CertificateContainerOpts.Certificate"}
+	}
+	if opts.PrivateKeyPassphrase != "" && opts.PrivateKey == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "containers.CertificateContainerOpts.PrivateKey"}
+	}
+
+	return CreateOpts{
+		Type: CertificateContainer,
+		Name: opts.Name,
+		SecretRefs: secretRefs(
+			SecretRefCertificate, opts.Certificate,
+			SecretRefPrivateKey, opts.PrivateKey,
+			SecretRefPrivateKeyPassphrase, opts.PrivateKeyPassphrase,
+			SecretRefIntermediates, opts.Intermediates,
+		),
+	}.ToContainerCreateMap()
+}
+
+// RSAContainerOpts provides options used to create an RSA container.
+type RSAContainerOpts struct {
+	// Name is the name of the container.
+	Name string
+
+	// PublicKey is the reference of the secret holding the public key.
+	PublicKey string
+
+	// PrivateKey is the reference of the secret holding the private key.
+	PrivateKey string
+
+	// PrivateKeyPassphrase is the reference of the secret holding the
+	// passphrase of the private key.
+	PrivateKeyPassphrase string
+}
+
+// ToContainerCreateMap formats an RSAContainerOpts into a create request.
+// The PublicKey and PrivateKey references are required.
+func (opts RSAContainerOpts) ToContainerCreateMap() (map[string]any, error) {
+	if opts.PublicKey == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "containers.RSAContainerOpts.PublicKey"}
+	}
+	if opts.PrivateKey == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "containers.RSAContainerOpts.PrivateKey"}
+	}
+
+	return CreateOpts{
+		Type: RSAContainer,
+		Name: opts.Name,
+		SecretRefs: secretRefs(
+			SecretRefPublicKey, opts.PublicKey,
+			SecretRefPrivateKey, opts.PrivateKey,
+			SecretRefPrivateKeyPassphrase, opts.PrivateKeyPassphrase,
+		),
+	}.ToContainerCreateMap()
+}
+
+// GenericContainerOpts provides options used to create a generic container.
+type GenericContainerOpts struct {
+	// Name is the name of the container.
+	Name string
+
+	// SecretRefs is a list of secret refs for the container.
+	SecretRefs []SecretRef
+}
+
+// ToContainerCreateMap formats a GenericContainerOpts into a create request.
+// Every secret ref must have a reference and a name, and names must be
+// unique within the container.
+func (opts GenericContainerOpts) ToContainerCreateMap() (map[string]any, error) {
+	names := make(map[string]struct{}, len(opts.SecretRefs))
+	for i, ref := range opts.SecretRefs {
+		if ref.SecretRef == "" {
+			return nil, gophercloud.ErrMissingInput{Argument: fmt.Sprintf("containers.GenericContainerOpts.SecretRefs[%d].SecretRef", i)}
+		}
+		if ref.Name == "" {
+			return nil, gophercloud.ErrMissingInput{Argument: fmt.Sprintf("containers.GenericContainerOpts.SecretRefs[%d].Name", i)}
+		}
+		if _, ok := names[ref.Name]; ok {
+			err := gophercloud.ErrInvalidInput{}
+			err.Argument = fmt.Sprintf("containers.GenericContainerOpts.SecretRefs[%d].Name", i)
+			err.Value = ref.Name
+			err.Info = "secret ref names must be unique"
+			return nil, err
+		}
+		names[ref.Name] = struct{}{}
+	}
+
+	return CreateOpts{
+		Type:       GenericContainer,
+		Name:       opts.Name,
+		SecretRefs: opts.SecretRefs,
+	}.ToContainerCreateMap()
+}
+
+// secretRefs builds a list of secret refs from name and reference pairs,
+// skipping empty references.
+func secretRefs(pairs ...string) []SecretRef {
+	var refs []SecretRef
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if pairs[i+1] != "" {
+			refs = append(refs, SecretRef{Name: pairs[i], SecretRef: pairs[i+1]})
+		}
+	}
+	return refs
+}
+
 // Create creates a new container.
 func Create(ctx context.Context, client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
 	b, err := opts.ToContainerCreateMap()
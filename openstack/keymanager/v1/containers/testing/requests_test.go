@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/keymanager/v1/containers"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
@@ -73,6 +74,109 @@ func TestCreateContainer(t *testing.T) {
 	th.AssertDeepEquals(t, FirstContainer, *actual)
 }
 
+func TestCreateGenericContainer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateContainerSuccessfully(t)
+
+	createOpts := containers.GenericContainerOpts{
+		Name: "mycontainer",
+		SecretRefs: []containers.SecretRef{
+			{
+				Name:      "mysecret",
+				SecretRef: "http://barbican:9311/v1/secrets/1b8068c4-3bb6-4be6-8f1e-da0d1ea0b67c",
+			},
+		},
+	}
+
+	actual, err := containers.Create(context.TODO(), client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, FirstContainer, *actual)
+}
+
+func TestGenericContainerOptsValidation(t *testing.T) {
+	for _, refs := range [][]containers.SecretRef{
+		{{Name: "mysecret"}},
+		{{SecretRef: "http://barbican:9311/v1/secrets/1"}},
+	} {
+		_, err := containers.GenericContainerOpts{SecretRefs: refs}.ToContainerCreateMap()
+		if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+			t.Fatalf("ErrMissingInput was expected to occur, got %v", err)
+		}
+	}
+
+	_, err := containers.GenericContainerOpts{
+		SecretRefs: []containers.SecretRef{
+			{Name: "mysecret", SecretRef: "http://barbican:9311/v1/secrets/1"},
+			{Name: "mysecret", SecretRef: "http://barbican:9311/v1/secrets/2"},
+		},
+	}.ToContainerCreateMap()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("ErrInvalidInput was expected to occur, got %v", err)
+	}
+}
+
+func TestCertificateContainerOpts(t *testing.T) {
+	_, err := containers.CertificateContainerOpts{
+		Name:       "mycert",
+		PrivateKey: "http://barbican:9311/v1/secrets/2",
+	}.ToContainerCreateMap()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("ErrMissingInput was expected to occur, got %v", err)
+	}
+
+	_, err = containers.CertificateContainerOpts{
+		Name:                 "mycert",
+		Certificate:          "http://barbican:9311/v1/secrets/1",
+		PrivateKeyPassphrase: "http://barbican:9311/v1/secrets/3",
+	}.ToContainerCreateMap()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("ErrMissingInput was expected to occur, got %v", err)
+	}
+
+	b, err := containers.CertificateContainerOpts{
+		Name:        "mycert",
+		Certificate: "http://barbican:9311/v1/secrets/1",
+		PrivateKey:  "http://barbican:9311/v1/secrets/2",
+	}.ToContainerCreateMap()
+	th.AssertNoErr(t, err)
+	th.AssertJSONEquals(t, `{
+		"type": "certificate",
+		"name": "mycert",
+		"secret_refs": [
+			{"name": "certificate", "secret_ref": "http://barbican:9311/v1/secrets/1"},
+			{"name": "private_key", "secret_ref": "http://barbican:9311/v1/secrets/2"}
+		]
+	}`, b)
+}
+
+func TestRSAContainerOpts(t *testing.T) {
+	_, err := containers.RSAContainerOpts{
+		Name:      "myrsa",
+		PublicKey: "http://barbican:9311/v1/secrets/1",
+	}.ToContainerCreateMap()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("ErrMissingInput was expected to occur, got %v", err)
+	}
+
+	b, err := containers.RSAContainerOpts{
+		Name:                 "myrsa",
+		PublicKey:            "http://barbican:9311/v1/secrets/1",
+		PrivateKey:           "http://barbican:9311/v1/secrets/2",
+		PrivateKeyPassphrase: "http://barbican:9311/v1/secrets/3",
+	}.ToContainerCreateMap()
+	th.AssertNoErr(t, err)
+	th.AssertJSONEquals(t, `{
+		"type": "rsa",
+		"name": "myrsa",
+		"secret_refs": [
+			{"name": "public_key", "secret_ref": "http://barbican:9311/v1/secrets/1"},
+			{"name": "private_key", "secret_ref": "http://barbican:9311/v1/secrets/2"},
+			{"name": "private_key_passphrase", "secret_ref": "http://barbican:9311/v1/secrets/3"}
+		]
+	}`, b)
+}
+
 func TestDeleteContainer(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
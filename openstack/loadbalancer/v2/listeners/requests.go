@@ -76,6 +76,9 @@ type ListOpts struct {
 	TimeoutMemberConnect *int     `q:"timeout_member_connect"`
 	TimeoutTCPInspect    *int     `q:"timeout_tcp_inspect"`
 	Tags                 []string `q:"tags"`
+	TagsAny              []string `q:"tags-any"`
+	TagsNot              []string `q:"not-tags"`
+	TagsNotAny           []string `q:"not-tags-any"`
 }
 
 // ToListenerListQuery formats a ListOpts into a query string.
@@ -168,3 +168,15 @@ func TestGetListenerStatsTree(t *testing.T) {
 
 	th.CheckDeepEquals(t, ListenerStatsTree, *actual)
 }
+
+func TestListListenersQueryTags(t *testing.T) {
+	opts := listeners.ListOpts{
+		Tags:       []string{"prod", "web"},
+		TagsAny:    []string{"blue"},
+		TagsNot:    []string{"deprecated"},
+		TagsNotAny: []string{"legacy"},
+	}
+	query, err := opts.ToListenerListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "?not-tags=deprecated&not-tags-any=legacy&tags=prod&tags=web&tags-any=blue", query)
+}
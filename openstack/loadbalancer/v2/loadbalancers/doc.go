@@ -67,16 +67,16 @@ Example to Create a fully populated Load Balancer
 				LBMethod: pools.LBMethodRoundRobin,
 				Protocol: "HTTP",
 				Name:     "example pool",
-				Members: []pools.BatchUpdateMemberOpts{{
+				Members: []pools.CreateMemberOpts{{
 					Address:      "192.0.2.51",
 					ProtocolPort: 80,
-				},},
+				}},
 				Monitor: &monitors.CreateOpts{
-					Name:           "db",
-					Type:           "HTTP",
-					Delay:          3,
-					MaxRetries:     2,
-					Timeout:        1,
+					Name:       "db",
+					Type:       "HTTP",
+					Delay:      3,
+					MaxRetries: 2,
+					Timeout:    1,
 				},
 			},
 		}},
@@ -87,6 +87,13 @@ Example to Create a fully populated Load Balancer
 		panic(err)
 	}
 
+	// The whole graph is provisioned at once, so a single wait is enough
+	// before the listeners, pools and members can be used.
+	err = loadbalancers.WaitForProvisioningStatus(context.TODO(), networkClient, lb.ID, "ACTIVE")
+	if err != nil {
+		panic(err)
+	}
+
 Example to Update a Load Balancer
 
 	lbID := "d67d56a6-4a86-4688-a282-f46444705c64"
@@ -128,6 +135,22 @@ Example to Get the Statistics of a Load Balancer
 		panic(err)
 	}
 
+Example to Watch the Health of a Pool
+
+	watchOpts := loadbalancers.WatchPoolHealthOpts{
+		LoadBalancerID: "d67d56a6-4a86-4688-a282-f46444705c64",
+		PoolID:         "fad389a3-9a4a-4762-a365-8c7038508b5d",
+		Interval:       30 * time.Second,
+	}
+
+	err := loadbalancers.WatchPoolHealth(ctx, networkClient, watchOpts, func(c loadbalancers.PoolHealthChange) error {
+		fmt.Printf("%s %s: %s -> %s\n", c.ResourceType, c.ID, c.PreviousStatus, c.CurrentStatus)
+		return nil
+	})
+	if err != nil && err != context.Canceled {
+		panic(err)
+	}
+
 Example to Failover a Load Balancers
 
 	lbID := "d67d56a6-4a86-4688-a282-f46444705c64"
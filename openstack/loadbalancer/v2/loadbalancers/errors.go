@@ -0,0 +1,30 @@
+package loadbalancers
+
+import (
+	"fmt"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// ErrPoolNotInStatusTree is returned by WatchPoolHealth when the watched pool
+// is not part of the status tree of the load balancer.
+type ErrPoolNotInStatusTree struct {
+	gophercloud.BaseError
+	LoadBalancerID string
+	PoolID         string
+}
+
+func (e ErrPoolNotInStatusTree) Error() string {
+	return fmt.Sprintf("Pool [%s] is not part of the status tree of load balancer [%s]", e.PoolID, e.LoadBalancerID)
+}
+
+// ErrProvisioningFailed is returned by WaitForProvisioningStatus when the
+// load balancer goes to the ERROR provisioning status.
+type ErrProvisioningFailed struct {
+	gophercloud.BaseError
+	LoadBalancerID string
+}
+
+func (e ErrProvisioningFailed) Error() string {
+	return fmt.Sprintf("Provisioning of load balancer [%s] failed", e.LoadBalancerID)
+}
@@ -633,3 +633,88 @@ func HandleLoadbalancerFailoverSuccessfully(t *testing.T) {
 		w.WriteHeader(http.StatusAccepted)
 	})
 }
+
+// poolHealthStatusesBody returns a status tree body whose pool has the given
+// members, formatted as member objects.
+func poolHealthStatusesBody(members string) string {
+	return fmt.Sprintf(`
+{
+    "statuses": {
+        "loadbalancer": {
+            "id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
+            "operating_status": "ONLINE",
+            "provisioning_status": "ACTIVE",
+            "listeners": [{
+                "id": "db902c0c-d5ff-4753-b465-668ad9656918",
+                "operating_status": "ONLINE",
+                "provisioning_status": "ACTIVE",
+                "pools": [{
+                    "id": "fad389a3-9a4a-4762-a365-8c7038508b5d",
+                    "operating_status": "ONLINE",
+                    "provisioning_status": "ACTIVE",
+                    "healthmonitor": {
+                        "id": "67306cda-815d-4354-9fe4-59e09da9c3c5",
+                        "name": "db-monitor",
+                        "type": "HTTP",
+                        "operating_status": "ONLINE",
+                        "provisioning_status": "ACTIVE"
+                    },
+                    "members": [%s]
+                }]
+            }]
+        }
+    }
+}
+`, members)
+}
+
+// PoolHealthStatusesBodies are the status trees successively returned by
+// HandlePoolHealthStatuses.
+var PoolHealthStatusesBodies = []string{
+	poolHealthStatusesBody(`
+        {"id": "2a280670-c202-4b0b-a562-34077415aabf", "name": "db1", "operating_status": "ONLINE"},
+        {"id": "7d19ad6c-d549-453e-a5cd-05382c6be96a", "name": "db2", "operating_status": "ONLINE"}`),
+	poolHealthStatusesBody(`
+        {"id": "2a280670-c202-4b0b-a562-34077415aabf", "name": "db1", "operating_status": "ERROR"},
+        {"id": "7d19ad6c-d549-453e-a5cd-05382c6be96a", "name": "db2", "operating_status": "ONLINE"}`),
+	poolHealthStatusesBody(`
+        {"id": "2a280670-c202-4b0b-a562-34077415aabf", "name": "db1", "operating_status": "ONLINE"},
+        {"id": "93c0f1a4-6b1e-4a33-8b3c-7a0e4c9d1f20", "name": "db3", "operating_status": "NO_MONITOR"}`),
+}
+
+// HandlePoolHealthStatuses sets up the test server to respond to loadbalancer
+// Get statuses tree requests with PoolHealthStatusesBodies, one per request.
+// The last body is repeated once all of them have been returned.
+func HandlePoolHealthStatuses(t *testing.T) {
+	var calls int
+	th.Mux.HandleFunc("/v2.0/lbaas/loadbalancers/36e08a3e-a78f-4b40-a229-1e7e23eee1ab/status", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		body := PoolHealthStatusesBodies[min(calls, len(PoolHealthStatusesBodies)-1)]
+		calls++
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, body)
+	})
+}
+
+// HandleLoadbalancerProvisioning sets up the test server to respond to
+// loadbalancer Get requests with the PENDING_CREATE provisioning status
+// first, and with finalStatus afterwards.
+func HandleLoadbalancerProvisioning(t *testing.T, finalStatus string) {
+	var calls int
+	th.Mux.HandleFunc("/v2.0/lbaas/loadbalancers/36e08a3e-a78f-4b40-a229-1e7e23eee1ab", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		status := "PENDING_CREATE"
+		if calls > 0 {
+			status = finalStatus
+		}
+		calls++
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"loadbalancer": {"id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab", "provisioning_status": "%s"}}`, status)
+	})
+}
@@ -2,7 +2,9 @@ package testing
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/loadbalancer/v2/l7policies"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/loadbalancer/v2/listeners"
@@ -239,3 +241,167 @@ func TestFailoverLoadbalancer(t *testing.T) {
 	res := loadbalancers.Failover(context.TODO(), fake.ServiceClient(), "36e08a3e-a78f-4b40-a229-1e7e23eee1ab")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestListLoadbalancersQueryTags(t *testing.T) {
+	opts := loadbalancers.ListOpts{
+		Tags:       []string{"prod", "web"},
+		TagsAny:    []string{"blue"},
+		TagsNot:    []string{"deprecated"},
+		TagsNotAny: []string{"legacy"},
+	}
+	query, err := opts.ToLoadBalancerListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "?not-tags=deprecated&not-tags-any=legacy&tags=prod&tags=web&tags-any=blue", query)
+}
+
+func TestWatchPoolHealth(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePoolHealthStatuses(t)
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	opts := loadbalancers.WatchPoolHealthOpts{
+		LoadBalancerID: "36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
+		PoolID:         "fad389a3-9a4a-4762-a365-8c7038508b5d",
+		Interval:       time.Millisecond,
+	}
+
+	var changes []loadbalancers.PoolHealthChange
+	err := loadbalancers.WatchPoolHealth(ctx, fake.ServiceClient(), opts, func(c loadbalancers.PoolHealthChange) error {
+		changes = append(changes, c)
+		if len(changes) == 4 {
+			cancel()
+		}
+		return nil
+	})
+	th.AssertEquals(t, context.Canceled, err)
+
+	expected := []loadbalancers.PoolHealthChange{
+		{
+			ResourceType:   loadbalancers.PoolHealthMember,
+			ID:             "2a280670-c202-4b0b-a562-34077415aabf",
+			Name:           "db1",
+			PreviousStatus: "ONLINE",
+			CurrentStatus:  "ERROR",
+		},
+		{
+			ResourceType:   loadbalancers.PoolHealthMember,
+			ID:             "2a280670-c202-4b0b-a562-34077415aabf",
+			Name:           "db1",
+			PreviousStatus: "ERROR",
+			CurrentStatus:  "ONLINE",
+		},
+		{
+			ResourceType:   loadbalancers.PoolHealthMember,
+			ID:             "7d19ad6c-d549-453e-a5cd-05382c6be96a",
+			Name:           "db2",
+			PreviousStatus: "ONLINE",
+		},
+		{
+			ResourceType:  loadbalancers.PoolHealthMember,
+			ID:            "93c0f1a4-6b1e-4a33-8b3c-7a0e4c9d1f20",
+			Name:          "db3",
+			CurrentStatus: "NO_MONITOR",
+		},
+	}
+	th.CheckDeepEquals(t, expected, changes)
+}
+
+func TestWatchPoolHealthReportInitial(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePoolHealthStatuses(t)
+
+	stop := errors.New("stop")
+	opts := loadbalancers.WatchPoolHealthOpts{
+		LoadBalancerID: "36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
+		PoolID:         "fad389a3-9a4a-4762-a365-8c7038508b5d",
+		ReportInitial:  true,
+	}
+
+	var changes []loadbalancers.PoolHealthChange
+	err := loadbalancers.WatchPoolHealth(context.TODO(), fake.ServiceClient(), opts, func(c loadbalancers.PoolHealthChange) error {
+		changes = append(changes, c)
+		if len(changes) == 3 {
+			return stop
+		}
+		return nil
+	})
+	th.AssertEquals(t, stop, err)
+
+	th.AssertEquals(t, 3, len(changes))
+	th.AssertEquals(t, loadbalancers.PoolHealthMonitor, changes[0].ResourceType)
+	th.AssertEquals(t, "db-monitor", changes[0].Name)
+	th.AssertEquals(t, "", changes[0].PreviousStatus)
+	th.AssertEquals(t, "ONLINE", changes[0].CurrentStatus)
+	th.AssertEquals(t, "db1", changes[1].Name)
+	th.AssertEquals(t, "db2", changes[2].Name)
+}
+
+func TestWatchPoolHealthCancelledBeforeFirstPoll(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePoolHealthStatuses(t)
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+
+	opts := loadbalancers.WatchPoolHealthOpts{
+		LoadBalancerID: "36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
+		PoolID:         "fad389a3-9a4a-4762-a365-8c7038508b5d",
+	}
+
+	err := loadbalancers.WatchPoolHealth(ctx, fake.ServiceClient(), opts, func(loadbalancers.PoolHealthChange) error {
+		t.Fatal("unexpected callback")
+		return nil
+	})
+	th.AssertEquals(t, context.Canceled, err)
+}
+
+func TestWatchPoolHealthPoolNotFound(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePoolHealthStatuses(t)
+
+	opts := loadbalancers.WatchPoolHealthOpts{
+		LoadBalancerID: "36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
+		PoolID:         "missing",
+	}
+
+	err := loadbalancers.WatchPoolHealth(context.TODO(), fake.ServiceClient(), opts, func(loadbalancers.PoolHealthChange) error {
+		t.Fatal("unexpected callback")
+		return nil
+	})
+	if _, ok := err.(loadbalancers.ErrPoolNotInStatusTree); !ok {
+		t.Fatalf("expected ErrPoolNotInStatusTree, got %v", err)
+	}
+}
+
+func TestWaitForProvisioningStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLoadbalancerProvisioning(t, "ACTIVE")
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+
+	err := loadbalancers.WaitForProvisioningStatus(ctx, fake.ServiceClient(), "36e08a3e-a78f-4b40-a229-1e7e23eee1ab", "ACTIVE")
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForProvisioningStatusError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLoadbalancerProvisioning(t, "ERROR")
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+
+	err := loadbalancers.WaitForProvisioningStatus(ctx, fake.ServiceClient(), "36e08a3e-a78f-4b40-a229-1e7e23eee1ab", "ACTIVE")
+	var provisioningErr loadbalancers.ErrProvisioningFailed
+	if !errors.As(err, &provisioningErr) {
+		t.Fatalf("expected ErrProvisioningFailed, got %v", err)
+	}
+}
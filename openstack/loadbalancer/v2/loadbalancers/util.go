@@ -0,0 +1,235 @@
+package loadbalancers
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/loadbalancer/v2/pools"
+)
+
+// Resource types reported in a PoolHealthChange.
+const (
+	PoolHealthMember  = "member"
+	PoolHealthMonitor = "healthmonitor"
+)
+
+// DefaultPoolHealthInterval is the polling interval used by WatchPoolHealth
+// when WatchPoolHealthOpts.Interval is not set.
+const DefaultPoolHealthInterval = 10 * time.Second
+
+// PoolHealthChange describes a transition of the operating status of a pool
+// member or of the health monitor of a pool.
+type PoolHealthChange struct {
+	// ResourceType is either PoolHealthMember or PoolHealthMonitor.
+	ResourceType string
+
+	// ID is the ID of the member or health monitor.
+	ID string
+
+	// Name is the name of the member or health monitor.
+	Name string
+
+	// PreviousStatus is the operating status observed during the previous
+	// poll. It is empty if the resource was not part of the pool yet.
+	PreviousStatus string
+
+	// CurrentStatus is the operating status observed during the current poll.
+	// It is empty if the resource was removed from the pool.
+	CurrentStatus string
+}
+
+// WatchPoolHealthOpts specifies the pool watched by WatchPoolHealth.
+type WatchPoolHealthOpts struct {
+	// LoadBalancerID is the ID of the load balancer the pool belongs to.
+	LoadBalancerID string
+
+	// PoolID is the ID of the watched pool.
+	PoolID string
+
+	// Interval is the delay between two polls of the status tree. Defaults
+	// to DefaultPoolHealthInterval.
+	Interval time.Duration
+
+	// ReportInitial reports the statuses observed during the first poll as
+	// transitions from an empty status.
+	ReportInitial bool
+}
+
+// WatchPoolHealth polls the status tree of a load balancer and invokes
+// callback each time the operating status of a member or of the health
+// monitor of the pool changes.
+//
+// WatchPoolHealth blocks until ctx is done, in which case ctx.Err() is
+// returned, or until the status tree can't be retrieved, the pool is not part
+// of it, or callback returns an error.
+func WatchPoolHealth(ctx context.Context, c *gophercloud.ServiceClient, opts WatchPoolHealthOpts, callback func(PoolHealthChange) error) error {
+	if opts.LoadBalancerID == "" {
+		return gophercloud.ErrMissingInput{Argument: "LoadBalancerID"}
+	}
+	if opts.PoolID == "" {
+		return gophercloud.ErrMissingInput{Argument: "PoolID"}
+	}
+
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = DefaultPoolHealthInterval
+	}
+
+	var previous map[string]PoolHealthChange
+	poll := func() error {
+		tree, err := GetStatuses(ctx, c, opts.LoadBalancerID).Extract()
+		if err != nil {
+			// A request interrupted by ctx reports a transport error
+			// rather than ctx.Err().
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+
+		pool := findStatusTreePool(tree, opts.PoolID)
+		if pool == nil {
+			return ErrPoolNotInStatusTree{LoadBalancerID: opts.LoadBalancerID, PoolID: opts.PoolID}
+		}
+
+		current := poolHealth(pool)
+		if previous != nil || opts.ReportInitial {
+			for _, change := range diffPoolHealth(previous, current) {
+				if err := callback(change); err != nil {
+					return err
+				}
+			}
+		}
+		previous = current
+
+		return nil
+	}
+
+	if err := poll(); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := poll(); err != nil {
+				return err
+			}
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// findStatusTreePool returns the pool with the given ID from a status tree,
+// or nil if the pool is not part of it.
+func findStatusTreePool(tree *StatusTree, poolID string) *pools.Pool {
+	if tree == nil || tree.Loadbalancer == nil {
+		return nil
+	}
+
+	for i := range tree.Loadbalancer.Pools {
+		if tree.Loadbalancer.Pools[i].ID == poolID {
+			return &tree.Loadbalancer.Pools[i]
+		}
+	}
+	for _, listener := range tree.Loadbalancer.Listeners {
+		for i := range listener.Pools {
+			if listener.Pools[i].ID == poolID {
+				return &listener.Pools[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// poolHealth indexes the operating statuses of the members and of the health
+// monitor of a pool. The PreviousStatus of the entries is not set.
+func poolHealth(pool *pools.Pool) map[string]PoolHealthChange {
+	health := make(map[string]PoolHealthChange, len(pool.Members)+1)
+
+	if pool.Monitor.ID != "" {
+		health[PoolHealthMonitor+"/"+pool.Monitor.ID] = PoolHealthChange{
+			ResourceType:  PoolHealthMonitor,
+			ID:            pool.Monitor.ID,
+			Name:          pool.Monitor.Name,
+			CurrentStatus: pool.Monitor.OperatingStatus,
+		}
+	}
+	for _, member := range pool.Members {
+		health[PoolHealthMember+"/"+member.ID] = PoolHealthChange{
+			ResourceType:  PoolHealthMember,
+			ID:            member.ID,
+			Name:          member.Name,
+			CurrentStatus: member.OperatingStatus,
+		}
+	}
+
+	return health
+}
+
+// diffPoolHealth returns the transitions between two polls, sorted by
+// resource type and ID.
+func diffPoolHealth(previous, current map[string]PoolHealthChange) []PoolHealthChange {
+	var changes []PoolHealthChange
+
+	for key, c := range current {
+		p, ok := previous[key]
+		if ok && p.CurrentStatus == c.CurrentStatus {
+			continue
+		}
+		c.PreviousStatus = p.CurrentStatus
+		changes = append(changes, c)
+	}
+	for key, p := range previous {
+		if _, ok := current[key]; !ok {
+			changes = append(changes, PoolHealthChange{
+				ResourceType:   p.ResourceType,
+				ID:             p.ID,
+				Name:           p.Name,
+				PreviousStatus: p.CurrentStatus,
+			})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].ResourceType != changes[j].ResourceType {
+			return changes[i].ResourceType < changes[j].ResourceType
+		}
+		return changes[i].ID < changes[j].ID
+	})
+
+	return changes
+}
+
+// WaitForProvisioningStatus polls a load balancer until its provisioning
+// status becomes status. Since a fully populated load balancer is created in
+// a single call, waiting for ACTIVE after Create is enough for the whole graph
+// of listeners, pools, members and monitors to be usable. If the load
+// balancer goes to the ERROR provisioning status, an ErrProvisioningFailed is
+// returned.
+func WaitForProvisioningStatus(ctx context.Context, c *gophercloud.ServiceClient, id, status string) error {
+	return gophercloud.WaitFor(ctx, func(ctx context.Context) (bool, error) {
+		current, err := Get(ctx, c, id).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		if current.ProvisioningStatus == status {
+			return true, nil
+		}
+
+		if current.ProvisioningStatus == "ERROR" {
+			return false, ErrProvisioningFailed{LoadBalancerID: id}
+		}
+
+		return false, nil
+	})
+}
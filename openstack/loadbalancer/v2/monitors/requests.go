@@ -39,6 +39,9 @@ type ListOpts struct {
 	SortKey        string   `q:"sort_key"`
 	SortDir        string   `q:"sort_dir"`
 	Tags           []string `q:"tags"`
+	TagsAny        []string `q:"tags-any"`
+	TagsNot        []string `q:"not-tags"`
+	TagsNotAny     []string `q:"not-tags-any"`
 }
 
 // ToMonitorListQuery formats a ListOpts into a query string.
@@ -224,7 +224,8 @@ func HandleHealthmonitorUpdateSuccessfully(t *testing.T) {
 				"max_retries": 10,
 				"max_retries_down": 8,
 				"url_path": "/another_check",
-				"expected_codes": "301"
+				"expected_codes": "301",
+				"tags": ["prod"]
 			}
 		}`)
 
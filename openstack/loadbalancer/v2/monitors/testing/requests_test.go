@@ -125,6 +125,7 @@ func TestUpdateHealthmonitor(t *testing.T) {
 		MaxRetriesDown: 8,
 		URLPath:        "/another_check",
 		ExpectedCodes:  "301",
+		Tags:           []string{"prod"},
 	}).Extract()
 	if err != nil {
 		t.Fatalf("Unexpected Update error: %v", err)
@@ -157,3 +158,15 @@ func TestDelayMustBeGreaterOrEqualThanTimeout(t *testing.T) {
 		t.Fatalf("Expected error, got none")
 	}
 }
+
+func TestListHealthmonitorsQueryTags(t *testing.T) {
+	opts := monitors.ListOpts{
+		Tags:       []string{"prod", "web"},
+		TagsAny:    []string{"blue"},
+		TagsNot:    []string{"deprecated"},
+		TagsNotAny: []string{"legacy"},
+	}
+	query, err := opts.ToMonitorListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "?not-tags=deprecated&not-tags-any=legacy&tags=prod&tags=web&tags-any=blue", query)
+}
@@ -43,6 +43,9 @@ type ListOpts struct {
 	SortKey        string   `q:"sort_key"`
 	SortDir        string   `q:"sort_dir"`
 	Tags           []string `q:"tags"`
+	TagsAny        []string `q:"tags-any"`
+	TagsNot        []string `q:"not-tags"`
+	TagsNotAny     []string `q:"not-tags-any"`
 }
 
 // ToPoolListQuery formats a ListOpts into a query string.
@@ -333,17 +336,21 @@ type ListMembersOptsBuilder interface {
 // you to sort by a particular Member attribute. SortDir sets the direction,
 // and is either `asc' or `desc'. Marker and Limit are used for pagination.
 type ListMembersOpts struct {
-	Name         string `q:"name"`
-	Weight       int    `q:"weight"`
-	AdminStateUp *bool  `q:"admin_state_up"`
-	ProjectID    string `q:"project_id"`
-	Address      string `q:"address"`
-	ProtocolPort int    `q:"protocol_port"`
-	ID           string `q:"id"`
-	Limit        int    `q:"limit"`
-	Marker       string `q:"marker"`
-	SortKey      string `q:"sort_key"`
-	SortDir      string `q:"sort_dir"`
+	Name         string   `q:"name"`
+	Weight       int      `q:"weight"`
+	AdminStateUp *bool    `q:"admin_state_up"`
+	ProjectID    string   `q:"project_id"`
+	Address      string   `q:"address"`
+	ProtocolPort int      `q:"protocol_port"`
+	ID           string   `q:"id"`
+	Limit        int      `q:"limit"`
+	Marker       string   `q:"marker"`
+	SortKey      string   `q:"sort_key"`
+	SortDir      string   `q:"sort_dir"`
+	Tags         []string `q:"tags"`
+	TagsAny      []string `q:"tags-any"`
+	TagsNot      []string `q:"not-tags"`
+	TagsNotAny   []string `q:"not-tags-any"`
 }
 
 // ToMemberListQuery formats a ListOpts into a query string.
@@ -340,3 +340,27 @@ func TestRequiredBatchUpdateMemberOpts(t *testing.T) {
 		t.Fatalf("Expected error, but got none")
 	}
 }
+
+func TestListPoolsQueryTags(t *testing.T) {
+	opts := pools.ListOpts{
+		Tags:       []string{"prod", "web"},
+		TagsAny:    []string{"blue"},
+		TagsNot:    []string{"deprecated"},
+		TagsNotAny: []string{"legacy"},
+	}
+	query, err := opts.ToPoolListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "?not-tags=deprecated&not-tags-any=legacy&tags=prod&tags=web&tags-any=blue", query)
+}
+
+func TestListMembersQueryTags(t *testing.T) {
+	opts := pools.ListMembersOpts{
+		Tags:       []string{"prod", "web"},
+		TagsAny:    []string{"blue"},
+		TagsNot:    []string{"deprecated"},
+		TagsNotAny: []string{"legacy"},
+	}
+	query, err := opts.ToMembersListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "?not-tags=deprecated&not-tags-any=legacy&tags=prod&tags=web&tags-any=blue", query)
+}
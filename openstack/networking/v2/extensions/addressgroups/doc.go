@@ -0,0 +1,87 @@
+/*
+Package addressgroups enables management and retrieval of address groups
+through the Neutron address-group extension. An address group is a set of
+IP addresses and CIDRs which can be referenced by security group rules with
+their RemoteAddressGroupID.
+
+Example to List Address Groups
+
+	listOpts := addressgroups.ListOpts{
+		ProjectID: "7e02058126cc4950b75f9970368ba177",
+	}
+
+	allPages, err := addressgroups.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allGroups, err := addressgroups.ExtractAddressGroups(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, group := range allGroups {
+		fmt.Printf("%+v\n", group)
+	}
+
+Example to Create an Address Group
+
+	createOpts := addressgroups.CreateOpts{
+		Name:      "bastions",
+		Addresses: []string{"192.168.10.1/32", "2001:db8::/64"},
+	}
+
+	group, err := addressgroups.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update an Address Group
+
+	groupID := "8722e0e0-9cc9-4490-9660-8c9a5732fbb0"
+
+	description := "SSH bastion hosts"
+	updateOpts := addressgroups.UpdateOpts{
+		Description: &description,
+	}
+
+	group, err := addressgroups.Update(context.TODO(), networkClient, groupID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Add Addresses to an Address Group
+
+	groupID := "8722e0e0-9cc9-4490-9660-8c9a5732fbb0"
+
+	opts := addressgroups.AddressesOpts{
+		Addresses: []string{"192.168.10.2/32"},
+	}
+
+	group, err := addressgroups.AddAddresses(context.TODO(), networkClient, groupID, opts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Remove Addresses from an Address Group
+
+	groupID := "8722e0e0-9cc9-4490-9660-8c9a5732fbb0"
+
+	opts := addressgroups.AddressesOpts{
+		Addresses: []string{"2001:db8::/64"},
+	}
+
+	group, err := addressgroups.RemoveAddresses(context.TODO(), networkClient, groupID, opts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Address Group
+
+	groupID := "8722e0e0-9cc9-4490-9660-8c9a5732fbb0"
+	err := addressgroups.Delete(context.TODO(), networkClient, groupID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package addressgroups
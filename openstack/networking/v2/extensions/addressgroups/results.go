@@ -0,0 +1,124 @@
+package addressgroups
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts an address group
+// resource.
+func (r commonResult) Extract() (*AddressGroup, error) {
+	var s AddressGroup
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v any) error {
+	return r.Result.ExtractIntoStructPtr(v, "address_group")
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as an AddressGroup.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as an AddressGroup.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as an AddressGroup.
+type UpdateResult struct {
+	commonResult
+}
+
+// AddAddressesResult represents the result of an AddAddresses operation.
+// Call its Extract method to interpret it as an AddressGroup.
+type AddAddressesResult struct {
+	commonResult
+}
+
+// RemoveAddressesResult represents the result of a RemoveAddresses operation.
+// Call its Extract method to interpret it as an AddressGroup.
+type RemoveAddressesResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// AddressGroup represents a group of IP addresses and CIDRs.
+type AddressGroup struct {
+	// ID is the UUID of the address group.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the address group.
+	Name string `json:"name"`
+
+	// Description is the human-readable description of the address group.
+	Description string `json:"description"`
+
+	// TenantID is the project owner of the address group.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the project owner of the address group.
+	ProjectID string `json:"project_id"`
+
+	// Addresses are the IP addresses and CIDRs of the address group.
+	Addresses []string `json:"addresses"`
+}
+
+// AddressGroupPage is the page returned by a pager when traversing over a
+// collection of address groups.
+type AddressGroupPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of address groups has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r AddressGroupPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"address_groups_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether an AddressGroupPage struct is empty.
+func (r AddressGroupPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractAddressGroups(r)
+	return len(is) == 0, err
+}
+
+// ExtractAddressGroups accepts a Page struct, specifically an
+// AddressGroupPage struct, and extracts the elements into a slice of
+// AddressGroup structs.
+func ExtractAddressGroups(r pagination.Page) ([]AddressGroup, error) {
+	var s []AddressGroup
+	err := ExtractAddressGroupsInto(r, &s)
+	return s, err
+}
+
+// ExtractAddressGroupsInto extracts the elements into a slice of AddressGroup
+// structs.
+func ExtractAddressGroupsInto(r pagination.Page, v any) error {
+	return r.(AddressGroupPage).Result.ExtractIntoSlicePtr(v, "address_groups")
+}
@@ -0,0 +1,2 @@
+// Package testing includes address groups unit tests
+package testing
@@ -0,0 +1,109 @@
+package testing
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/addressgroups"
+)
+
+const ListResponse = `
+{
+    "address_groups": [
+        {
+            "id": "8722e0e0-9cc9-4490-9660-8c9a5732fbb0",
+            "name": "bastions",
+            "description": "",
+            "tenant_id": "7e02058126cc4950b75f9970368ba177",
+            "project_id": "7e02058126cc4950b75f9970368ba177",
+            "addresses": ["192.168.10.1/32", "2001:db8::/64"]
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "address_group": {
+        "id": "8722e0e0-9cc9-4490-9660-8c9a5732fbb0",
+        "name": "bastions",
+        "description": "",
+        "tenant_id": "7e02058126cc4950b75f9970368ba177",
+        "project_id": "7e02058126cc4950b75f9970368ba177",
+        "addresses": ["192.168.10.1/32", "2001:db8::/64"]
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "address_group": {
+        "name": "bastions",
+        "addresses": ["192.168.10.1/32", "2001:db8::/64"]
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "address_group": {
+        "description": "SSH bastion hosts"
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "address_group": {
+        "id": "8722e0e0-9cc9-4490-9660-8c9a5732fbb0",
+        "name": "bastions",
+        "description": "SSH bastion hosts",
+        "tenant_id": "7e02058126cc4950b75f9970368ba177",
+        "project_id": "7e02058126cc4950b75f9970368ba177",
+        "addresses": ["192.168.10.1/32", "2001:db8::/64"]
+    }
+}
+`
+
+const AddAddressesRequest = `
+{
+    "addresses": ["192.168.10.2/32"]
+}
+`
+
+const AddAddressesResponse = `
+{
+    "address_group": {
+        "id": "8722e0e0-9cc9-4490-9660-8c9a5732fbb0",
+        "name": "bastions",
+        "description": "",
+        "tenant_id": "7e02058126cc4950b75f9970368ba177",
+        "project_id": "7e02058126cc4950b75f9970368ba177",
+        "addresses": ["192.168.10.1/32", "192.168.10.2/32", "2001:db8::/64"]
+    }
+}
+`
+
+const RemoveAddressesRequest = `
+{
+    "addresses": ["2001:db8::/64"]
+}
+`
+
+const RemoveAddressesResponse = `
+{
+    "address_group": {
+        "id": "8722e0e0-9cc9-4490-9660-8c9a5732fbb0",
+        "name": "bastions",
+        "description": "",
+        "tenant_id": "7e02058126cc4950b75f9970368ba177",
+        "project_id": "7e02058126cc4950b75f9970368ba177",
+        "addresses": ["192.168.10.1/32"]
+    }
+}
+`
+
+var Bastions = addressgroups.AddressGroup{
+	ID:        "8722e0e0-9cc9-4490-9660-8c9a5732fbb0",
+	Name:      "bastions",
+	TenantID:  "7e02058126cc4950b75f9970368ba177",
+	ProjectID: "7e02058126cc4950b75f9970368ba177",
+	Addresses: []string{"192.168.10.1/32", "2001:db8::/64"},
+}
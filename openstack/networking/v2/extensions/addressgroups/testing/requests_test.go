@@ -0,0 +1,179 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/addressgroups"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+const groupID = "8722e0e0-9cc9-4490-9660-8c9a5732fbb0"
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/address-groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"name": "bastions",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ListResponse)
+	})
+
+	count := 0
+	err := addressgroups.List(fake.ServiceClient(), addressgroups.ListOpts{Name: "bastions"}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := addressgroups.ExtractAddressGroups(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []addressgroups.AddressGroup{Bastions}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/address-groups/"+groupID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	group, err := addressgroups.Get(context.TODO(), fake.ServiceClient(), groupID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, Bastions, *group)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/address-groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	createOpts := addressgroups.CreateOpts{
+		Name:      "bastions",
+		Addresses: []string{"192.168.10.1/32", "2001:db8::/64"},
+	}
+	group, err := addressgroups.Create(context.TODO(), fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, Bastions, *group)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/address-groups/"+groupID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UpdateResponse)
+	})
+
+	description := "SSH bastion hosts"
+	updateOpts := addressgroups.UpdateOpts{
+		Description: &description,
+	}
+	group, err := addressgroups.Update(context.TODO(), fake.ServiceClient(), groupID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "SSH bastion hosts", group.Description)
+}
+
+func TestAddAddresses(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/address-groups/"+groupID+"/add_addresses", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, AddAddressesRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, AddAddressesResponse)
+	})
+
+	opts := addressgroups.AddressesOpts{
+		Addresses: []string{"192.168.10.2/32"},
+	}
+	group, err := addressgroups.AddAddresses(context.TODO(), fake.ServiceClient(), groupID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"192.168.10.1/32", "192.168.10.2/32", "2001:db8::/64"}, group.Addresses)
+}
+
+func TestRemoveAddresses(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/address-groups/"+groupID+"/remove_addresses", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, RemoveAddressesRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, RemoveAddressesResponse)
+	})
+
+	opts := addressgroups.AddressesOpts{
+		Addresses: []string{"2001:db8::/64"},
+	}
+	group, err := addressgroups.RemoveAddresses(context.TODO(), fake.ServiceClient(), groupID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"192.168.10.1/32"}, group.Addresses)
+}
+
+func TestAddressesRequired(t *testing.T) {
+	res := addressgroups.AddAddresses(context.TODO(), fake.ServiceClient(), groupID, addressgroups.AddressesOpts{})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/address-groups/"+groupID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := addressgroups.Delete(context.TODO(), fake.ServiceClient(), groupID)
+	th.AssertNoErr(t, res.Err)
+}
@@ -63,6 +63,23 @@ Example to List Networks hosted by a DHCP Agent
 		fmt.Printf("%+v\n", network)
 	}
 
+Example to List DHCP Agents hosting a Network
+
+	networkID := "1ae075ca-708b-4e66-b4a7-b7698632f05f"
+	allPages, err := agents.ListDHCPAgentsHostingNetwork(networkClient, networkID).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allAgents, err := agents.ExtractAgents(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, agent := range allAgents {
+		fmt.Printf("%+v\n", agent)
+	}
+
 Example to Schedule a network to a DHCP Agent
 
 	agentID := "76af7b1f-d61b-4526-94f7-d2e14e2698df"
@@ -152,6 +152,16 @@ func RemoveDHCPNetwork(ctx context.Context, c *gophercloud.ServiceClient, id str
 	return
 }
 
+// ListDHCPAgentsHostingNetwork lists the DHCP agents hosting a specific
+// network.
+// GET /v2.0/networks/{network-id}/dhcp-agents
+func ListDHCPAgentsHostingNetwork(c *gophercloud.ServiceClient, networkID string) pagination.Pager {
+	url := listDHCPAgentsHostingNetworkURL(c, networkID)
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return AgentPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
 // ListBGPSpeakers list the BGP Speakers hosted by a specific dragent
 // GET /v2.0/agents/{agent-id}/bgp-drinstances
 func ListBGPSpeakers(c *gophercloud.ServiceClient, agentID string) pagination.Pager {
@@ -293,6 +293,29 @@ func TestRemoveBGPSpeaker(t *testing.T) {
 	th.AssertNoErr(t, err)
 }
 
+func TestListDHCPAgentsHostingNetwork(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	networkID := "1ae075ca-708b-4e66-b4a7-b7698632f05f"
+	th.Mux.HandleFunc("/v2.0/networks/"+networkID+"/dhcp-agents",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, AgentsListResult)
+		})
+
+	allPages, err := agents.ListDHCPAgentsHostingNetwork(fake.ServiceClient(), networkID).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	actual, err := agents.ExtractAgents(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []agents.Agent{Agent1, Agent2}, actual)
+}
+
 func TestListDRAgentHostingBGPSpeakers(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -8,6 +8,8 @@ const l3RoutersResourcePath = "l3-routers"
 const bgpSpeakersResourcePath = "bgp-drinstances"
 const bgpDRAgentSpeakersResourcePath = "bgp-speakers"
 const bgpDRAgentAgentResourcePath = "bgp-dragents"
+const networksResourcePath = "networks"
+const dhcpAgentsResourcePath = "dhcp-agents"
 
 func resourceURL(c *gophercloud.ServiceClient, id string) string {
 	return c.ServiceURL(resourcePath, id)
@@ -84,3 +86,8 @@ func removeBGPSpeakersURL(c *gophercloud.ServiceClient, agentID string, speakerI
 func listDRAgentHostingBGPSpeakersURL(c *gophercloud.ServiceClient, speakerID string) string {
 	return c.ServiceURL(bgpDRAgentSpeakersResourcePath, speakerID, bgpDRAgentAgentResourcePath)
 }
+
+// return /v2.0/networks/{network-id}/dhcp-agents
+func listDHCPAgentsHostingNetworkURL(c *gophercloud.ServiceClient, networkID string) string {
+	return c.ServiceURL(networksResourcePath, networkID, dhcpAgentsResourcePath)
+}
@@ -0,0 +1,52 @@
+/*
+Package autoallocatedtopology provides the ability to retrieve and manage the
+auto-allocated topology of a project through the Neutron
+auto-allocated-topology extension, and a GetMeANetwork helper mirroring the
+automatic network selection of the Compute service.
+
+Example to Get the Auto-Allocated Topology of a Project
+
+	topology, err := autoallocatedtopology.Get(context.TODO(), networkClient, projectID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Network: %s\n", topology.ID)
+
+Example to Validate the Auto-Allocation Requirements
+
+	err := autoallocatedtopology.Validate(context.TODO(), networkClient, projectID).ExtractErr()
+	if err != nil {
+		var notMet autoallocatedtopology.ErrRequirementsNotMet
+		if errors.As(err, &notMet) {
+			fmt.Printf("Cannot auto-allocate a network: %s\n", notMet.Reason)
+		}
+		panic(err)
+	}
+
+Example to Delete the Auto-Allocated Topology of a Project
+
+	err := autoallocatedtopology.Delete(context.TODO(), networkClient, projectID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get a Network and Create a Port on it
+
+	ref, err := autoallocatedtopology.GetMeANetwork(context.TODO(), networkClient, autoallocatedtopology.GetMeANetworkOpts{
+		ProjectID: projectID,
+		IPVersion: gophercloud.IPv4,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := ref.ToPortCreateOpts()
+	createOpts.Name = "my-port"
+
+	port, err := ports.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package autoallocatedtopology
@@ -0,0 +1,56 @@
+package autoallocatedtopology
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// ErrAmbiguousNetwork is returned by GetMeANetwork when more than one
+// network is available to the project, so that none can be picked
+// automatically.
+type ErrAmbiguousNetwork struct {
+	gophercloud.BaseError
+	NetworkIDs []string
+}
+
+func (e ErrAmbiguousNetwork) Error() string {
+	return fmt.Sprintf("Multiple networks are available, one must be picked explicitly: %s", strings.Join(e.NetworkIDs, ", "))
+}
+
+// ErrNoSubnet is returned by GetMeANetwork when the network has no usable
+// subnet and no subnet creation options were given.
+type ErrNoSubnet struct {
+	gophercloud.BaseError
+	NetworkID string
+}
+
+func (e ErrNoSubnet) Error() string {
+	return fmt.Sprintf("Network [%s] has no usable subnet", e.NetworkID)
+}
+
+// ErrRequirementsNotMet is returned by ValidateResult.ExtractErr when a
+// topology cannot be auto-allocated for the project, for example because
+// there is no default external network or no default subnet pool.
+type ErrRequirementsNotMet struct {
+	gophercloud.BaseError
+
+	// Reason is the message of the Networking service.
+	Reason string
+
+	// Err is the 409 Conflict error returned by the Networking service.
+	Err error
+}
+
+func (e ErrRequirementsNotMet) Error() string {
+	if e.Reason == "" {
+		return "The requirements to auto-allocate a topology are not met"
+	}
+	return fmt.Sprintf("The requirements to auto-allocate a topology are not met: %s", e.Reason)
+}
+
+// Unwrap returns the error returned by the Networking service.
+func (e ErrRequirementsNotMet) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,32 @@
+package autoallocatedtopology
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// Get returns the auto-allocated topology of a project. The topology, made
+// of a network, a subnet and a router plugged into the default external
+// network, is created if it doesn't exist yet.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, projectID string) (r GetResult) {
+	resp, err := c.Get(ctx, getURL(c, projectID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Validate checks that the requirements to auto-allocate a topology for a
+// project are met, without allocating anything. A 409 Conflict error is
+// returned if they are not.
+func Validate(ctx context.Context, c *gophercloud.ServiceClient, projectID string) (r ValidateResult) {
+	resp, err := c.Get(ctx, validateURL(c, projectID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete deletes the auto-allocated topology of a project.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, projectID string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, deleteURL(c, projectID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
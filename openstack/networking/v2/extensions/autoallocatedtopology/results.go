@@ -0,0 +1,70 @@
+package autoallocatedtopology
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// Topology represents the auto-allocated topology of a project.
+type Topology struct {
+	// ID is the ID of the auto-allocated network.
+	ID string `json:"id"`
+
+	// ProjectID is the project owning the topology.
+	ProjectID string `json:"project_id"`
+
+	// TenantID is the project owning the topology.
+	TenantID string `json:"tenant_id"`
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a Topology.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Topology.
+func (r GetResult) Extract() (*Topology, error) {
+	var s struct {
+		Topology *Topology `json:"auto_allocated_topology"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Topology, err
+}
+
+// ValidateResult represents the result of a validate operation. Call its
+// ExtractErr method to determine if the requirements are met.
+type ValidateResult struct {
+	gophercloud.ErrResult
+}
+
+// ExtractErr returns nil if the requirements to auto-allocate a topology are
+// met. If they are not, the error is an ErrRequirementsNotMet holding the
+// reason given by the Networking service.
+func (r ValidateResult) ExtractErr() error {
+	var respErr gophercloud.ErrUnexpectedResponseCode
+	if !errors.As(r.Err, &respErr) || respErr.Actual != http.StatusConflict {
+		return r.Err
+	}
+
+	var s struct {
+		NeutronError struct {
+			Message string `json:"message"`
+		} `json:"NeutronError"`
+	}
+	_ = json.Unmarshal(respErr.Body, &s)
+
+	return ErrRequirementsNotMet{
+		Reason: s.NeutronError.Message,
+		Err:    r.Err,
+	}
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
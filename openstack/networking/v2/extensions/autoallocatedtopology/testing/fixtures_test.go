@@ -0,0 +1,146 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+// ProjectID is the project used in the tests.
+const ProjectID = "0c5b4ec9e2e14ec0b7aa2ea6ab73ddf6"
+
+// GetResponse is a sample response to a Get request.
+const GetResponse = `
+{
+	"auto_allocated_topology": {
+		"id": "e1ad5ef4-0a94-4e7f-9c3d-6f2c4da6b4a0",
+		"project_id": "0c5b4ec9e2e14ec0b7aa2ea6ab73ddf6",
+		"tenant_id": "0c5b4ec9e2e14ec0b7aa2ea6ab73ddf6"
+	}
+}
+`
+
+// ValidateResponse is a sample response to a Validate request.
+const ValidateResponse = `
+{
+	"auto_allocated_topology": {
+		"dry-run": "pass"
+	}
+}
+`
+
+// SubnetListResponse is a sample response to a subnet List request on the
+// auto-allocated network.
+const SubnetListResponse = `
+{
+	"subnets": [
+		{
+			"id": "a0304c3a-4f08-4c43-88af-d796509c97d2",
+			"network_id": "e1ad5ef4-0a94-4e7f-9c3d-6f2c4da6b4a0",
+			"cidr": "10.0.0.0/26",
+			"ip_version": 4
+		}
+	]
+}
+`
+
+// SubnetCreateResponse is a sample response to a subnet Create request.
+const SubnetCreateResponse = `
+{
+	"subnet": {
+		"id": "08eae331-0402-425a-923c-34f7cfe39c1b",
+		"network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+		"cidr": "192.168.199.0/24",
+		"ip_version": 4
+	}
+}
+`
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/auto-allocated-topology/"+ProjectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		if r.URL.Query().Get("fields") == "dry-run" {
+			fmt.Fprint(w, ValidateResponse)
+			return
+		}
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleNetworkList configures the test server to respond to the network
+// List requests of GetMeANetwork, with ownNetworks and sharedNetworks being
+// the networks of the project and the shared networks.
+func HandleNetworkList(t *testing.T, ownNetworks, sharedNetworks string) {
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+		th.AssertEquals(t, "false", r.Form.Get("router:external"))
+
+		w.Header().Add("Content-Type", "application/json")
+		switch shared := r.Form.Get("shared"); shared {
+		case "false":
+			th.AssertEquals(t, ProjectID, r.Form.Get("project_id"))
+			fmt.Fprintf(w, `{"networks": [%s]}`, ownNetworks)
+		case "true":
+			fmt.Fprintf(w, `{"networks": [%s]}`, sharedNetworks)
+		default:
+			t.Fatalf("Unexpected shared filter: [%s]", shared)
+		}
+	})
+}
+
+// HandleSubnetListSuccessfully configures the test server to respond to a
+// subnet List request on the auto-allocated network.
+func HandleSubnetListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"network_id": "e1ad5ef4-0a94-4e7f-9c3d-6f2c4da6b4a0",
+			"ip_version": "4",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, SubnetListResponse)
+	})
+}
+
+// HandleSubnetCreateSuccessfully configures the test server to respond to an
+// empty subnet List request and to a subnet Create request.
+func HandleSubnetCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22"})
+			fmt.Fprint(w, `{"subnets": []}`)
+		case "POST":
+			th.TestJSONRequest(t, r, `
+			{
+				"subnet": {
+					"network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+					"cidr": "192.168.199.0/24",
+					"ip_version": 4
+				}
+			}`)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, SubnetCreateResponse)
+		default:
+			t.Fatalf("Unexpected method: %s", r.Method)
+		}
+	})
+}
@@ -0,0 +1,138 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/autoallocatedtopology"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/subnets"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := autoallocatedtopology.Get(context.TODO(), fake.ServiceClient(), ProjectID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &autoallocatedtopology.Topology{
+		ID:        "e1ad5ef4-0a94-4e7f-9c3d-6f2c4da6b4a0",
+		ProjectID: ProjectID,
+		TenantID:  ProjectID,
+	}, actual)
+}
+
+func TestValidate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	err := autoallocatedtopology.Validate(context.TODO(), fake.ServiceClient(), ProjectID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestValidateRequirementsNotMet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/auto-allocated-topology/"+ProjectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"fields": "dry-run"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"NeutronError": {"type": "AutoAllocationFailure", "message": "Deployment error: No default router:external network.", "detail": ""}}`)
+	})
+
+	err := autoallocatedtopology.Validate(context.TODO(), fake.ServiceClient(), ProjectID).ExtractErr()
+
+	var notMet autoallocatedtopology.ErrRequirementsNotMet
+	if !errors.As(err, &notMet) {
+		t.Fatalf("Expected ErrRequirementsNotMet, got %v", err)
+	}
+	th.AssertEquals(t, "Deployment error: No default router:external network.", notMet.Reason)
+	th.AssertEquals(t, true, gophercloud.ResponseCodeIs(err, http.StatusConflict))
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/auto-allocated-topology/"+ProjectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := autoallocatedtopology.Delete(context.TODO(), fake.ServiceClient(), ProjectID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestGetMeANetworkAutoAllocated(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleNetworkList(t, "", "")
+	HandleGetSuccessfully(t)
+	HandleSubnetListSuccessfully(t)
+
+	ref, err := autoallocatedtopology.GetMeANetwork(context.TODO(), fake.ServiceClient(), autoallocatedtopology.GetMeANetworkOpts{
+		ProjectID: ProjectID,
+		IPVersion: gophercloud.IPv4,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &autoallocatedtopology.NetworkReference{
+		NetworkID:     "e1ad5ef4-0a94-4e7f-9c3d-6f2c4da6b4a0",
+		SubnetID:      "a0304c3a-4f08-4c43-88af-d796509c97d2",
+		AutoAllocated: true,
+	}, ref)
+
+	th.AssertDeepEquals(t, ports.CreateOpts{
+		NetworkID: "e1ad5ef4-0a94-4e7f-9c3d-6f2c4da6b4a0",
+		FixedIPs:  []ports.IP{{SubnetID: "a0304c3a-4f08-4c43-88af-d796509c97d2"}},
+	}, ref.ToPortCreateOpts())
+}
+
+func TestGetMeANetworkCreateSubnet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleNetworkList(t, "", `{"id": "d32019d3-bc6e-4319-9c1d-6722fc136a22", "shared": true}`)
+	HandleSubnetCreateSuccessfully(t)
+
+	ref, err := autoallocatedtopology.GetMeANetwork(context.TODO(), fake.ServiceClient(), autoallocatedtopology.GetMeANetworkOpts{
+		ProjectID: ProjectID,
+		SubnetCreateOpts: &subnets.CreateOpts{
+			CIDR:      "192.168.199.0/24",
+			IPVersion: gophercloud.IPv4,
+		},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &autoallocatedtopology.NetworkReference{
+		NetworkID:     "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+		SubnetID:      "08eae331-0402-425a-923c-34f7cfe39c1b",
+		SubnetCreated: true,
+	}, ref)
+}
+
+func TestGetMeANetworkAmbiguous(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleNetworkList(t,
+		`{"id": "db193ab3-96e3-4cb3-8fc5-05f4296d0324"}`,
+		`{"id": "d32019d3-bc6e-4319-9c1d-6722fc136a22", "shared": true}`)
+
+	_, err := autoallocatedtopology.GetMeANetwork(context.TODO(), fake.ServiceClient(), autoallocatedtopology.GetMeANetworkOpts{
+		ProjectID: ProjectID,
+	})
+	ambiguous, ok := err.(autoallocatedtopology.ErrAmbiguousNetwork)
+	if !ok {
+		t.Fatalf("ErrAmbiguousNetwork was expected to occur, got %v", err)
+	}
+	th.AssertDeepEquals(t, []string{"db193ab3-96e3-4cb3-8fc5-05f4296d0324", "d32019d3-bc6e-4319-9c1d-6722fc136a22"}, ambiguous.NetworkIDs)
+}
@@ -0,0 +1,151 @@
+package autoallocatedtopology
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/external"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/subnets"
+)
+
+// GetMeANetworkOpts provides options to GetMeANetwork.
+type GetMeANetworkOpts struct {
+	// ProjectID is the project to find or allocate a network for.
+	ProjectID string
+
+	// IPVersion, if set, restricts the subnet to the given IP version.
+	IPVersion gophercloud.IPVersion
+
+	// SubnetCreateOpts, if set, are used to create a subnet when the network
+	// has none. Its NetworkID is set by GetMeANetwork.
+	SubnetCreateOpts *subnets.CreateOpts
+}
+
+// NetworkReference holds the network and subnet picked by GetMeANetwork.
+type NetworkReference struct {
+	// NetworkID is the ID of the network.
+	NetworkID string
+
+	// SubnetID is the ID of the subnet.
+	SubnetID string
+
+	// AutoAllocated is true if the network was auto-allocated.
+	AutoAllocated bool
+
+	// SubnetCreated is true if the subnet was created by GetMeANetwork.
+	SubnetCreated bool
+}
+
+// ToPortCreateOpts returns the options to create a port on the network and
+// the subnet of the NetworkReference.
+func (ref NetworkReference) ToPortCreateOpts() ports.CreateOpts {
+	return ports.CreateOpts{
+		NetworkID: ref.NetworkID,
+		FixedIPs:  []ports.IP{{SubnetID: ref.SubnetID}},
+	}
+}
+
+// GetMeANetwork implements the "get me a network" behavior of the Compute
+// service for callers managing ports themselves.
+//
+// The networks owned by the project and the shared networks, except the
+// external ones, are considered. If exactly one is found, it is used. If
+// none is found, the auto-allocated topology of the project is used,
+// allocating it if needed. If more than one is found, an ErrAmbiguousNetwork
+// error is returned.
+//
+// The first subnet of the network matching opts.IPVersion is then picked.
+// If there is none, a subnet is created with opts.SubnetCreateOpts, or an
+// ErrNoSubnet error is returned.
+func GetMeANetwork(ctx context.Context, c *gophercloud.ServiceClient, opts GetMeANetworkOpts) (*NetworkReference, error) {
+	if opts.ProjectID == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "autoallocatedtopology.GetMeANetworkOpts.ProjectID"}
+	}
+
+	networkIDs, err := availableNetworks(ctx, c, opts.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	ref := &NetworkReference{}
+	switch len(networkIDs) {
+	case 0:
+		topology, err := Get(ctx, c, opts.ProjectID).Extract()
+		if err != nil {
+			return nil, err
+		}
+		ref.NetworkID = topology.ID
+		ref.AutoAllocated = true
+	case 1:
+		ref.NetworkID = networkIDs[0]
+	default:
+		return nil, ErrAmbiguousNetwork{NetworkIDs: networkIDs}
+	}
+
+	allPages, err := subnets.List(c, subnets.ListOpts{
+		NetworkID: ref.NetworkID,
+		IPVersion: int(opts.IPVersion),
+	}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allSubnets, err := subnets.ExtractSubnets(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(allSubnets) > 0 {
+		ref.SubnetID = allSubnets[0].ID
+		return ref, nil
+	}
+
+	if opts.SubnetCreateOpts == nil {
+		return nil, ErrNoSubnet{NetworkID: ref.NetworkID}
+	}
+
+	createOpts := *opts.SubnetCreateOpts
+	createOpts.NetworkID = ref.NetworkID
+	subnet, err := subnets.Create(ctx, c, createOpts).Extract()
+	if err != nil {
+		return nil, err
+	}
+	ref.SubnetID = subnet.ID
+	ref.SubnetCreated = true
+
+	return ref, nil
+}
+
+// availableNetworks returns the IDs of the internal networks owned by the
+// project and of the internal shared networks.
+func availableNetworks(ctx context.Context, c *gophercloud.ServiceClient, projectID string) ([]string, error) {
+	iFalse := false
+	iTrue := true
+
+	var ids []string
+	for _, listOpts := range []networks.ListOpts{
+		{ProjectID: projectID, Shared: &iFalse},
+		{Shared: &iTrue},
+	} {
+		allPages, err := networks.List(c, external.ListOptsExt{
+			ListOptsBuilder: listOpts,
+			External:        &iFalse,
+		}).AllPages(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		allNetworks, err := networks.ExtractNetworks(allPages)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, n := range allNetworks {
+			ids = append(ids, n.ID)
+		}
+	}
+
+	return ids, nil
+}
@@ -34,7 +34,7 @@ Example:
 
 Example:
         var opts peers.CreateOpts
-        opts.AuthType = "md5"
+        opts.AuthType = peers.AuthTypeMD5
         opts.Password = "notSoStrong"
         opts.RemoteAS = 20000
         opts.Name = "gophercloud-testing-bgp-peer"
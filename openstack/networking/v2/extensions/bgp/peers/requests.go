@@ -28,22 +28,43 @@ type CreateOptsBuilder interface {
 	ToPeerCreateMap() (map[string]any, error)
 }
 
+// Authentication types of a BGP Peer.
+const (
+	AuthTypeNone = "none"
+	AuthTypeMD5  = "md5"
+)
+
 // CreateOpts represents options used to create a BGP Peer.
 type CreateOpts struct {
-	AuthType string `json:"auth_type"`
-	RemoteAS int    `json:"remote_as"`
-	Name     string `json:"name"`
+	// AuthType is the authentication type, either AuthTypeNone or
+	// AuthTypeMD5. It defaults to AuthTypeNone.
+	AuthType string `json:"auth_type,omitempty"`
+
+	// RemoteAS is the autonomous system number of the peer.
+	RemoteAS int `json:"remote_as"`
+
+	// Name is the name of the BGP Peer.
+	Name string `json:"name"`
+
+	// Password is the authentication password. It is required unless
+	// AuthType is AuthTypeNone.
 	Password string `json:"password,omitempty"`
-	PeerIP   string `json:"peer_ip"`
+
+	// PeerIP is the IP address of the peer.
+	PeerIP string `json:"peer_ip"`
 }
 
 // ToPeerCreateMap builds a request body from CreateOpts.
 func (opts CreateOpts) ToPeerCreateMap() (map[string]any, error) {
+	if opts.AuthType != "" && opts.AuthType != AuthTypeNone && opts.Password == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "peers.CreateOpts.Password"}
+	}
+
 	return gophercloud.BuildRequestBody(opts, jroot)
 }
 
 // Create a BGP Peer
-func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOpts) (r CreateResult) {
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
 	b, err := opts.ToPeerCreateMap()
 	if err != nil {
 		r.Err = err
@@ -79,7 +100,7 @@ func (opts UpdateOpts) ToPeerUpdateMap() (map[string]any, error) {
 }
 
 // Update accept a BGP Peer ID and an UpdateOpts and update the BGP Peer
-func Update(ctx context.Context, c *gophercloud.ServiceClient, bgpPeerID string, opts UpdateOpts) (r UpdateResult) {
+func Update(ctx context.Context, c *gophercloud.ServiceClient, bgpPeerID string, opts UpdateOptsBuilder) (r UpdateResult) {
 	b, err := opts.ToPeerUpdateMap()
 	if err != nil {
 		r.Err = err
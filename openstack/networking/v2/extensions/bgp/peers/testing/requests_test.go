@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/bgp/peers"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
@@ -78,7 +79,7 @@ func TestCreate(t *testing.T) {
 	})
 
 	var opts peers.CreateOpts
-	opts.AuthType = "md5"
+	opts.AuthType = peers.AuthTypeMD5
 	opts.Password = "notSoStrong"
 	opts.RemoteAS = 20000
 	opts.Name = "gophercloud-testing-bgp-peer"
@@ -135,3 +136,24 @@ func TestUpdate(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.AssertEquals(t, r.Name, opts.Name)
 }
+
+func TestCreateBGPPeerMissingPassword(t *testing.T) {
+	opts := peers.CreateOpts{
+		AuthType: peers.AuthTypeMD5,
+		RemoteAS: 20000,
+		Name:     "gophercloud-testing-bgp-peer",
+		PeerIP:   "192.168.0.1",
+	}
+
+	_, err := opts.ToPeerCreateMap()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("expected ErrMissingInput, got %v", err)
+	}
+
+	opts.AuthType = ""
+	b, err := opts.ToPeerCreateMap()
+	th.AssertNoErr(t, err)
+	if _, ok := b["bgp_peer"].(map[string]any)["auth_type"]; ok {
+		t.Fatal("auth_type should be omitted when empty")
+	}
+}
@@ -67,10 +67,12 @@ Example:
 
 Example:
 
+        advertiseTenantNetworks := false
+        advertiseFloatingIPHostRoutes := true
         opts := speakers.UpdateOpts{
                 Name:                          "testing-bgp-speaker",
-                AdvertiseTenantNetworks:       false,
-                AdvertiseFloatingIPHostRoutes: true,
+                AdvertiseTenantNetworks:       &advertiseTenantNetworks,
+                AdvertiseFloatingIPHostRoutes: &advertiseFloatingIPHostRoutes,
         }
         spk, err := speakers.Update(context.TODO(), client, bgpSpeakerID, opts).Extract()
         if err != nil {
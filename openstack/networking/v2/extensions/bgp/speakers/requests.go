@@ -43,7 +43,7 @@ func (opts CreateOpts) ToSpeakerCreateMap() (map[string]any, error) {
 }
 
 // Create accepts a CreateOpts and create a BGP Speaker.
-func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOpts) (r CreateResult) {
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
 	b, err := opts.ToSpeakerCreateMap()
 	if err != nil {
 		r.Err = err
@@ -61,11 +61,12 @@ func Delete(ctx context.Context, c *gophercloud.ServiceClient, speakerID string)
 	return
 }
 
-// UpdateOpts represents options used to update a BGP Speaker.
+// UpdateOpts represents options used to update a BGP Speaker. Fields left
+// nil are not changed.
 type UpdateOpts struct {
 	Name                          string `json:"name,omitempty"`
-	AdvertiseFloatingIPHostRoutes bool   `json:"advertise_floating_ip_host_routes"`
-	AdvertiseTenantNetworks       bool   `json:"advertise_tenant_networks"`
+	AdvertiseFloatingIPHostRoutes *bool  `json:"advertise_floating_ip_host_routes,omitempty"`
+	AdvertiseTenantNetworks       *bool  `json:"advertise_tenant_networks,omitempty"`
 }
 
 // ToSpeakerUpdateMap build a request body from UpdateOpts
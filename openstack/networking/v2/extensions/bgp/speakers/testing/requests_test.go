@@ -140,17 +140,25 @@ func TestUpdate(t *testing.T) {
 		}
 	})
 
+	advertiseTenantNetworks := false
+	advertiseFloatingIPHostRoutes := true
 	opts := speakers.UpdateOpts{
 		Name:                          "testing-bgp-speaker",
-		AdvertiseTenantNetworks:       false,
-		AdvertiseFloatingIPHostRoutes: true,
+		AdvertiseTenantNetworks:       &advertiseTenantNetworks,
+		AdvertiseFloatingIPHostRoutes: &advertiseFloatingIPHostRoutes,
 	}
 
 	r, err := speakers.Update(context.TODO(), fake.ServiceClient(), bgpSpeakerID, opts).Extract()
 	th.AssertNoErr(t, err)
 	th.AssertEquals(t, r.Name, opts.Name)
-	th.AssertEquals(t, r.AdvertiseTenantNetworks, opts.AdvertiseTenantNetworks)
-	th.AssertEquals(t, r.AdvertiseFloatingIPHostRoutes, opts.AdvertiseFloatingIPHostRoutes)
+	th.AssertEquals(t, r.AdvertiseTenantNetworks, advertiseTenantNetworks)
+	th.AssertEquals(t, r.AdvertiseFloatingIPHostRoutes, advertiseFloatingIPHostRoutes)
+}
+
+func TestUpdateBGPSpeakerNameOnly(t *testing.T) {
+	b, err := speakers.UpdateOpts{Name: "renamed"}.ToSpeakerUpdateMap()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, map[string]any{"bgp_speaker": map[string]any{"name": "renamed"}}, b)
 }
 
 func TestAddBGPPeer(t *testing.T) {
@@ -1,5 +1,3 @@
-package bgpvpns
-
 /*
 Package bgpvpns contains the functionality for working with Neutron BGP VPNs.
 
@@ -7,60 +5,108 @@ Package bgpvpns contains the functionality for working with Neutron BGP VPNs.
 
 Example:
 
-        pages, err := bgpvpns.List(client).AllPages(context.TODO())
-        if err != nil {
-                log.Panic(err)
-        }
-        allVPNs, err := bgpvpns.ExtractBGPVPNs(pages)
-        if err != nil {
-                log.Panic(err)
-        }
+	pages, err := bgpvpns.List(client, bgpvpns.ListOpts{}).AllPages(context.TODO())
+	if err != nil {
+		log.Panic(err)
+	}
+	allVPNs, err := bgpvpns.ExtractBGPVPNs(pages)
+	if err != nil {
+		log.Panic(err)
+	}
 
-        for _, bgpvpn := range allVPNs {
-                log.Printf("%+v", bgpvpn)
-        }
+	for _, bgpvpn := range allVPNs {
+		log.Printf("%+v", bgpvpn)
+	}
 
 2. Get BGP VPN, a.k.a. GET /bgpvpn/bgpvpns/{id}
 
 Example:
-        p, err := bgpvpns.Get(context.TODO(), client, id).Extract()
-        if err != nil {
-                log.Panic(err)
-        }
-        log.Printf("%+v", *p)
+
+	p, err := bgpvpns.Get(context.TODO(), client, id).Extract()
+	if err != nil {
+		log.Panic(err)
+	}
+	log.Printf("%+v", *p)
 
 3. Create BGP VPN, a.k.a. POST /bgpvpn/bgpvpns
 
 Example:
-        opts := bgpvpns.CreateOpts{
-                name: "gophercloud-testing-bgpvpn".
-        }
-        r, err := bgpvpns.Create(context.TODO(), client, opts).Extract()
-        if err != nil {
-                log.Panic(err)
-        }
-        log.Printf("%+v", *r)
+
+	opts := bgpvpns.CreateOpts{
+		Name:         "gophercloud-testing-bgpvpn",
+		Type:         bgpvpns.TypeL2,
+		RouteTargets: []string{"64512:1444"},
+		VNI:          1000,
+	}
+	r, err := bgpvpns.Create(context.TODO(), client, opts).Extract()
+	if err != nil {
+		log.Panic(err)
+	}
+	log.Printf("%+v", *r)
 
 4. Delete BGP VPN, a.k.a. DELETE /bgpvpn/bgpvpns/{id}
 
 Example:
-        err := bgpvpns.Delete(context.TODO(), client, bgpVpnID).ExtractErr()
-        if err != nil {
-                log.Panic(err)
-        }
-        log.Printf("BGP VPN deleted")
 
+	err := bgpvpns.Delete(context.TODO(), client, bgpVpnID).ExtractErr()
+	if err != nil {
+		log.Panic(err)
+	}
+	log.Printf("BGP VPN deleted")
 
 5. Update BGP VPN, a.k.a. PUT /bgpvpn/bgpvpns/{id}
 
 Example:
-        nameUpdated := "bgpvpn-name-updated"
-        opts := bgpvpns.UpdateOpts{
-                name: &nameUpdated,
-        }
-        p, err := bgpvpns.Update(context.TODO(), client, id, opts).Extract()
-        if err != nil {
-                log.Panic(err)
-        }
-        log.Printf("%+v", p)
+
+	nameUpdated := "bgpvpn-name-updated"
+	opts := bgpvpns.UpdateOpts{
+		Name: &nameUpdated,
+	}
+	p, err := bgpvpns.Update(context.TODO(), client, id, opts).Extract()
+	if err != nil {
+		log.Panic(err)
+	}
+	log.Printf("%+v", p)
+
+6. Associate a network with a BGP VPN, a.k.a. POST /bgpvpn/bgpvpns/{id}/network_associations
+
+Example:
+
+	opts := bgpvpns.CreateNetworkAssociationOpts{
+		NetworkID: networkID,
+	}
+	a, err := bgpvpns.CreateNetworkAssociation(context.TODO(), client, bgpVpnID, opts).Extract()
+	if err != nil {
+		log.Panic(err)
+	}
+	log.Printf("%+v", a)
+
+7. Associate a router with a BGP VPN, a.k.a. POST /bgpvpn/bgpvpns/{id}/router_associations
+
+Example:
+
+	advertiseExtraRoutes := true
+	opts := bgpvpns.CreateRouterAssociationOpts{
+		RouterID:             routerID,
+		AdvertiseExtraRoutes: &advertiseExtraRoutes,
+	}
+	a, err := bgpvpns.CreateRouterAssociation(context.TODO(), client, bgpVpnID, opts).Extract()
+	if err != nil {
+		log.Panic(err)
+	}
+	log.Printf("%+v", a)
+
+8. Associate a port with a BGP VPN, a.k.a. POST /bgpvpn/bgpvpns/{id}/port_associations
+
+Example:
+
+	opts := bgpvpns.CreatePortAssociationOpts{
+		PortID: portID,
+	}
+	a, err := bgpvpns.CreatePortAssociation(context.TODO(), client, bgpVpnID, opts).Extract()
+	if err != nil {
+		log.Panic(err)
+	}
+	log.Printf("%+v", a)
 */
+package bgpvpns
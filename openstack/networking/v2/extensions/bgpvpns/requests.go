@@ -55,6 +55,17 @@ func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetRes
 	return
 }
 
+// The types of BGP VPN, which may be used as CreateOpts.Type.
+const (
+	// TypeL2 is a BGP VPN interconnecting Ethernet networks, for example
+	// with EVPN.
+	TypeL2 = "l2"
+
+	// TypeL3 is a BGP VPN interconnecting IP subnets, for example with
+	// IP VPN over MPLS. This is the default.
+	TypeL3 = "l3"
+)
+
 // CreateOptsBuilder allows extensions to add additional parameters to the
 // Create request.
 type CreateOptsBuilder interface {
@@ -81,7 +92,7 @@ func (opts CreateOpts) ToBGPVPNCreateMap() (map[string]any, error) {
 }
 
 // Create a BGP VPN
-func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOpts) (r CreateResult) {
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
 	b, err := opts.ToBGPVPNCreateMap()
 	if err != nil {
 		r.Err = err
@@ -121,7 +132,7 @@ func (opts UpdateOpts) ToBGPVPNUpdateMap() (map[string]any, error) {
 }
 
 // Update accept a BGP VPN ID and an UpdateOpts and update the BGP VPN
-func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOpts) (r UpdateResult) {
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
 	b, err := opts.ToBGPVPNUpdateMap()
 	if err != nil {
 		r.Err = err
@@ -106,7 +106,7 @@ func TestCreate(t *testing.T) {
 			"64512:1888",
 			"64512:1999",
 		},
-		Type: "l3",
+		Type: bgpvpns.TypeL3,
 		VNI:  1000,
 	}
 
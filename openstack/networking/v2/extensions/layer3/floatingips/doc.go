@@ -33,6 +33,17 @@ Example to Create a Floating IP
 		panic(err)
 	}
 
+Example to list the Port Forwardings of a Floating IP
+
+	fip, err := floatingips.Get(context.TODO(), networkClient, fipID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, pf := range fip.PortForwardings {
+		fmt.Printf("%+v\n", pf)
+	}
+
 Example to Update a Floating IP
 
 	fipID := "2f245a7b-796b-4f26-9cf9-9e82d248fda7"
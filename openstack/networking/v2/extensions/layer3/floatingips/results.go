@@ -56,6 +56,21 @@ type FloatingIP struct {
 
 	// Tags optionally set via extensions/attributestags
 	Tags []string `json:"tags"`
+
+	// PortForwardings are the port forwarding rules of the floating IP. They
+	// are returned with the expose-port-forwarding-in-fip extension.
+	PortForwardings []PortForwarding `json:"port_forwardings"`
+}
+
+// PortForwarding is a port forwarding rule, as listed in a floating IP. The
+// rules themselves are managed with the layer3/portforwarding package.
+type PortForwarding struct {
+	Protocol          string `json:"protocol"`
+	InternalIPAddress string `json:"internal_ip_address"`
+	InternalPort      int    `json:"internal_port"`
+	ExternalPort      int    `json:"external_port"`
+	InternalPortRange string `json:"internal_port_range"`
+	ExternalPortRange string `json:"external_port_range"`
 }
 
 func (r *FloatingIP) UnmarshalJSON(b []byte) error {
@@ -322,6 +322,64 @@ func TestGet(t *testing.T) {
 	th.AssertEquals(t, "1117c30a-ddb4-49a1-bec3-a65b286b4170", ip.RouterID)
 }
 
+func TestGetWithPortForwardings(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/floatingips/2f245a7b-796b-4f26-9cf9-9e82d248fda7", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, `
+{
+    "floatingip": {
+        "floating_network_id": "90f742b1-6d17-487b-ba95-71881dbc0b64",
+        "floating_ip_address": "10.0.0.3",
+        "status": "ACTIVE",
+        "id": "2f245a7b-796b-4f26-9cf9-9e82d248fda7",
+        "router_id": "1117c30a-ddb4-49a1-bec3-a65b286b4170",
+        "port_forwardings": [
+            {
+                "protocol": "tcp",
+                "internal_ip_address": "10.0.0.11",
+                "internal_port": 25,
+                "external_port": 2230
+            },
+            {
+                "protocol": "udp",
+                "internal_ip_address": "10.0.0.12",
+                "internal_port_range": "8000:8010",
+                "external_port_range": "9000:9010"
+            }
+        ]
+    }
+}
+      `)
+	})
+
+	ip, err := floatingips.Get(context.TODO(), fake.ServiceClient(), "2f245a7b-796b-4f26-9cf9-9e82d248fda7").Extract()
+	th.AssertNoErr(t, err)
+
+	expected := []floatingips.PortForwarding{
+		{
+			Protocol:          "tcp",
+			InternalIPAddress: "10.0.0.11",
+			InternalPort:      25,
+			ExternalPort:      2230,
+		},
+		{
+			Protocol:          "udp",
+			InternalIPAddress: "10.0.0.12",
+			InternalPortRange: "8000:8010",
+			ExternalPortRange: "9000:9010",
+		},
+	}
+	th.AssertDeepEquals(t, expected, ip.PortForwardings)
+}
+
 func TestAssociate(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
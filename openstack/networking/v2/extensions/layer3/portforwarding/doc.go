@@ -44,6 +44,22 @@ Example to Create a Port Forwarding for a floating IP
 		panic(err)
 	}
 
+Example to Create a Port Forwarding for a range of ports
+
+	createOpts := &portforwarding.CreateOpts{
+		Protocol:          "tcp",
+		Description:       "web servers",
+		InternalPortRange: "8000:8010",
+		ExternalPortRange: "9000:9010",
+		InternalIPAddress: internalIP,
+		InternalPortID:    portID,
+	}
+
+	pf, err := portforwarding.Create(context.TODO(), networkingClient, floatingIPID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Update a Port Forwarding
 
 	updateOpts := portforwarding.UpdateOpts{
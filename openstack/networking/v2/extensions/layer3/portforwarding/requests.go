@@ -24,6 +24,7 @@ type ListOpts struct {
 	InternalIPAddress string `q:"internal_ip_address"`
 	Protocol          string `q:"protocol"`
 	InternalPort      string `q:"internal_port"`
+	ExternalPortRange string `q:"external_port_range"`
 	SortKey           string `q:"sort_key"`
 	SortDir           string `q:"sort_dir"`
 	Fields            string `q:"fields"`
@@ -62,14 +63,22 @@ func Get(ctx context.Context, c *gophercloud.ServiceClient, floatingIpId string,
 }
 
 // CreateOpts contains all the values needed to create a new port forwarding
-// resource. All attributes are required.
+// resource. All attributes are required, except Description and either the
+// single ports or the port ranges.
 type CreateOpts struct {
 	Description       string `json:"description,omitempty"`
 	InternalPortID    string `json:"internal_port_id"`
 	InternalIPAddress string `json:"internal_ip_address"`
-	InternalPort      int    `json:"internal_port"`
-	ExternalPort      int    `json:"external_port"`
+	InternalPort      int    `json:"internal_port,omitempty"`
+	ExternalPort      int    `json:"external_port,omitempty"`
 	Protocol          string `json:"protocol"`
+
+	// InternalPortRange and ExternalPortRange forward a range of ports, in
+	// the "first:last" format, instead of a single port. Both ranges must
+	// have the same size. They require the
+	// floating-ip-port-forwarding-port-ranges extension.
+	InternalPortRange string `json:"internal_port_range,omitempty"`
+	ExternalPortRange string `json:"external_port_range,omitempty"`
 }
 
 // CreateOptsBuilder allows extensions to add additional parameters to the
@@ -81,6 +90,10 @@ type CreateOptsBuilder interface {
 // ToPortForwardingCreateMap allows CreateOpts to satisfy the CreateOptsBuilder
 // interface
 func (opts CreateOpts) ToPortForwardingCreateMap() (map[string]any, error) {
+	if err := checkPortsAndRanges(opts.InternalPort, opts.ExternalPort, opts.InternalPortRange, opts.ExternalPortRange); err != nil {
+		return nil, err
+	}
+
 	return gophercloud.BuildRequestBody(opts, "port_forwarding")
 }
 
@@ -105,14 +118,33 @@ type UpdateOpts struct {
 	InternalPort      int     `json:"internal_port,omitempty"`
 	ExternalPort      int     `json:"external_port,omitempty"`
 	Protocol          string  `json:"protocol,omitempty"`
+	InternalPortRange string  `json:"internal_port_range,omitempty"`
+	ExternalPortRange string  `json:"external_port_range,omitempty"`
 }
 
 // ToPortForwardingUpdateMap allows UpdateOpts to satisfy the UpdateOptsBuilder
 // interface
 func (opts UpdateOpts) ToPortForwardingUpdateMap() (map[string]any, error) {
+	if err := checkPortsAndRanges(opts.InternalPort, opts.ExternalPort, opts.InternalPortRange, opts.ExternalPortRange); err != nil {
+		return nil, err
+	}
+
 	return gophercloud.BuildRequestBody(opts, "port_forwarding")
 }
 
+// checkPortsAndRanges returns an error if both a single port and a port
+// range are given.
+func checkPortsAndRanges(internalPort, externalPort int, internalPortRange, externalPortRange string) error {
+	if (internalPort != 0 || externalPort != 0) && (internalPortRange != "" || externalPortRange != "") {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "InternalPortRange/ExternalPortRange"
+		err.Value = internalPortRange + " " + externalPortRange
+		err.Info = "port ranges cannot be combined with InternalPort or ExternalPort"
+		return err
+	}
+	return nil
+}
+
 // UpdateOptsBuilder allows extensions to add additional parameters to the
 // Update request.
 type UpdateOptsBuilder interface {
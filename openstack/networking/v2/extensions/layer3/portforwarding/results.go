@@ -29,6 +29,14 @@ type PortForwarding struct {
 	// The fixed IPv4 address of the Neutron port associated
 	// to the floating IP port forwarding.
 	InternalIPAddress string `json:"internal_ip_address"`
+
+	// The range of port numbers of the Neutron port fixed IP address, in
+	// the "first:last" format, when a range of ports is forwarded.
+	InternalPortRange string `json:"internal_port_range"`
+
+	// The range of port numbers of the floating IP address, in the
+	// "first:last" format, when a range of ports is forwarded.
+	ExternalPortRange string `json:"external_port_range"`
 }
 
 type commonResult struct {
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/layer3/portforwarding"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
@@ -233,3 +234,82 @@ func TestUpdate(t *testing.T) {
 	}
 	th.AssertDeepEquals(t, expected, *actual)
 }
+
+func TestCreateWithPortRanges(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/floatingips/2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e/port_forwardings", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+  "port_forwarding": {
+    "protocol": "tcp",
+    "description": "web servers",
+    "internal_ip_address": "10.0.0.11",
+    "internal_port_id": "1238be08-a2a8-4b8d-addf-fb5e2250e480",
+    "internal_port_range": "8000:8010",
+    "external_port_range": "9000:9010"
+  }
+}
+`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, `
+{
+  "port_forwarding": {
+    "protocol": "tcp",
+    "description": "web servers",
+    "internal_ip_address": "10.0.0.11",
+    "internal_port_id": "1238be08-a2a8-4b8d-addf-fb5e2250e480",
+    "internal_port_range": "8000:8010",
+    "external_port_range": "9000:9010",
+    "id": "725ade3c-9760-4880-8080-8fc2dbab9acc"
+  }
+}`)
+	})
+
+	options := portforwarding.CreateOpts{
+		Description:       "web servers",
+		Protocol:          "tcp",
+		InternalIPAddress: "10.0.0.11",
+		InternalPortID:    "1238be08-a2a8-4b8d-addf-fb5e2250e480",
+		InternalPortRange: "8000:8010",
+		ExternalPortRange: "9000:9010",
+	}
+
+	pf, err := portforwarding.Create(context.TODO(), fake.ServiceClient(), "2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e", options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "725ade3c-9760-4880-8080-8fc2dbab9acc", pf.ID)
+	th.AssertEquals(t, "web servers", pf.Description)
+	th.AssertEquals(t, "8000:8010", pf.InternalPortRange)
+	th.AssertEquals(t, "9000:9010", pf.ExternalPortRange)
+	th.AssertEquals(t, 0, pf.InternalPort)
+}
+
+func TestPortAndPortRangeAreExclusive(t *testing.T) {
+	createOpts := portforwarding.CreateOpts{
+		Protocol:          "tcp",
+		InternalIPAddress: "10.0.0.11",
+		InternalPortID:    "1238be08-a2a8-4b8d-addf-fb5e2250e480",
+		InternalPort:      25,
+		ExternalPortRange: "9000:9010",
+	}
+	_, err := createOpts.ToPortForwardingCreateMap()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("Expected ErrInvalidInput, got %v", err)
+	}
+
+	updateOpts := portforwarding.UpdateOpts{
+		ExternalPort:      2230,
+		InternalPortRange: "8000:8010",
+	}
+	_, err = updateOpts.ToPortForwardingUpdateMap()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("Expected ErrInvalidInput, got %v", err)
+	}
+}
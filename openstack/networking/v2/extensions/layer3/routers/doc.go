@@ -118,6 +118,31 @@ Example to Remove an Interface from a Router
 		panic(err)
 	}
 
+Example to Add an additional External Gateway to a Router
+
+	routerID := "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
+
+	enableSNAT := false
+	gwOpts := routers.ExternalGatewaysOpts{
+		ExternalGateways: []routers.GatewayInfo{
+			{
+				NetworkID:  "3e1b8a9c-6a52-4a0e-9f0b-2c1c8a5c4d2f",
+				EnableSNAT: &enableSNAT,
+				ExternalFixedIPs: []routers.ExternalFixedIP{
+					{SubnetID: "f3b2c1a0-9e8d-4c7b-a6f5-e4d3c2b1a098"},
+				},
+			},
+		},
+	}
+
+	router, err := routers.AddExternalGateways(context.TODO(), networkClient, routerID, gwOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	// The same options can be passed to UpdateExternalGateways and
+	// RemoveExternalGateways.
+
 Example to List an L3 agents for a Router
 
 	routerID := "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
@@ -240,9 +240,95 @@ func RemoveInterface(ctx context.Context, c *gophercloud.ServiceClient, id strin
 	return
 }
 
+// ExternalGatewaysOptsBuilder allows extensions to add additional parameters
+// to the AddExternalGateways, UpdateExternalGateways and
+// RemoveExternalGateways requests.
+type ExternalGatewaysOptsBuilder interface {
+	ToRouterExternalGatewaysMap() (map[string]any, error)
+}
+
+// ExternalGatewaysOpts represents the options for managing the external
+// gateways of a router. It requires the external-gateway-multihoming
+// extension.
+type ExternalGatewaysOpts struct {
+	// ExternalGateways is the list of external gateways to add, update or
+	// remove. Gateways are identified by their NetworkID and, when several
+	// gateways share a network, by their ExternalFixedIPs.
+	ExternalGateways []GatewayInfo `json:"external_gateways" required:"true"`
+}
+
+// ToRouterExternalGatewaysMap builds a request body from
+// ExternalGatewaysOpts.
+func (opts ExternalGatewaysOpts) ToRouterExternalGatewaysMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "router")
+}
+
+// AddExternalGateways adds external gateways to a router. The first gateway
+// added to a router without one becomes its default gateway.
+func AddExternalGateways(ctx context.Context, c *gophercloud.ServiceClient, id string, opts ExternalGatewaysOptsBuilder) (r ExternalGatewaysResult) {
+	b, err := opts.ToRouterExternalGatewaysMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, addExternalGatewaysURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateExternalGateways updates the external gateways of a router, such as
+// their EnableSNAT setting or their ExternalFixedIPs.
+func UpdateExternalGateways(ctx context.Context, c *gophercloud.ServiceClient, id string, opts ExternalGatewaysOptsBuilder) (r ExternalGatewaysResult) {
+	b, err := opts.ToRouterExternalGatewaysMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, updateExternalGatewaysURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// RemoveExternalGateways removes external gateways from a router.
+func RemoveExternalGateways(ctx context.Context, c *gophercloud.ServiceClient, id string, opts ExternalGatewaysOptsBuilder) (r ExternalGatewaysResult) {
+	b, err := opts.ToRouterExternalGatewaysMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, removeExternalGatewaysURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // ListL3Agents returns a list of l3-agents scheduled for a specific router.
 func ListL3Agents(c *gophercloud.ServiceClient, id string) (result pagination.Pager) {
 	return pagination.NewPager(c, listl3AgentsURL(c, id), func(r pagination.PageResult) pagination.Page {
 		return ListL3AgentsPage{pagination.SinglePageBase(r)}
 	})
 }
+
+// IDFromName is a convenience function that returns a router's ID given its
+// name. It returns a gophercloud.ErrResourceNotFound if no router matches the
+// name, and a gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	pages, err := List(client, ListOpts{Name: name}).AllPages(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	all, err := ExtractRouters(pages)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.IDFromName(all, name, "router", func(r Router) (string, string) {
+		return r.Name, r.ID
+	})
+}
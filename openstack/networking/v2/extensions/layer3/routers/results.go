@@ -45,6 +45,11 @@ type Router struct {
 	// GateayInfo provides information on external gateway for the router.
 	GatewayInfo GatewayInfo `json:"external_gateway_info"`
 
+	// ExternalGateways lists all the external gateways of the router when
+	// the external-gateway-multihoming extension is enabled. The first entry
+	// is the same as GatewayInfo.
+	ExternalGateways []GatewayInfo `json:"external_gateways"`
+
 	// AdminStateUp is the administrative state of the router.
 	AdminStateUp bool `json:"admin_state_up"`
 
@@ -151,6 +156,13 @@ type UpdateResult struct {
 	commonResult
 }
 
+// ExternalGatewaysResult represents the result of AddExternalGateways,
+// UpdateExternalGateways and RemoveExternalGateways. Call its Extract method
+// to interpret it as a Router.
+type ExternalGatewaysResult struct {
+	commonResult
+}
+
 // DeleteResult represents the result of a delete operation. Call its ExtractErr
 // method to determine if the request succeeded or failed.
 type DeleteResult struct {
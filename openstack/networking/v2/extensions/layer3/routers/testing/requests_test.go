@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
@@ -550,6 +551,103 @@ func TestRemoveInterface(t *testing.T) {
 	th.AssertEquals(t, "9a83fa11-8da5-436e-9afe-3d3ac5ce7770", res.ID)
 }
 
+func TestExternalGateways(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	routerBody := `
+{
+    "router": {
+        "id": "4e8e5957-649f-477b-9e5b-f1f75b21c03c",
+        "name": "router1",
+        "status": "ACTIVE",
+        "external_gateway_info": {
+            "network_id": "8ca37218-28ff-41cb-9b10-039601ea7e6b",
+            "enable_snat": false,
+            "external_fixed_ips": [
+                {"ip_address": "192.0.2.17", "subnet_id": "ab561bc4-1a8e-48f2-9fbd-376fcb1a1def"}
+            ]
+        },
+        "external_gateways": [
+            {
+                "network_id": "8ca37218-28ff-41cb-9b10-039601ea7e6b",
+                "enable_snat": false,
+                "external_fixed_ips": [
+                    {"ip_address": "192.0.2.17", "subnet_id": "ab561bc4-1a8e-48f2-9fbd-376fcb1a1def"}
+                ]
+            },
+            {
+                "network_id": "3e1b8a9c-6a52-4a0e-9f0b-2c1c8a5c4d2f",
+                "enable_snat": false,
+                "external_fixed_ips": [
+                    {"ip_address": "198.51.100.5", "subnet_id": "f3b2c1a0-9e8d-4c7b-a6f5-e4d3c2b1a098"}
+                ]
+            }
+        ]
+    }
+}
+`
+
+	for _, action := range []string{"add_external_gateways", "update_external_gateways", "remove_external_gateways"} {
+		th.Mux.HandleFunc("/v2.0/routers/4e8e5957-649f-477b-9e5b-f1f75b21c03c/"+action, func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "PUT")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+			th.TestJSONRequest(t, r, `
+{
+    "router": {
+        "external_gateways": [
+            {
+                "network_id": "3e1b8a9c-6a52-4a0e-9f0b-2c1c8a5c4d2f",
+                "enable_snat": false,
+                "external_fixed_ips": [
+                    {"ip_address": "198.51.100.5", "subnet_id": "f3b2c1a0-9e8d-4c7b-a6f5-e4d3c2b1a098"}
+                ]
+            }
+        ]
+    }
+}
+			`)
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, routerBody)
+		})
+	}
+
+	enableSNAT := false
+	opts := routers.ExternalGatewaysOpts{
+		ExternalGateways: []routers.GatewayInfo{
+			{
+				NetworkID:  "3e1b8a9c-6a52-4a0e-9f0b-2c1c8a5c4d2f",
+				EnableSNAT: &enableSNAT,
+				ExternalFixedIPs: []routers.ExternalFixedIP{
+					{IPAddress: "198.51.100.5", SubnetID: "f3b2c1a0-9e8d-4c7b-a6f5-e4d3c2b1a098"},
+				},
+			},
+		},
+	}
+
+	for _, fn := range []func(context.Context, *gophercloud.ServiceClient, string, routers.ExternalGatewaysOptsBuilder) routers.ExternalGatewaysResult{
+		routers.AddExternalGateways,
+		routers.UpdateExternalGateways,
+		routers.RemoveExternalGateways,
+	} {
+		router, err := fn(context.TODO(), fake.ServiceClient(), "4e8e5957-649f-477b-9e5b-f1f75b21c03c", opts).Extract()
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, 2, len(router.ExternalGateways))
+		th.AssertEquals(t, "3e1b8a9c-6a52-4a0e-9f0b-2c1c8a5c4d2f", router.ExternalGateways[1].NetworkID)
+		th.AssertEquals(t, "198.51.100.5", router.ExternalGateways[1].ExternalFixedIPs[0].IPAddress)
+		th.AssertEquals(t, false, *router.ExternalGateways[1].EnableSNAT)
+	}
+}
+
+func TestExternalGatewaysRequiredOpts(t *testing.T) {
+	_, err := routers.AddExternalGateways(context.TODO(), fake.ServiceClient(), "foo", routers.ExternalGatewaysOpts{}).Extract()
+	if err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
 func TestListL3Agents(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -686,3 +784,29 @@ func TestListL3Agents(t *testing.T) {
 	}
 	th.CheckDeepEquals(t, expected, actual)
 }
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/routers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, `
+{
+	"routers": [
+		{"id": "2bc7a2a9-7c2a-4a8c-9f0e-5d4b7a2b1c01", "name": "router-1"},
+		{"id": "7f3c8e1d-51b4-4a8e-8a1e-3d9c0e6f4b02", "name": "router-2"},
+		{"id": "c4d1f0e2-9b7a-4f6c-8d3e-1a2b5c6d7e03", "name": "router-2"}
+	]
+}`)
+	})
+
+	id, err := routers.IDFromName(context.TODO(), fake.ServiceClient(), "router-1")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2bc7a2a9-7c2a-4a8c-9f0e-5d4b7a2b1c01", id)
+}
@@ -0,0 +1,68 @@
+/*
+Package mtu provides the ability to retrieve and manage the MTU of networks
+through the Neutron net-mtu and net-mtu-writable extensions.
+
+Example of Listing Networks with a given MTU
+
+	type NetworkWithMTU struct {
+		networks.Network
+		mtu.NetworkMTUExt
+	}
+
+	var allNetworks []NetworkWithMTU
+
+	iTrue := true
+	networkListOpts := networks.ListOpts{
+		Shared: &iTrue,
+	}
+	listOpts := mtu.ListOptsExt{
+		ListOptsBuilder: networkListOpts,
+		MTU:             1500,
+	}
+
+	allPages, err := networks.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	err = networks.ExtractNetworksInto(allPages, &allNetworks)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, network := range allNetworks {
+		fmt.Printf("%+v\n", network)
+	}
+
+Example of Creating a Network with an explicit MTU
+
+	networkCreateOpts := networks.CreateOpts{
+		Name: "private",
+	}
+
+	createOpts := mtu.CreateOptsExt{
+		CreateOptsBuilder: networkCreateOpts,
+		MTU:               1450,
+	}
+
+	var network NetworkWithMTU
+	err := networks.Create(context.TODO(), networkClient, createOpts).ExtractInto(&network)
+	if err != nil {
+		panic(err)
+	}
+
+Example of Updating the MTU of a Network
+
+	networkID := "db193ab3-96e3-4cb3-8fc5-05f4296d0324"
+
+	var network NetworkWithMTU
+	err := mtu.UpdateMTU(context.TODO(), networkClient, networkID, 9000).ExtractInto(&network)
+	if err != nil {
+		var rejected mtu.ErrMTUChangeRejected
+		if errors.As(err, &rejected) {
+			fmt.Printf("network %s is in use: %s\n", rejected.NetworkID, rejected.Body)
+		}
+		panic(err)
+	}
+*/
+package mtu
@@ -0,0 +1,26 @@
+package mtu
+
+import (
+	"fmt"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// ErrMTUChangeRejected is the error returned by UpdateMTU when the backend
+// refuses to change the MTU of a network, typically because the network is
+// in use and its driver doesn't support changing the MTU of existing ports.
+type ErrMTUChangeRejected struct {
+	gophercloud.ErrUnexpectedResponseCode
+	NetworkID string
+	MTU       int
+}
+
+func (e ErrMTUChangeRejected) Error() string {
+	return fmt.Sprintf("Changing the MTU of network [%s] to %d was rejected: %s", e.NetworkID, e.MTU, e.ErrUnexpectedResponseCode.Error())
+}
+
+// Unwrap returns the underlying ErrUnexpectedResponseCode, so that
+// gophercloud.ResponseCodeIs can be used on an ErrMTUChangeRejected.
+func (e ErrMTUChangeRejected) Unwrap() error {
+	return e.ErrUnexpectedResponseCode
+}
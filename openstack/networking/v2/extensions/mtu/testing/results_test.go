@@ -2,10 +2,12 @@ package testing
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/mtu"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/networks"
@@ -151,3 +153,70 @@ func TestUpdate(t *testing.T) {
 	th.AssertEquals(t, iTrue, s.Shared)
 	th.AssertEquals(t, 1350, s.MTU)
 }
+
+func TestUpdateMTU(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks/4e8e5957-649f-477b-9e5b-f1f75b21c03c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"network": {"mtu": 1350}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UpdateResponse)
+	})
+
+	var s NetworkMTU
+
+	err := mtu.UpdateMTU(context.TODO(), fake.ServiceClient(), "4e8e5957-649f-477b-9e5b-f1f75b21c03c", 1350).ExtractInto(&s)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1350, s.MTU)
+}
+
+func TestUpdateMTURejected(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks/4e8e5957-649f-477b-9e5b-f1f75b21c03c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+
+		fmt.Fprint(w, `{"NeutronError": {"type": "NetworkInUse", "message": "Unable to complete operation on network 4e8e5957-649f-477b-9e5b-f1f75b21c03c. There are one or more ports still in use on the network.", "detail": ""}}`)
+	})
+
+	err := mtu.UpdateMTU(context.TODO(), fake.ServiceClient(), "4e8e5957-649f-477b-9e5b-f1f75b21c03c", 9000).Err
+
+	var rejected mtu.ErrMTUChangeRejected
+	if !errors.As(err, &rejected) {
+		t.Fatalf("Expected ErrMTUChangeRejected but got %v", err)
+	}
+	th.AssertEquals(t, "4e8e5957-649f-477b-9e5b-f1f75b21c03c", rejected.NetworkID)
+	th.AssertEquals(t, 9000, rejected.MTU)
+	th.AssertEquals(t, true, gophercloud.ResponseCodeIs(err, http.StatusConflict))
+}
+
+func TestUpdateMTUOtherError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks/4e8e5957-649f-477b-9e5b-f1f75b21c03c", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	err := mtu.UpdateMTU(context.TODO(), fake.ServiceClient(), "4e8e5957-649f-477b-9e5b-f1f75b21c03c", 9000).Err
+
+	var rejected mtu.ErrMTUChangeRejected
+	th.AssertEquals(t, false, errors.As(err, &rejected))
+	th.AssertEquals(t, true, gophercloud.ResponseCodeIs(err, http.StatusNotFound))
+
+	err = mtu.UpdateMTU(context.TODO(), fake.ServiceClient(), "4e8e5957-649f-477b-9e5b-f1f75b21c03c", 0).Err
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("Expected ErrInvalidInput but got %v", err)
+	}
+}
@@ -0,0 +1,54 @@
+package mtu
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/networks"
+)
+
+// UpdateMTU sets the MTU of an existing network. If the backend rejects the
+// change, either with a conflict or with an invalid input error about the
+// MTU, the result error is an ErrMTUChangeRejected.
+func UpdateMTU(ctx context.Context, c *gophercloud.ServiceClient, networkID string, mtu int) (r networks.UpdateResult) {
+	if mtu <= 0 {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "mtu"
+		err.Value = mtu
+		err.Info = "the MTU must be a positive number"
+		r.Err = err
+		return
+	}
+
+	opts := UpdateOptsExt{
+		UpdateOptsBuilder: networks.UpdateOpts{},
+		MTU:               mtu,
+	}
+	r = networks.Update(ctx, c, networkID, opts)
+
+	var codeError gophercloud.ErrUnexpectedResponseCode
+	if errors.As(r.Err, &codeError) && isMTUChangeRejected(codeError) {
+		r.Err = ErrMTUChangeRejected{
+			ErrUnexpectedResponseCode: codeError,
+			NetworkID:                 networkID,
+			MTU:                       mtu,
+		}
+	}
+	return
+}
+
+// isMTUChangeRejected reports whether an update error was caused by the MTU
+// change. Neutron returns a conflict when a network is in use, and some
+// drivers an invalid input error mentioning the MTU.
+func isMTUChangeRejected(e gophercloud.ErrUnexpectedResponseCode) bool {
+	switch e.Actual {
+	case http.StatusConflict:
+		return true
+	case http.StatusBadRequest:
+		return bytes.Contains(bytes.ToLower(e.Body), []byte("mtu"))
+	}
+	return false
+}
@@ -26,5 +26,16 @@ Example of Getting a single NetworkIPAvailability
 	}
 
 	fmt.Printf("%+v\n", availability)
+
+Example of Getting the Free IP Addresses of each Subnet of a Network
+
+	availability, err := networkipavailabilities.Get(context.TODO(), networkClient, "cf11ab78-2302-49fa-870f-851a08c7afb8").Extract()
+	if err != nil {
+	  panic(err)
+	}
+
+	for _, subnet := range availability.SubnetIPAvailabilities {
+	  fmt.Printf("%s: %s/%s free\n", subnet.CIDR, subnet.FreeIPs(), subnet.TotalIPs)
+	}
 */
 package networkipavailabilities
@@ -72,6 +72,11 @@ func (r *NetworkIPAvailability) UnmarshalJSON(b []byte) error {
 	return err
 }
 
+// FreeIPs returns the number of IP addresses still available in the network.
+func (r NetworkIPAvailability) FreeIPs() string {
+	return freeIPs(r.TotalIPs, r.UsedIPs)
+}
+
 // SubnetIPAvailability represents availability details for a single subnet.
 type SubnetIPAvailability struct {
 	// SubnetID contains an unique identifier of the subnet.
@@ -113,6 +118,25 @@ func (r *SubnetIPAvailability) UnmarshalJSON(b []byte) error {
 	return err
 }
 
+// FreeIPs returns the number of IP addresses still available in the subnet.
+func (r SubnetIPAvailability) FreeIPs() string {
+	return freeIPs(r.TotalIPs, r.UsedIPs)
+}
+
+// freeIPs subtracts the number of used IP addresses from the total number of
+// IP addresses. Both numbers may exceed 64 bits for IPv6 subnets.
+func freeIPs(total, used string) string {
+	t, ok := new(big.Int).SetString(total, 10)
+	if !ok {
+		return ""
+	}
+	u, ok := new(big.Int).SetString(used, 10)
+	if !ok {
+		return ""
+	}
+	return t.Sub(t, u).String()
+}
+
 // NetworkIPAvailabilityPage stores a single page of NetworkIPAvailabilities
 // from the List call.
 type NetworkIPAvailabilityPage struct {
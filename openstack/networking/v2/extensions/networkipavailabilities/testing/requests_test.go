@@ -54,6 +54,35 @@ func TestList(t *testing.T) {
 	}
 }
 
+func TestListFiltered(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/network-ip-availabilities", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"ip_version": "4",
+			"project_id": "424e7cf0243c468ca61732ba45973b3e",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, NetworkIPAvailabilityListResult)
+	})
+
+	allPages, err := networkipavailabilities.List(fake.ServiceClient(), networkipavailabilities.ListOpts{
+		IPVersion: "4",
+		ProjectID: "424e7cf0243c468ca61732ba45973b3e",
+	}).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	actual, err := networkipavailabilities.ExtractNetworkIPAvailabilities(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(actual))
+}
+
 func TestGet(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -77,6 +106,7 @@ func TestGet(t *testing.T) {
 	th.AssertEquals(t, s.TenantID, "424e7cf0243c468ca61732ba45973b3e")
 	th.AssertEquals(t, s.TotalIPs, "253")
 	th.AssertEquals(t, s.UsedIPs, "3")
+	th.AssertEquals(t, s.FreeIPs(), "250")
 	th.AssertDeepEquals(t, s.SubnetIPAvailabilities, []networkipavailabilities.SubnetIPAvailability{
 		{
 			SubnetID:   "4afe6e5f-9649-40db-b18f-64c7ead942bd",
@@ -88,3 +118,11 @@ func TestGet(t *testing.T) {
 		},
 	})
 }
+
+func TestFreeIPsIPv6(t *testing.T) {
+	subnet := networkipavailabilities.SubnetIPAvailability{
+		TotalIPs: "18446744073709551614",
+		UsedIPs:  "2",
+	}
+	th.AssertEquals(t, "18446744073709551612", subnet.FreeIPs())
+}
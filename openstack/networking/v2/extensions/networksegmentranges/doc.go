@@ -0,0 +1,75 @@
+/*
+Package networksegmentranges enables management and retrieval of network
+segment ranges through the Neutron network-segment-range extension. Segment
+ranges control which segmentation IDs, such as VLAN IDs, are allocated to
+the networks of a project.
+
+Example to List Network Segment Ranges
+
+	listOpts := networksegmentranges.ListOpts{
+		NetworkType: "vlan",
+	}
+
+	allPages, err := networksegmentranges.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allRanges, err := networksegmentranges.ExtractNetworkSegmentRanges(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, r := range allRanges {
+		fmt.Printf("%+v\n", r)
+	}
+
+Example to Get a Network Segment Range
+
+	rangeID := "1f7c2e4d-9c49-4b8b-9f6a-1c2b3d4e5f60"
+	r, err := networksegmentranges.Get(context.TODO(), networkClient, rangeID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Network Segment Range for a Project
+
+	shared := false
+	createOpts := networksegmentranges.CreateOpts{
+		Name:            "project-vlans",
+		Shared:          &shared,
+		ProjectID:       "7e02058126cc4950b75f9970368ba177",
+		NetworkType:     "vlan",
+		PhysicalNetwork: "physnet1",
+		Minimum:         100,
+		Maximum:         199,
+	}
+
+	r, err := networksegmentranges.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Network Segment Range
+
+	rangeID := "1f7c2e4d-9c49-4b8b-9f6a-1c2b3d4e5f60"
+
+	updateOpts := networksegmentranges.UpdateOpts{
+		Minimum: 100,
+		Maximum: 299,
+	}
+
+	r, err := networksegmentranges.Update(context.TODO(), networkClient, rangeID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Network Segment Range
+
+	rangeID := "1f7c2e4d-9c49-4b8b-9f6a-1c2b3d4e5f60"
+	err := networksegmentranges.Delete(context.TODO(), networkClient, rangeID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package networksegmentranges
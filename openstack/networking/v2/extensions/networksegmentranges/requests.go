@@ -0,0 +1,196 @@
+package networksegmentranges
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToNetworkSegmentRangeListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the network segment range attributes you want to see returned. SortKey
+// allows you to sort by a particular attribute. SortDir sets the direction,
+// and is either `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID              string `q:"id"`
+	Name            string `q:"name"`
+	Default         *bool  `q:"default"`
+	Shared          *bool  `q:"shared"`
+	ProjectID       string `q:"project_id"`
+	NetworkType     string `q:"network_type"`
+	PhysicalNetwork string `q:"physical_network"`
+	RevisionNumber  *int   `q:"revision_number"`
+	Limit           int    `q:"limit"`
+	Marker          string `q:"marker"`
+	SortKey         string `q:"sort_key"`
+	SortDir         string `q:"sort_dir"`
+	Tags            string `q:"tags"`
+	TagsAny         string `q:"tags-any"`
+	NotTags         string `q:"not-tags"`
+	NotTagsAny      string `q:"not-tags-any"`
+}
+
+// ToNetworkSegmentRangeListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToNetworkSegmentRangeListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// network segment ranges. It accepts a ListOpts struct, which allows you to
+// filter and sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToNetworkSegmentRangeListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return NetworkSegmentRangePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific network segment range based on its unique ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, getURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToNetworkSegmentRangeCreateMap() (map[string]any, error)
+}
+
+// CreateOpts represents the attributes used when creating a new network
+// segment range.
+type CreateOpts struct {
+	// Name is the human-readable name of the range.
+	Name string `json:"name,omitempty"`
+
+	// Description is the human-readable description of the range.
+	Description string `json:"description,omitempty"`
+
+	// Shared makes the range available to all projects. A range which is not
+	// shared must have a ProjectID.
+	Shared *bool `json:"shared,omitempty"`
+
+	// ProjectID is the project the range is reserved for.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// NetworkType is the type of network of the range, such as vlan, vxlan
+	// or geneve.
+	NetworkType string `json:"network_type" required:"true"`
+
+	// PhysicalNetwork is the name of the physical network of a vlan range.
+	PhysicalNetwork string `json:"physical_network,omitempty"`
+
+	// Minimum is the first segmentation ID of the range.
+	Minimum int `json:"minimum" required:"true"`
+
+	// Maximum is the last segmentation ID of the range.
+	Maximum int `json:"maximum" required:"true"`
+}
+
+// ToNetworkSegmentRangeCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToNetworkSegmentRangeCreateMap() (map[string]any, error) {
+	if err := checkBounds(opts.Minimum, opts.Maximum); err != nil {
+		return nil, err
+	}
+
+	return gophercloud.BuildRequestBody(opts, "network_segment_range")
+}
+
+// Create accepts a CreateOpts struct and creates a new network segment range
+// using the values provided.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToNetworkSegmentRangeCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, createURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToNetworkSegmentRangeUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts represents the attributes used when updating an existing
+// network segment range.
+type UpdateOpts struct {
+	// Name is the human-readable name of the range.
+	Name *string `json:"name,omitempty"`
+
+	// Description is the human-readable description of the range.
+	Description *string `json:"description,omitempty"`
+
+	// Minimum is the first segmentation ID of the range.
+	Minimum int `json:"minimum,omitempty"`
+
+	// Maximum is the last segmentation ID of the range.
+	Maximum int `json:"maximum,omitempty"`
+}
+
+// ToNetworkSegmentRangeUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToNetworkSegmentRangeUpdateMap() (map[string]any, error) {
+	if opts.Minimum != 0 && opts.Maximum != 0 {
+		if err := checkBounds(opts.Minimum, opts.Maximum); err != nil {
+			return nil, err
+		}
+	}
+
+	return gophercloud.BuildRequestBody(opts, "network_segment_range")
+}
+
+// Update accepts a UpdateOpts struct and updates an existing network segment
+// range using the values provided.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToNetworkSegmentRangeUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, updateURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete accepts a unique ID and deletes the network segment range associated
+// with it.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, deleteURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// checkBounds returns an error if the minimum of a range is greater than its
+// maximum.
+func checkBounds(minimum, maximum int) error {
+	if minimum > maximum {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "Minimum"
+		err.Value = minimum
+		err.Info = fmt.Sprintf("must not be greater than Maximum (%d)", maximum)
+		return err
+	}
+	return nil
+}
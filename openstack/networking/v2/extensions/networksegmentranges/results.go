@@ -0,0 +1,148 @@
+package networksegmentranges
+
+import (
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a network segment
+// range resource.
+func (r commonResult) Extract() (*NetworkSegmentRange, error) {
+	var s NetworkSegmentRange
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v any) error {
+	return r.Result.ExtractIntoStructPtr(v, "network_segment_range")
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a NetworkSegmentRange.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a NetworkSegmentRange.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a NetworkSegmentRange.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// NetworkSegmentRange represents a range of segmentation IDs.
+type NetworkSegmentRange struct {
+	// ID is the UUID of the range.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the range.
+	Name string `json:"name"`
+
+	// Description is the human-readable description of the range.
+	Description string `json:"description"`
+
+	// Default is true for the range created by the Networking service from
+	// its configuration. It cannot be deleted.
+	Default bool `json:"default"`
+
+	// Shared is true when the range is available to all projects.
+	Shared bool `json:"shared"`
+
+	// ProjectID is the project the range is reserved for.
+	ProjectID string `json:"project_id"`
+
+	// NetworkType is the type of network of the range.
+	NetworkType string `json:"network_type"`
+
+	// PhysicalNetwork is the name of the physical network of a vlan range.
+	PhysicalNetwork string `json:"physical_network"`
+
+	// Minimum is the first segmentation ID of the range.
+	Minimum int `json:"minimum"`
+
+	// Maximum is the last segmentation ID of the range.
+	Maximum int `json:"maximum"`
+
+	// Available lists the segmentation IDs of the range which are not
+	// allocated.
+	Available []int `json:"available"`
+
+	// Used maps the allocated segmentation IDs of the range to the projects
+	// which use them.
+	Used map[string]string `json:"used"`
+
+	// Tags optionally set via extensions/attributestags
+	Tags []string `json:"tags"`
+
+	// RevisionNumber optionally set via extensions/standard-attr-revisions
+	RevisionNumber int `json:"revision_number"`
+
+	// CreatedAt contains an ISO-8601 timestamp of when the range was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt contains an ISO-8601 timestamp of when the range was last
+	// updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NetworkSegmentRangePage is the page returned by a pager when traversing
+// over a collection of network segment ranges.
+type NetworkSegmentRangePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of network segment
+// ranges has reached the end of a page and the pager seeks to traverse over a
+// new one. In order to do this, it needs to construct the next page's URL.
+func (r NetworkSegmentRangePage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"network_segment_ranges_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a NetworkSegmentRangePage struct is empty.
+func (r NetworkSegmentRangePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractNetworkSegmentRanges(r)
+	return len(is) == 0, err
+}
+
+// ExtractNetworkSegmentRanges accepts a Page struct, specifically a
+// NetworkSegmentRangePage struct, and extracts the elements into a slice of
+// NetworkSegmentRange structs.
+func ExtractNetworkSegmentRanges(r pagination.Page) ([]NetworkSegmentRange, error) {
+	var s []NetworkSegmentRange
+	err := ExtractNetworkSegmentRangesInto(r, &s)
+	return s, err
+}
+
+// ExtractNetworkSegmentRangesInto extracts the elements into a slice of
+// NetworkSegmentRange structs.
+func ExtractNetworkSegmentRangesInto(r pagination.Page, v any) error {
+	return r.(NetworkSegmentRangePage).Result.ExtractIntoSlicePtr(v, "network_segment_ranges")
+}
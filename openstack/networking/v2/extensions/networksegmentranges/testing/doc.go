@@ -0,0 +1,2 @@
+// Package testing includes network segment ranges unit tests
+package testing
@@ -0,0 +1,122 @@
+package testing
+
+import (
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/networksegmentranges"
+)
+
+const ListResponse = `
+{
+    "network_segment_ranges": [
+        {
+            "id": "1f7c2e4d-9c49-4b8b-9f6a-1c2b3d4e5f60",
+            "name": "project-vlans",
+            "description": "",
+            "default": false,
+            "shared": false,
+            "project_id": "7e02058126cc4950b75f9970368ba177",
+            "network_type": "vlan",
+            "physical_network": "physnet1",
+            "minimum": 100,
+            "maximum": 103,
+            "available": [101, 102, 103],
+            "used": {
+                "100": "7e02058126cc4950b75f9970368ba177"
+            },
+            "tags": [],
+            "revision_number": 1,
+            "created_at": "2024-03-01T10:00:00Z",
+            "updated_at": "2024-03-01T10:00:00Z"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "network_segment_range": {
+        "id": "1f7c2e4d-9c49-4b8b-9f6a-1c2b3d4e5f60",
+        "name": "project-vlans",
+        "description": "",
+        "default": false,
+        "shared": false,
+        "project_id": "7e02058126cc4950b75f9970368ba177",
+        "network_type": "vlan",
+        "physical_network": "physnet1",
+        "minimum": 100,
+        "maximum": 103,
+        "available": [101, 102, 103],
+        "used": {
+            "100": "7e02058126cc4950b75f9970368ba177"
+        },
+        "tags": [],
+        "revision_number": 1,
+        "created_at": "2024-03-01T10:00:00Z",
+        "updated_at": "2024-03-01T10:00:00Z"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "network_segment_range": {
+        "name": "project-vlans",
+        "shared": false,
+        "project_id": "7e02058126cc4950b75f9970368ba177",
+        "network_type": "vlan",
+        "physical_network": "physnet1",
+        "minimum": 100,
+        "maximum": 103
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "network_segment_range": {
+        "name": "project-vlans-large",
+        "minimum": 100,
+        "maximum": 199
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "network_segment_range": {
+        "id": "1f7c2e4d-9c49-4b8b-9f6a-1c2b3d4e5f60",
+        "name": "project-vlans-large",
+        "description": "",
+        "default": false,
+        "shared": false,
+        "project_id": "7e02058126cc4950b75f9970368ba177",
+        "network_type": "vlan",
+        "physical_network": "physnet1",
+        "minimum": 100,
+        "maximum": 199,
+        "tags": [],
+        "revision_number": 2,
+        "created_at": "2024-03-01T10:00:00Z",
+        "updated_at": "2024-03-02T10:00:00Z"
+    }
+}
+`
+
+var ProjectRange = networksegmentranges.NetworkSegmentRange{
+	ID:              "1f7c2e4d-9c49-4b8b-9f6a-1c2b3d4e5f60",
+	Name:            "project-vlans",
+	ProjectID:       "7e02058126cc4950b75f9970368ba177",
+	NetworkType:     "vlan",
+	PhysicalNetwork: "physnet1",
+	Minimum:         100,
+	Maximum:         103,
+	Available:       []int{101, 102, 103},
+	Used: map[string]string{
+		"100": "7e02058126cc4950b75f9970368ba177",
+	},
+	Tags:           []string{},
+	RevisionNumber: 1,
+	CreatedAt:      time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+	UpdatedAt:      time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+}
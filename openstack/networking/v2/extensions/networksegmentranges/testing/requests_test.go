@@ -0,0 +1,154 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/networksegmentranges"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/network_segment_ranges", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"network_type": "vlan",
+			"shared":       "false",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ListResponse)
+	})
+
+	shared := false
+	listOpts := networksegmentranges.ListOpts{
+		NetworkType: "vlan",
+		Shared:      &shared,
+	}
+
+	count := 0
+	err := networksegmentranges.List(fake.ServiceClient(), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := networksegmentranges.ExtractNetworkSegmentRanges(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []networksegmentranges.NetworkSegmentRange{ProjectRange}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/network_segment_ranges/1f7c2e4d-9c49-4b8b-9f6a-1c2b3d4e5f60", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	r, err := networksegmentranges.Get(context.TODO(), fake.ServiceClient(), "1f7c2e4d-9c49-4b8b-9f6a-1c2b3d4e5f60").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ProjectRange, *r)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/network_segment_ranges", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	shared := false
+	createOpts := networksegmentranges.CreateOpts{
+		Name:            "project-vlans",
+		Shared:          &shared,
+		ProjectID:       "7e02058126cc4950b75f9970368ba177",
+		NetworkType:     "vlan",
+		PhysicalNetwork: "physnet1",
+		Minimum:         100,
+		Maximum:         103,
+	}
+	r, err := networksegmentranges.Create(context.TODO(), fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ProjectRange, *r)
+}
+
+func TestCreateInvalidBounds(t *testing.T) {
+	createOpts := networksegmentranges.CreateOpts{
+		NetworkType: "vxlan",
+		Minimum:     2000,
+		Maximum:     1000,
+	}
+	_, err := createOpts.ToNetworkSegmentRangeCreateMap()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("Expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/network_segment_ranges/1f7c2e4d-9c49-4b8b-9f6a-1c2b3d4e5f60", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UpdateResponse)
+	})
+
+	name := "project-vlans-large"
+	updateOpts := networksegmentranges.UpdateOpts{
+		Name:    &name,
+		Minimum: 100,
+		Maximum: 199,
+	}
+	r, err := networksegmentranges.Update(context.TODO(), fake.ServiceClient(), "1f7c2e4d-9c49-4b8b-9f6a-1c2b3d4e5f60", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "project-vlans-large", r.Name)
+	th.AssertEquals(t, 199, r.Maximum)
+	th.AssertEquals(t, 2, r.RevisionNumber)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/network_segment_ranges/1f7c2e4d-9c49-4b8b-9f6a-1c2b3d4e5f60", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := networksegmentranges.Delete(context.TODO(), fake.ServiceClient(), "1f7c2e4d-9c49-4b8b-9f6a-1c2b3d4e5f60")
+	th.AssertNoErr(t, res.Err)
+}
@@ -1,3 +1,62 @@
-// Package portsbinding provides information and interaction with the port
-// binding extension for the OpenStack Networking service.
+/*
+Package portsbinding provides information and interaction with the port
+binding extension for the OpenStack Networking service.
+
+Example to Get the Binding Details of a Port
+
+	type PortWithBindingExt struct {
+		ports.Port
+		portsbinding.PortsBindingExt
+	}
+
+	var port PortWithBindingExt
+	err := ports.Get(context.TODO(), networkClient, portID).ExtractInto(&port)
+	if err != nil {
+		panic(err)
+	}
+
+	vifDetails, err := port.ExtractVIFDetails()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Port filtering: %v\n", vifDetails.PortFilter)
+
+Example to List the Bindings of a Port
+
+	allPages, err := portsbinding.ListBindings(networkClient, portID, nil).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allBindings, err := portsbinding.ExtractBindings(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, binding := range allBindings {
+		fmt.Printf("%s: %s\n", binding.Host, binding.Status)
+	}
+
+Example to Move the Binding of a Port to Another Host
+
+	createOpts := portsbinding.CreateBindingOpts{
+		Host: "compute-2",
+	}
+
+	_, err := portsbinding.CreateBinding(context.TODO(), networkClient, portID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	_, err = portsbinding.ActivateBinding(context.TODO(), networkClient, portID, "compute-2").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = portsbinding.DeleteBinding(context.TODO(), networkClient, portID, "compute-1").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
 package portsbinding
@@ -1,7 +1,11 @@
 package portsbinding
 
 import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
 
 // CreateOptsExt adds port binding options to the base ports.CreateOpts.
@@ -94,3 +98,94 @@ func (opts UpdateOptsExt) ToPortUpdateMap() (map[string]any, error) {
 
 	return base, nil
 }
+
+// ListBindingsOptsBuilder allows extensions to add additional parameters to
+// the ListBindings request.
+type ListBindingsOptsBuilder interface {
+	ToBindingListQuery() (string, error)
+}
+
+// ListBindingsOpts allows the filtering of port bindings.
+type ListBindingsOpts struct {
+	Host     string `q:"host"`
+	VIFType  string `q:"vif_type"`
+	VNICType string `q:"vnic_type"`
+	Status   string `q:"status"`
+}
+
+// ToBindingListQuery formats a ListBindingsOpts into a query string.
+func (opts ListBindingsOpts) ToBindingListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// ListBindings returns a Pager which allows you to iterate over the bindings
+// of a port.
+func ListBindings(c *gophercloud.ServiceClient, portID string, opts ListBindingsOptsBuilder) pagination.Pager {
+	url := bindingsURL(c, portID)
+	if opts != nil {
+		query, err := opts.ToBindingListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return BindingPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateBindingOptsBuilder allows extensions to add additional parameters to
+// the CreateBinding request.
+type CreateBindingOptsBuilder interface {
+	ToBindingCreateMap() (map[string]any, error)
+}
+
+// CreateBindingOpts represents the attributes used when binding a port to a
+// host.
+type CreateBindingOpts struct {
+	// Host is the host on which the port is bound.
+	Host string `json:"host" required:"true"`
+
+	// VNICType is the virtual network interface card (vNIC) type of the
+	// binding.
+	VNICType string `json:"vnic_type,omitempty"`
+
+	// Profile passes host specific information to the plug-in.
+	Profile map[string]any `json:"profile,omitempty"`
+}
+
+// ToBindingCreateMap builds a request body from CreateBindingOpts.
+func (opts CreateBindingOpts) ToBindingCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "binding")
+}
+
+// CreateBinding creates an inactive binding of a port on a host, usually the
+// destination host of a live migration.
+func CreateBinding(ctx context.Context, c *gophercloud.ServiceClient, portID string, opts CreateBindingOptsBuilder) (r CreateBindingResult) {
+	b, err := opts.ToBindingCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, bindingsURL(c, portID), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ActivateBinding activates the binding of a port on a host. The previously
+// active binding becomes inactive.
+func ActivateBinding(ctx context.Context, c *gophercloud.ServiceClient, portID, host string) (r ActivateBindingResult) {
+	resp, err := c.Put(ctx, activateURL(c, portID, host), nil, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteBinding deletes the inactive binding of a port on a host.
+func DeleteBinding(ctx context.Context, c *gophercloud.ServiceClient, portID, host string) (r DeleteBindingResult) {
+	resp, err := c.Delete(ctx, bindingURL(c, portID, host), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
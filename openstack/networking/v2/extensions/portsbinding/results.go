@@ -1,5 +1,12 @@
 package portsbinding
 
+import (
+	"encoding/json"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
 // PortsBindingExt represents a decorated form of a Port with the additional
 // port binding information.
 type PortsBindingExt struct {
@@ -22,3 +29,189 @@ type PortsBindingExt struct {
 	// information to the plug-in.
 	Profile map[string]any `json:"binding:profile"`
 }
+
+// ExtractVIFDetails interprets the VIFDetails of the port as a VIFDetails
+// struct.
+func (r PortsBindingExt) ExtractVIFDetails() (*VIFDetails, error) {
+	var s VIFDetails
+	err := remarshal(r.VIFDetails, &s)
+	return &s, err
+}
+
+// ExtractProfile interprets the Profile of the port as a Profile struct.
+func (r PortsBindingExt) ExtractProfile() (*Profile, error) {
+	var s Profile
+	err := remarshal(r.Profile, &s)
+	return &s, err
+}
+
+// VIFDetails represents the well known keys of the binding:vif_details
+// attribute. The keys which are set depend on the mechanism driver.
+type VIFDetails struct {
+	// PortFilter is whether the mechanism driver filters the traffic of the
+	// port, through security groups.
+	PortFilter bool `json:"port_filter"`
+
+	// OVSHybridPlug is whether the port is plugged through an intermediate
+	// Linux bridge.
+	OVSHybridPlug bool `json:"ovs_hybrid_plug"`
+
+	// DatapathType is the datapath type of the Open vSwitch bridge.
+	DatapathType string `json:"datapath_type"`
+
+	// BridgeName is the name of the bridge the port is plugged into.
+	BridgeName string `json:"bridge_name"`
+
+	// Connectivity is the connectivity type of the mechanism driver, such as
+	// "l2" or "l3".
+	Connectivity string `json:"connectivity"`
+
+	// BoundDrivers maps binding levels to mechanism driver names.
+	BoundDrivers map[string]string `json:"bound_drivers"`
+
+	// VLAN is the VLAN ID of the port, used by SR-IOV ports.
+	VLAN string `json:"vlan"`
+
+	// PhysicalNetwork is the physical network of the port.
+	PhysicalNetwork string `json:"physical_network"`
+}
+
+// Profile represents the well known keys of the binding:profile attribute.
+type Profile struct {
+	// PCISlot is the PCI address of the virtual function of an SR-IOV port.
+	PCISlot string `json:"pci_slot"`
+
+	// PCIVendorInfo is the vendor and product ID of the virtual function of an
+	// SR-IOV port.
+	PCIVendorInfo string `json:"pci_vendor_info"`
+
+	// PhysicalNetwork is the physical network of an SR-IOV port.
+	PhysicalNetwork string `json:"physical_network"`
+
+	// Capabilities lists the capabilities of the port, such as
+	// "switchdev".
+	Capabilities []string `json:"capabilities"`
+
+	// MigratingTo is the host a port is being migrated to.
+	MigratingTo string `json:"migrating_to"`
+}
+
+// Binding represents a binding of a port on a host.
+type Binding struct {
+	// Host is the host on which the port is bound.
+	Host string `json:"host"`
+
+	// VIFType is the VIF type of the binding.
+	VIFType string `json:"vif_type"`
+
+	// VIFDetails contains mechanism driver specific information about the
+	// binding. Call ExtractVIFDetails to interpret it.
+	VIFDetails map[string]any `json:"vif_details"`
+
+	// VNICType is the virtual network interface card (vNIC) type of the
+	// binding.
+	VNICType string `json:"vnic_type"`
+
+	// Profile contains host specific information passed to the plug-in.
+	// Call ExtractProfile to interpret it.
+	Profile map[string]any `json:"profile"`
+
+	// Status is the status of the binding, either "ACTIVE" or "INACTIVE".
+	Status string `json:"status"`
+}
+
+// ExtractVIFDetails interprets the VIFDetails of the binding as a VIFDetails
+// struct.
+func (r Binding) ExtractVIFDetails() (*VIFDetails, error) {
+	var s VIFDetails
+	err := remarshal(r.VIFDetails, &s)
+	return &s, err
+}
+
+// ExtractProfile interprets the Profile of the binding as a Profile struct.
+func (r Binding) ExtractProfile() (*Profile, error) {
+	var s Profile
+	err := remarshal(r.Profile, &s)
+	return &s, err
+}
+
+func remarshal(m map[string]any, v any) error {
+	if m == nil {
+		return nil
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+type bindingResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Binding.
+func (r bindingResult) Extract() (*Binding, error) {
+	var s struct {
+		Binding *Binding `json:"binding"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Binding, err
+}
+
+// CreateBindingResult represents the result of a CreateBinding operation.
+// Call its Extract method to interpret it as a Binding.
+type CreateBindingResult struct {
+	bindingResult
+}
+
+// ActivateBindingResult represents the result of an ActivateBinding
+// operation. Call its Extract method to interpret it as a Binding.
+type ActivateBindingResult struct {
+	bindingResult
+}
+
+// DeleteBindingResult represents the result of a DeleteBinding operation.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type DeleteBindingResult struct {
+	gophercloud.ErrResult
+}
+
+// BindingPage is the page returned by a pager when traversing over a
+// collection of port bindings.
+type BindingPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of bindings has reached
+// the end of a page and the pager seeks to traverse over a new one.
+func (r BindingPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"bindings_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a BindingPage struct is empty.
+func (r BindingPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractBindings(r)
+	return len(is) == 0, err
+}
+
+// ExtractBindings accepts a Page struct, specifically a BindingPage struct,
+// and extracts the elements into a slice of Binding structs.
+func ExtractBindings(r pagination.Page) ([]Binding, error) {
+	var s struct {
+		Bindings []Binding `json:"bindings"`
+	}
+	err := (r.(BindingPage)).ExtractInto(&s)
+	return s.Bindings, err
+}
@@ -148,3 +148,134 @@ func HandleUpdate(t *testing.T) {
 		`)
 	})
 }
+
+const ListBindingsResponse = `
+{
+    "bindings": [
+        {
+            "host": "compute-1",
+            "vif_type": "ovs",
+            "vif_details": {
+                "port_filter": true,
+                "ovs_hybrid_plug": false,
+                "datapath_type": "system",
+                "bridge_name": "br-int",
+                "connectivity": "l2",
+                "bound_drivers": {
+                    "0": "openvswitch"
+                }
+            },
+            "vnic_type": "normal",
+            "profile": {},
+            "status": "ACTIVE"
+        },
+        {
+            "host": "compute-2",
+            "vif_type": "unbound",
+            "vif_details": {},
+            "vnic_type": "normal",
+            "profile": {
+                "migrating_to": "compute-2"
+            },
+            "status": "INACTIVE"
+        }
+    ]
+}
+`
+
+const CreateBindingRequest = `
+{
+    "binding": {
+        "host": "compute-2",
+        "vnic_type": "normal",
+        "profile": {
+            "migrating_to": "compute-2"
+        }
+    }
+}
+`
+
+const CreateBindingResponse = `
+{
+    "binding": {
+        "host": "compute-2",
+        "vif_type": "unbound",
+        "vif_details": {},
+        "vnic_type": "normal",
+        "profile": {
+            "migrating_to": "compute-2"
+        },
+        "status": "INACTIVE"
+    }
+}
+`
+
+const ActivateBindingResponse = `
+{
+    "binding": {
+        "host": "compute-2",
+        "vif_type": "ovs",
+        "vif_details": {
+            "port_filter": true,
+            "ovs_hybrid_plug": false,
+            "datapath_type": "system",
+            "bridge_name": "br-int",
+            "connectivity": "l2",
+            "bound_drivers": {
+                "0": "openvswitch"
+            }
+        },
+        "vnic_type": "normal",
+        "profile": {},
+        "status": "ACTIVE"
+    }
+}
+`
+
+func HandleListBindings(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/ports/46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2/bindings", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"vnic_type": "normal"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ListBindingsResponse)
+	})
+}
+
+func HandleCreateBinding(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/ports/46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2/bindings", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestJSONRequest(t, r, CreateBindingRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, CreateBindingResponse)
+	})
+}
+
+func HandleActivateBinding(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/ports/46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2/bindings/compute-2/activate", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ActivateBindingResponse)
+	})
+}
+
+func HandleDeleteBinding(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/ports/46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2/bindings/compute-2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
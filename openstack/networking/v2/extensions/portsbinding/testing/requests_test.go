@@ -187,3 +187,80 @@ func TestUpdate(t *testing.T) {
 	th.AssertEquals(t, s.HostID, "HOST1")
 	th.AssertEquals(t, s.VNICType, "normal")
 }
+
+func TestListBindings(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleListBindings(t)
+
+	listOpts := portsbinding.ListBindingsOpts{
+		VNICType: "normal",
+	}
+	allPages, err := portsbinding.ListBindings(fake.ServiceClient(), "46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2", listOpts).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	actual, err := portsbinding.ExtractBindings(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(actual))
+	th.AssertEquals(t, "compute-1", actual[0].Host)
+	th.AssertEquals(t, "ACTIVE", actual[0].Status)
+	th.AssertEquals(t, "INACTIVE", actual[1].Status)
+
+	vifDetails, err := actual[0].ExtractVIFDetails()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &portsbinding.VIFDetails{
+		PortFilter:   true,
+		DatapathType: "system",
+		BridgeName:   "br-int",
+		Connectivity: "l2",
+		BoundDrivers: map[string]string{"0": "openvswitch"},
+	}, vifDetails)
+
+	profile, err := actual[1].ExtractProfile()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "compute-2", profile.MigratingTo)
+}
+
+func TestCreateBinding(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleCreateBinding(t)
+
+	createOpts := portsbinding.CreateBindingOpts{
+		Host:     "compute-2",
+		VNICType: "normal",
+		Profile: map[string]any{
+			"migrating_to": "compute-2",
+		},
+	}
+	binding, err := portsbinding.CreateBinding(context.TODO(), fake.ServiceClient(), "46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2", createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "compute-2", binding.Host)
+	th.AssertEquals(t, "unbound", binding.VIFType)
+	th.AssertEquals(t, "INACTIVE", binding.Status)
+}
+
+func TestActivateBinding(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleActivateBinding(t)
+
+	binding, err := portsbinding.ActivateBinding(context.TODO(), fake.ServiceClient(), "46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2", "compute-2").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "compute-2", binding.Host)
+	th.AssertEquals(t, "ovs", binding.VIFType)
+	th.AssertEquals(t, "ACTIVE", binding.Status)
+}
+
+func TestDeleteBinding(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleDeleteBinding(t)
+
+	res := portsbinding.DeleteBinding(context.TODO(), fake.ServiceClient(), "46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2", "compute-2")
+	th.AssertNoErr(t, res.Err)
+}
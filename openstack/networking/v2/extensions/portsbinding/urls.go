@@ -0,0 +1,15 @@
+package portsbinding
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+func bindingsURL(c *gophercloud.ServiceClient, portID string) string {
+	return c.ServiceURL("ports", portID, "bindings")
+}
+
+func bindingURL(c *gophercloud.ServiceClient, portID, host string) string {
+	return c.ServiceURL("ports", portID, "bindings", host)
+}
+
+func activateURL(c *gophercloud.ServiceClient, portID, host string) string {
+	return c.ServiceURL("ports", portID, "bindings", host, "activate")
+}
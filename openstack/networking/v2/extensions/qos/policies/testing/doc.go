@@ -0,0 +1,2 @@
+// QoS policies unit tests
+package testing
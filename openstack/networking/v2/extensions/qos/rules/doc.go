@@ -40,6 +40,7 @@ Example of Creating a single BandwidthLimitRule
 	opts := rules.CreateBandwidthLimitRuleOpts{
 	    MaxKBps:      2000,
 	    MaxBurstKBps: 200,
+	    Direction:    rules.DirectionEgress,
 	}
 
 	policyID := "501005fa-3b56-4061-aaca-3f24995112e1"
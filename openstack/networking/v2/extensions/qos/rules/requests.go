@@ -7,6 +7,40 @@ import (
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
 
+// Directions of the traffic a bandwidth rule applies to.
+const (
+	DirectionIngress = "ingress"
+	DirectionEgress  = "egress"
+)
+
+// Types of QoS rules, as found in the "type" key of the rules of a policy.
+const (
+	RuleTypeBandwidthLimit   = "bandwidth_limit"
+	RuleTypeDSCPMarking      = "dscp_marking"
+	RuleTypeMinimumBandwidth = "minimum_bandwidth"
+)
+
+// validDSCPMarks are the DSCP marks accepted by the Networking service.
+var validDSCPMarks = map[int]bool{
+	0: true, 8: true, 10: true, 12: true, 14: true, 16: true, 18: true,
+	20: true, 22: true, 24: true, 26: true, 28: true, 30: true, 32: true,
+	34: true, 36: true, 38: true, 40: true, 46: true, 48: true, 56: true,
+}
+
+// checkDSCPMark returns an ErrInvalidInput error if mark is not a DSCP mark
+// accepted by the Networking service.
+func checkDSCPMark(mark int) error {
+	if validDSCPMarks[mark] {
+		return nil
+	}
+
+	err := gophercloud.ErrInvalidInput{}
+	err.Argument = "DSCPMark"
+	err.Value = mark
+	err.Info = "DSCPMark must be 0, 56, or an even number between 8 and 48 except 42 and 44"
+	return err
+}
+
 // ListOptsBuilder allows extensions to add additional parameters to the
 // List request.
 type BandwidthLimitRulesListOptsBuilder interface {
@@ -80,7 +114,8 @@ type CreateBandwidthLimitRuleOpts struct {
 	// MaxBurstKBps is a maximum burst size in kilobits.
 	MaxBurstKBps int `json:"max_burst_kbps,omitempty"`
 
-	// Direction represents the direction of traffic.
+	// Direction represents the direction of traffic, either DirectionIngress
+	// or DirectionEgress.
 	Direction string `json:"direction,omitempty"`
 }
 
@@ -117,7 +152,8 @@ type UpdateBandwidthLimitRuleOpts struct {
 	// MaxBurstKBps is a maximum burst size in kilobits.
 	MaxBurstKBps *int `json:"max_burst_kbps,omitempty"`
 
-	// Direction represents the direction of traffic.
+	// Direction represents the direction of traffic, either DirectionIngress
+	// or DirectionEgress.
 	Direction string `json:"direction,omitempty"`
 }
 
@@ -218,6 +254,10 @@ type CreateDSCPMarkingRuleOpts struct {
 
 // ToDSCPMarkingRuleCreateMap constructs a request body from CreateDSCPMarkingRuleOpts.
 func (opts CreateDSCPMarkingRuleOpts) ToDSCPMarkingRuleCreateMap() (map[string]any, error) {
+	if err := checkDSCPMark(opts.DSCPMark); err != nil {
+		return nil, err
+	}
+
 	return gophercloud.BuildRequestBody(opts, "dscp_marking_rule")
 }
 
@@ -249,6 +289,12 @@ type UpdateDSCPMarkingRuleOpts struct {
 
 // ToDSCPMarkingRuleUpdateMap constructs a request body from UpdateDSCPMarkingRuleOpts.
 func (opts UpdateDSCPMarkingRuleOpts) ToDSCPMarkingRuleUpdateMap() (map[string]any, error) {
+	if opts.DSCPMark != nil {
+		if err := checkDSCPMark(*opts.DSCPMark); err != nil {
+			return nil, err
+		}
+	}
+
 	return gophercloud.BuildRequestBody(opts, "dscp_marking_rule")
 }
 
@@ -342,7 +388,8 @@ type CreateMinimumBandwidthRuleOpts struct {
 	// MaxKBps is a minimum kilobits per second. It's a required parameter.
 	MinKBps int `json:"min_kbps"`
 
-	// Direction represents the direction of traffic.
+	// Direction represents the direction of traffic, either DirectionIngress
+	// or DirectionEgress.
 	Direction string `json:"direction,omitempty"`
 }
 
@@ -376,7 +423,8 @@ type UpdateMinimumBandwidthRuleOpts struct {
 	// MaxKBps is a minimum kilobits per second. It's a required parameter.
 	MinKBps *int `json:"min_kbps,omitempty"`
 
-	// Direction represents the direction of traffic.
+	// Direction represents the direction of traffic, either DirectionIngress
+	// or DirectionEgress.
 	Direction string `json:"direction,omitempty"`
 }
 
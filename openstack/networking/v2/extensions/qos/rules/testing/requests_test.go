@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/qos/rules"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
@@ -277,6 +278,22 @@ func TestUpdateDSCPMarkingRule(t *testing.T) {
 	th.AssertEquals(t, 26, r.DSCPMark)
 }
 
+func TestDSCPMarkingRuleInvalidMark(t *testing.T) {
+	_, err := rules.CreateDSCPMarkingRuleOpts{DSCPMark: 42}.ToDSCPMarkingRuleCreateMap()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+
+	dscpMark := 7
+	_, err = rules.UpdateDSCPMarkingRuleOpts{DSCPMark: &dscpMark}.ToDSCPMarkingRuleUpdateMap()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+
+	_, err = rules.CreateDSCPMarkingRuleOpts{DSCPMark: 0}.ToDSCPMarkingRuleCreateMap()
+	th.AssertNoErr(t, err)
+}
+
 func TestDeleteDSCPMarkingRule(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -15,17 +15,31 @@ before this feature was added.
 
 Example to Create a RBAC Policy
 
-		createOpts := rbacpolicies.CreateOpts{
-			Action:       rbacpolicies.ActionAccessShared,
-			ObjectType:   "network",
-	                TargetTenant: "6e547a3bcfe44702889fdeff3c3520c3",
-	                ObjectID:     "240d22bf-bd17-4238-9758-25f72610ecdc"
-		}
-
-		rbacPolicy, err := rbacpolicies.Create(context.TODO(), rbacClient, createOpts).Extract()
-		if err != nil {
-			panic(err)
-		}
+	createOpts := rbacpolicies.CreateOpts{
+		Action:       rbacpolicies.ActionAccessShared,
+		ObjectType:   rbacpolicies.ObjectTypeNetwork,
+		TargetTenant: "6e547a3bcfe44702889fdeff3c3520c3",
+		ObjectID:     "240d22bf-bd17-4238-9758-25f72610ecdc",
+	}
+
+	rbacPolicy, err := rbacpolicies.Create(context.TODO(), rbacClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Share a Security Group with a Project
+
+	createOpts := rbacpolicies.CreateOpts{
+		Action:       rbacpolicies.ActionAccessShared,
+		ObjectType:   rbacpolicies.ObjectTypeSecurityGroup,
+		TargetTenant: "6e547a3bcfe44702889fdeff3c3520c3",
+		ObjectID:     "85cc3048-abc3-43cc-89b3-377341426ac5",
+	}
+
+	rbacPolicy, err := rbacpolicies.Create(context.TODO(), rbacClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
 
 Example to List RBAC Policies
 
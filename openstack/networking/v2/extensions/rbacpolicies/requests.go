@@ -78,6 +78,16 @@ const (
 	ActionAccessShared PolicyAction = "access_as_shared"
 )
 
+// The types of object which may be shared through a RBAC policy.
+const (
+	ObjectTypeNetwork       = "network"
+	ObjectTypeQoSPolicy     = "qos_policy"
+	ObjectTypeSecurityGroup = "security_group"
+	ObjectTypeAddressScope  = "address_scope"
+	ObjectTypeAddressGroup  = "address_group"
+	ObjectTypeSubnetPool    = "subnetpool"
+)
+
 // CreateOptsBuilder allows extensions to add additional parameters to the
 // Create request.
 type CreateOptsBuilder interface {
@@ -94,6 +104,14 @@ type CreateOpts struct {
 
 // ToRBACPolicyCreateMap builds a request body from CreateOpts.
 func (opts CreateOpts) ToRBACPolicyCreateMap() (map[string]any, error) {
+	if opts.Action == ActionAccessExternal && opts.ObjectType != ObjectTypeNetwork {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "rbacpolicies.CreateOpts.ObjectType"
+		err.Value = opts.ObjectType
+		err.Info = "access_as_external is only supported for networks"
+		return nil, err
+	}
+
 	return gophercloud.BuildRequestBody(opts, "rbac_policy")
 }
 
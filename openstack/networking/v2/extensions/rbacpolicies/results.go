@@ -54,11 +54,12 @@ type RBACPolicy struct {
 
 	// ObjectID is the ID of the object_type resource.
 	// An object_type of network returns a network ID and
-	// object_type of qos-policy returns a QoS ID.
+	// object_type of qos_policy returns a QoS ID.
 	ObjectID string `json:"object_id"`
 
 	// ObjectType is the type of the object that the RBAC policy affects.
-	// Types include qos-policy or network.
+	// Types include network, qos_policy, security_group, address_scope,
+	// address_group and subnetpool.
 	ObjectType string `json:"object_type"`
 
 	// TenantID is the ID of the project that owns the resource.
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/rbacpolicies"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
@@ -40,6 +41,19 @@ func TestCreate(t *testing.T) {
 	th.AssertDeepEquals(t, &rbacPolicy1, rbacResult)
 }
 
+func TestCreateExternalRequiresNetwork(t *testing.T) {
+	options := rbacpolicies.CreateOpts{
+		Action:       rbacpolicies.ActionAccessExternal,
+		ObjectType:   rbacpolicies.ObjectTypeQoSPolicy,
+		TargetTenant: "6e547a3bcfe44702889fdeff3c3520c3",
+		ObjectID:     "240d22bf-bd17-4238-9758-25f72610ecdc",
+	}
+	_, err := rbacpolicies.Create(context.TODO(), fake.ServiceClient(), options).Extract()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("Expected ErrInvalidInput, got %v", err)
+	}
+}
+
 func TestGet(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
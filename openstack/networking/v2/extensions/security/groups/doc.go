@@ -47,6 +47,20 @@ Example to Update a Security Group
 		panic(err)
 	}
 
+Example to Clone a Security Group into Another Project
+
+	groupID := "37d94f8a-d136-465c-ae46-144f0d8ef141"
+
+	cloneOpts := groups.CloneOpts{
+		Name:      "web_template",
+		ProjectID: "966b3c7d36a24facaf20b7e458bf2192",
+	}
+
+	group, err := groups.Clone(context.TODO(), networkClient, groupID, cloneOpts)
+	if err != nil {
+		panic(err)
+	}
+
 Example to Delete a Security Group
 
 	groupID := "37d94f8a-d136-465c-ae46-144f0d8ef141"
@@ -0,0 +1,37 @@
+package groups
+
+import (
+	"fmt"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// ErrClone is returned by Clone when the new security group was created but
+// the rules of the source group could not be copied to it. The new group is
+// deleted before the error is returned.
+type ErrClone struct {
+	gophercloud.BaseError
+
+	// GroupID is the ID of the security group which was created and rolled
+	// back.
+	GroupID string
+
+	// Err is the error which caused the rollback.
+	Err error
+
+	// RollbackErr is the error returned when deleting the security group, if
+	// any. When set, the security group still exists.
+	RollbackErr error
+}
+
+func (e ErrClone) Error() string {
+	if e.RollbackErr != nil {
+		return fmt.Sprintf("Unable to copy rules to security group [%s]: %s; the security group could not be deleted: %s", e.GroupID, e.Err, e.RollbackErr)
+	}
+	return fmt.Sprintf("Unable to copy rules to security group [%s], the security group was deleted: %s", e.GroupID, e.Err)
+}
+
+// Unwrap returns the error which caused the rollback.
+func (e ErrClone) Unwrap() error {
+	return e.Err
+}
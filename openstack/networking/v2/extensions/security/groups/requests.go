@@ -140,3 +140,22 @@ func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r Del
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// IDFromName is a convenience function that returns a security group's ID given its
+// name. It returns a gophercloud.ErrResourceNotFound if no security group matches the
+// name, and a gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	pages, err := List(client, ListOpts{Name: name}).AllPages(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	all, err := ExtractGroups(pages)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.IDFromName(all, name, "security group", func(r SecGroup) (string, string) {
+		return r.Name, r.ID
+	})
+}
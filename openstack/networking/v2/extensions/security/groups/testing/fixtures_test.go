@@ -171,3 +171,170 @@ const SecurityGroupGetResponse = `
     }
 }
 `
+
+const SecurityGroupCloneSourceResponse = `
+{
+    "security_group": {
+        "description": "web tier",
+        "id": "85cc3048-abc3-43cc-89b3-377341426ac5",
+        "name": "webservers",
+        "security_group_rules": [
+            {
+                "direction": "egress",
+                "ethertype": "IPv4",
+                "id": "93aa42e5-80db-4581-9391-3a608bd0e448",
+                "port_range_max": null,
+                "port_range_min": null,
+                "protocol": null,
+                "remote_group_id": null,
+                "remote_ip_prefix": null,
+                "security_group_id": "85cc3048-abc3-43cc-89b3-377341426ac5",
+                "tenant_id": "e4f50856753b4dc6afee5fa6b9b6c550"
+            },
+            {
+                "direction": "ingress",
+                "ethertype": "IPv4",
+                "id": "b1cbb4c5-08b1-4e52-a0f4-8a5e3f3a8f7a",
+                "port_range_max": null,
+                "port_range_min": null,
+                "protocol": null,
+                "remote_group_id": "85cc3048-abc3-43cc-89b3-377341426ac5",
+                "remote_ip_prefix": null,
+                "security_group_id": "85cc3048-abc3-43cc-89b3-377341426ac5",
+                "tenant_id": "e4f50856753b4dc6afee5fa6b9b6c550"
+            },
+            {
+                "description": "ssh",
+                "direction": "ingress",
+                "ethertype": "IPv4",
+                "id": "f3b6c1de-7a2f-4a6b-9a1e-2c1f4b6d8e90",
+                "port_range_max": 22,
+                "port_range_min": 22,
+                "protocol": "tcp",
+                "remote_group_id": null,
+                "remote_ip_prefix": "0.0.0.0/0",
+                "security_group_id": "85cc3048-abc3-43cc-89b3-377341426ac5",
+                "tenant_id": "e4f50856753b4dc6afee5fa6b9b6c550"
+            }
+        ],
+        "tenant_id": "e4f50856753b4dc6afee5fa6b9b6c550",
+        "created_at": "2019-06-30T04:15:37Z",
+        "updated_at": "2019-06-30T05:18:49Z"
+    }
+}
+`
+
+const SecurityGroupCloneRulesRequest = `
+{
+    "security_group_rules": [
+        {
+            "direction": "ingress",
+            "ethertype": "IPv4",
+            "security_group_id": "2076db17-a522-4506-91de-c6dd8e837028",
+            "remote_group_id": "2076db17-a522-4506-91de-c6dd8e837028"
+        },
+        {
+            "description": "ssh",
+            "direction": "ingress",
+            "ethertype": "IPv4",
+            "security_group_id": "2076db17-a522-4506-91de-c6dd8e837028",
+            "port_range_max": 22,
+            "port_range_min": 22,
+            "protocol": "tcp",
+            "remote_ip_prefix": "0.0.0.0/0"
+        }
+    ]
+}
+`
+
+const SecurityGroupCloneRulesResponse = `
+{
+    "security_group_rules": [
+        {
+            "direction": "ingress",
+            "ethertype": "IPv4",
+            "id": "0a9e4f2c-3c7d-4d0e-8a47-5b6f1e2d3c4b",
+            "remote_group_id": "2076db17-a522-4506-91de-c6dd8e837028",
+            "security_group_id": "2076db17-a522-4506-91de-c6dd8e837028",
+            "tenant_id": "e4f50856753b4dc6afee5fa6b9b6c550"
+        },
+        {
+            "description": "ssh",
+            "direction": "ingress",
+            "ethertype": "IPv4",
+            "id": "7d2c9b1a-6e5f-4a3b-8c2d-1e0f9a8b7c6d",
+            "port_range_max": 22,
+            "port_range_min": 22,
+            "protocol": "tcp",
+            "remote_ip_prefix": "0.0.0.0/0",
+            "security_group_id": "2076db17-a522-4506-91de-c6dd8e837028",
+            "tenant_id": "e4f50856753b4dc6afee5fa6b9b6c550"
+        }
+    ]
+}
+`
+
+const SecurityGroupCloneAddressGroupSourceResponse = `
+{
+    "security_group": {
+        "description": "web tier",
+        "id": "85cc3048-abc3-43cc-89b3-377341426ac5",
+        "name": "webservers",
+        "security_group_rules": [
+            {
+                "description": "https from the office",
+                "direction": "ingress",
+                "ethertype": "IPv4",
+                "id": "c5d2e1f0-4b3a-4c9d-8e7f-6a5b4c3d2e1f",
+                "port_range_max": 443,
+                "port_range_min": 443,
+                "protocol": "tcp",
+                "remote_address_group_id": "8722e0e0-9cc9-4490-9660-8c9a5732fbb0",
+                "remote_group_id": null,
+                "remote_ip_prefix": null,
+                "security_group_id": "85cc3048-abc3-43cc-89b3-377341426ac5",
+                "tenant_id": "e4f50856753b4dc6afee5fa6b9b6c550"
+            }
+        ],
+        "tenant_id": "e4f50856753b4dc6afee5fa6b9b6c550",
+        "created_at": "2019-06-30T04:15:37Z",
+        "updated_at": "2019-06-30T05:18:49Z"
+    }
+}
+`
+
+const SecurityGroupCloneAddressGroupRulesRequest = `
+{
+    "security_group_rules": [
+        {
+            "description": "https from the office",
+            "direction": "ingress",
+            "ethertype": "IPv4",
+            "security_group_id": "2076db17-a522-4506-91de-c6dd8e837028",
+            "port_range_max": 443,
+            "port_range_min": 443,
+            "protocol": "tcp",
+            "remote_address_group_id": "8722e0e0-9cc9-4490-9660-8c9a5732fbb0"
+        }
+    ]
+}
+`
+
+const SecurityGroupCloneAddressGroupRulesResponse = `
+{
+    "security_group_rules": [
+        {
+            "description": "https from the office",
+            "direction": "ingress",
+            "ethertype": "IPv4",
+            "id": "9f8e7d6c-5b4a-4392-8170-6f5e4d3c2b1a",
+            "port_range_max": 443,
+            "port_range_min": 443,
+            "protocol": "tcp",
+            "remote_address_group_id": "8722e0e0-9cc9-4490-9660-8c9a5732fbb0",
+            "security_group_id": "2076db17-a522-4506-91de-c6dd8e837028",
+            "tenant_id": "e4f50856753b4dc6afee5fa6b9b6c550"
+        }
+    ]
+}
+`
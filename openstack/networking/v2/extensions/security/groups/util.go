@@ -0,0 +1,114 @@
+package groups
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+)
+
+// CloneOpts contains the values used to create the copy of a security group
+// made by Clone.
+type CloneOpts struct {
+	// Name is the name of the new security group. Defaults to the name of the
+	// source group.
+	Name string
+
+	// Description is the description of the new security group. Defaults to
+	// the description of the source group.
+	Description *string
+
+	// ProjectID is the project which will own the new security group and its
+	// rules. Defaults to the project of the caller. Only administrative users
+	// can specify a project other than their own.
+	ProjectID string
+
+	// Stateful sets whether the new security group is stateful. It is not
+	// copied from the source group, as the attribute is missing on clouds
+	// without the stateful security group extension.
+	Stateful *bool
+}
+
+// Clone creates a new security group with the rules of the security group
+// identified by id. Rules whose remote group is the source group itself are
+// remapped to reference the new group.
+//
+// Rules identical to the default rules Neutron adds to every new security
+// group are not duplicated. If copying the rules fails, the new group is
+// deleted and an ErrClone is returned.
+func Clone(ctx context.Context, c *gophercloud.ServiceClient, id string, opts CloneOpts) (*SecGroup, error) {
+	source, err := Get(ctx, c, id).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	createOpts := CreateOpts{
+		Name:        source.Name,
+		Description: source.Description,
+		ProjectID:   opts.ProjectID,
+	}
+	if opts.Name != "" {
+		createOpts.Name = opts.Name
+	}
+	if opts.Description != nil {
+		createOpts.Description = *opts.Description
+	}
+	if opts.Stateful != nil {
+		createOpts.Stateful = opts.Stateful
+	}
+
+	clone, err := Create(ctx, c, createOpts).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[rules.CreateOpts]bool, len(clone.Rules))
+	for _, rule := range clone.Rules {
+		existing[cloneRuleOpts(rule, id, clone.ID, opts.ProjectID)] = true
+	}
+
+	var ruleOpts []rules.CreateOpts
+	for _, rule := range source.Rules {
+		o := cloneRuleOpts(rule, id, clone.ID, opts.ProjectID)
+		if existing[o] {
+			continue
+		}
+		existing[o] = true
+		ruleOpts = append(ruleOpts, o)
+	}
+
+	if len(ruleOpts) == 0 {
+		return clone, nil
+	}
+
+	if _, err := rules.CreateBulk(ctx, c, ruleOpts).Extract(); err != nil {
+		e := ErrClone{GroupID: clone.ID, Err: err}
+		e.RollbackErr = Delete(context.WithoutCancel(ctx), c, clone.ID).ExtractErr()
+		return nil, e
+	}
+
+	return Get(ctx, c, clone.ID).Extract()
+}
+
+// cloneRuleOpts builds the options needed to recreate rule in the security
+// group cloneID, remapping self-references from sourceID to cloneID.
+func cloneRuleOpts(rule rules.SecGroupRule, sourceID, cloneID, projectID string) rules.CreateOpts {
+	remoteGroupID := rule.RemoteGroupID
+	if remoteGroupID == sourceID {
+		remoteGroupID = cloneID
+	}
+
+	return rules.CreateOpts{
+		Direction:            rules.RuleDirection(rule.Direction),
+		Description:          rule.Description,
+		EtherType:            rules.RuleEtherType(rule.EtherType),
+		SecGroupID:           cloneID,
+		PortRangeMax:         rule.PortRangeMax,
+		PortRangeMin:         rule.PortRangeMin,
+		Protocol:             rules.RuleProtocol(rule.Protocol),
+		RemoteGroupID:        remoteGroupID,
+		RemoteIPPrefix:       rule.RemoteIPPrefix,
+		RemoteAddressGroupID: rule.RemoteAddressGroupID,
+		ProjectID:            projectID,
+	}
+}
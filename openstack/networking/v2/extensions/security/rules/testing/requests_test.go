@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
@@ -222,6 +223,77 @@ func TestCreateAnyProtocol(t *testing.T) {
 	th.AssertNoErr(t, err)
 }
 
+func TestCreateRemoteAddressGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/security-group-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "security_group_rule": {
+        "direction": "ingress",
+        "ethertype": "IPv4",
+        "protocol": "tcp",
+        "port_range_min": 22,
+        "port_range_max": 22,
+        "remote_address_group_id": "8722e0e0-9cc9-4490-9660-8c9a5732fbb0",
+        "security_group_id": "a7734e61-b545-452d-a3cd-0189cbd9747a"
+    }
+}
+      `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, `
+{
+    "security_group_rule": {
+        "direction": "ingress",
+        "ethertype": "IPv4",
+        "id": "2bc0accf-312e-429a-956e-e4407625eb62",
+        "protocol": "tcp",
+        "port_range_max": 22,
+        "port_range_min": 22,
+        "remote_group_id": null,
+        "remote_ip_prefix": null,
+        "remote_address_group_id": "8722e0e0-9cc9-4490-9660-8c9a5732fbb0",
+        "security_group_id": "a7734e61-b545-452d-a3cd-0189cbd9747a",
+        "tenant_id": "e4f50856753b4dc6afee5fa6b9b6c550"
+    }
+}
+    `)
+	})
+
+	opts := rules.CreateOpts{
+		Direction:            "ingress",
+		EtherType:            rules.EtherType4,
+		Protocol:             rules.ProtocolTCP,
+		PortRangeMin:         22,
+		PortRangeMax:         22,
+		RemoteAddressGroupID: "8722e0e0-9cc9-4490-9660-8c9a5732fbb0",
+		SecGroupID:           "a7734e61-b545-452d-a3cd-0189cbd9747a",
+	}
+	rule, err := rules.Create(context.TODO(), fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "8722e0e0-9cc9-4490-9660-8c9a5732fbb0", rule.RemoteAddressGroupID)
+}
+
+func TestCreateMultipleRemotes(t *testing.T) {
+	opts := rules.CreateOpts{
+		Direction:            "ingress",
+		EtherType:            rules.EtherType4,
+		RemoteIPPrefix:       "10.0.0.0/8",
+		RemoteAddressGroupID: "8722e0e0-9cc9-4490-9660-8c9a5732fbb0",
+		SecGroupID:           "a7734e61-b545-452d-a3cd-0189cbd9747a",
+	}
+	res := rules.Create(context.TODO(), fake.ServiceClient(), opts)
+	if _, ok := res.Err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("Expected ErrInvalidInput, got %v", res.Err)
+	}
+}
+
 func TestCreateBulk(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -0,0 +1,86 @@
+/*
+Package segments enables management and retrieval of network segments, which
+are used by routed provider networks, through the Neutron segment extension.
+
+Example to List Segments of a Network
+
+	listOpts := segments.ListOpts{
+		NetworkID: "6ce1e5d4-a6ff-4390-b9ad-40b6a3b3bbfc",
+	}
+
+	allPages, err := segments.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allSegments, err := segments.ExtractSegments(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, segment := range allSegments {
+		fmt.Printf("%+v\n", segment)
+	}
+
+Example to Get a Segment
+
+	segmentID := "a8ddb0ca-4e8d-4f0f-a1fa-eab6b4d4a6bd"
+	segment, err := segments.Get(context.TODO(), networkClient, segmentID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Segment
+
+	createOpts := segments.CreateOpts{
+		NetworkID:       "6ce1e5d4-a6ff-4390-b9ad-40b6a3b3bbfc",
+		Name:            "rack-2",
+		NetworkType:     "vlan",
+		PhysicalNetwork: "physnet-rack-2",
+		SegmentationID:  2016,
+	}
+
+	segment, err := segments.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Segment
+
+	segmentID := "a8ddb0ca-4e8d-4f0f-a1fa-eab6b4d4a6bd"
+
+	description := "Second rack of the datacenter"
+	updateOpts := segments.UpdateOpts{
+		Description: &description,
+	}
+
+	segment, err := segments.Update(context.TODO(), networkClient, segmentID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Segment
+
+	segmentID := "a8ddb0ca-4e8d-4f0f-a1fa-eab6b4d4a6bd"
+	err := segments.Delete(context.TODO(), networkClient, segmentID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the Subnets of a Segment
+
+	listOpts := subnets.ListOpts{
+		SegmentID: "a8ddb0ca-4e8d-4f0f-a1fa-eab6b4d4a6bd",
+	}
+
+	allPages, err := subnets.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allSubnets, err := subnets.ExtractSubnets(allPages)
+	if err != nil {
+		panic(err)
+	}
+*/
+package segments
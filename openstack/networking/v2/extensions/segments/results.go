@@ -0,0 +1,127 @@
+package segments
+
+import (
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a segment resource.
+func (r commonResult) Extract() (*Segment, error) {
+	var s Segment
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v any) error {
+	return r.Result.ExtractIntoStructPtr(v, "segment")
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a Segment.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a Segment.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a Segment.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// Segment represents a segment of a routed provider network.
+type Segment struct {
+	// ID is the UUID of the segment.
+	ID string `json:"id"`
+
+	// NetworkID is the ID of the network the segment belongs to.
+	NetworkID string `json:"network_id"`
+
+	// Name is the human-readable name of the segment.
+	Name string `json:"name"`
+
+	// Description is the human-readable description of the segment.
+	Description string `json:"description"`
+
+	// NetworkType is the type of the physical network.
+	NetworkType string `json:"network_type"`
+
+	// PhysicalNetwork is the name of the physical network the segment is
+	// implemented on.
+	PhysicalNetwork string `json:"physical_network"`
+
+	// SegmentationID is the ID of the segment on the physical network.
+	SegmentationID int `json:"segmentation_id"`
+
+	// RevisionNumber optionally set via extensions/standard-attr-revisions
+	RevisionNumber int `json:"revision_number"`
+
+	// CreatedAt contains an ISO-8601 timestamp of when the segment was
+	// created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt contains an ISO-8601 timestamp of when the segment was last
+	// updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// SegmentPage is the page returned by a pager when traversing over a
+// collection of segments.
+type SegmentPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of segments has reached
+// the end of a page and the pager seeks to traverse over a new one. In order
+// to do this, it needs to construct the next page's URL.
+func (r SegmentPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"segments_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a SegmentPage struct is empty.
+func (r SegmentPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractSegments(r)
+	return len(is) == 0, err
+}
+
+// ExtractSegments accepts a Page struct, specifically a SegmentPage struct,
+// and extracts the elements into a slice of Segment structs. In other words,
+// a generic collection is mapped into a relevant slice.
+func ExtractSegments(r pagination.Page) ([]Segment, error) {
+	var s []Segment
+	err := ExtractSegmentsInto(r, &s)
+	return s, err
+}
+
+// ExtractSegmentsInto extracts the elements into a slice of Segment structs.
+func ExtractSegmentsInto(r pagination.Page, v any) error {
+	return r.(SegmentPage).Result.ExtractIntoSlicePtr(v, "segments")
+}
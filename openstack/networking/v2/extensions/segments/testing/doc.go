@@ -0,0 +1,2 @@
+// Package testing includes segments unit tests
+package testing
@@ -0,0 +1,117 @@
+package testing
+
+import (
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/segments"
+)
+
+const ListResponse = `
+{
+    "segments": [
+        {
+            "id": "a8ddb0ca-4e8d-4f0f-a1fa-eab6b4d4a6bd",
+            "network_id": "6ce1e5d4-a6ff-4390-b9ad-40b6a3b3bbfc",
+            "name": "rack-1",
+            "description": "",
+            "network_type": "vlan",
+            "physical_network": "physnet-rack-1",
+            "segmentation_id": 2016,
+            "revision_number": 1,
+            "created_at": "2024-03-01T10:00:00Z",
+            "updated_at": "2024-03-01T10:00:00Z"
+        },
+        {
+            "id": "c5b8b5a0-7a36-4a4e-9d5f-4b6a1c3e8c2d",
+            "network_id": "6ce1e5d4-a6ff-4390-b9ad-40b6a3b3bbfc",
+            "name": "rack-2",
+            "description": "Second rack",
+            "network_type": "vlan",
+            "physical_network": "physnet-rack-2",
+            "segmentation_id": 2017,
+            "revision_number": 2,
+            "created_at": "2024-03-01T10:05:00Z",
+            "updated_at": "2024-03-02T08:00:00Z"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "segment": {
+        "id": "a8ddb0ca-4e8d-4f0f-a1fa-eab6b4d4a6bd",
+        "network_id": "6ce1e5d4-a6ff-4390-b9ad-40b6a3b3bbfc",
+        "name": "rack-1",
+        "description": "",
+        "network_type": "vlan",
+        "physical_network": "physnet-rack-1",
+        "segmentation_id": 2016,
+        "revision_number": 1,
+        "created_at": "2024-03-01T10:00:00Z",
+        "updated_at": "2024-03-01T10:00:00Z"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "segment": {
+        "network_id": "6ce1e5d4-a6ff-4390-b9ad-40b6a3b3bbfc",
+        "name": "rack-1",
+        "network_type": "vlan",
+        "physical_network": "physnet-rack-1",
+        "segmentation_id": 2016
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "segment": {
+        "description": "First rack"
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "segment": {
+        "id": "a8ddb0ca-4e8d-4f0f-a1fa-eab6b4d4a6bd",
+        "network_id": "6ce1e5d4-a6ff-4390-b9ad-40b6a3b3bbfc",
+        "name": "rack-1",
+        "description": "First rack",
+        "network_type": "vlan",
+        "physical_network": "physnet-rack-1",
+        "segmentation_id": 2016,
+        "revision_number": 2,
+        "created_at": "2024-03-01T10:00:00Z",
+        "updated_at": "2024-03-03T12:00:00Z"
+    }
+}
+`
+
+var Segment1 = segments.Segment{
+	ID:              "a8ddb0ca-4e8d-4f0f-a1fa-eab6b4d4a6bd",
+	NetworkID:       "6ce1e5d4-a6ff-4390-b9ad-40b6a3b3bbfc",
+	Name:            "rack-1",
+	NetworkType:     "vlan",
+	PhysicalNetwork: "physnet-rack-1",
+	SegmentationID:  2016,
+	RevisionNumber:  1,
+	CreatedAt:       time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+	UpdatedAt:       time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+}
+
+var Segment2 = segments.Segment{
+	ID:              "c5b8b5a0-7a36-4a4e-9d5f-4b6a1c3e8c2d",
+	NetworkID:       "6ce1e5d4-a6ff-4390-b9ad-40b6a3b3bbfc",
+	Name:            "rack-2",
+	Description:     "Second rack",
+	NetworkType:     "vlan",
+	PhysicalNetwork: "physnet-rack-2",
+	SegmentationID:  2017,
+	RevisionNumber:  2,
+	CreatedAt:       time.Date(2024, 3, 1, 10, 5, 0, 0, time.UTC),
+	UpdatedAt:       time.Date(2024, 3, 2, 8, 0, 0, 0, time.UTC),
+}
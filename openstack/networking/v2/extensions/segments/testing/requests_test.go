@@ -0,0 +1,140 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/segments"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/segments", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"network_id": "6ce1e5d4-a6ff-4390-b9ad-40b6a3b3bbfc",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ListResponse)
+	})
+
+	count := 0
+	listOpts := segments.ListOpts{
+		NetworkID: "6ce1e5d4-a6ff-4390-b9ad-40b6a3b3bbfc",
+	}
+	err := segments.List(fake.ServiceClient(), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := segments.ExtractSegments(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []segments.Segment{Segment1, Segment2}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/segments/a8ddb0ca-4e8d-4f0f-a1fa-eab6b4d4a6bd", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	s, err := segments.Get(context.TODO(), fake.ServiceClient(), "a8ddb0ca-4e8d-4f0f-a1fa-eab6b4d4a6bd").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, Segment1, *s)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/segments", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	createOpts := segments.CreateOpts{
+		NetworkID:       "6ce1e5d4-a6ff-4390-b9ad-40b6a3b3bbfc",
+		Name:            "rack-1",
+		NetworkType:     "vlan",
+		PhysicalNetwork: "physnet-rack-1",
+		SegmentationID:  2016,
+	}
+	s, err := segments.Create(context.TODO(), fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, Segment1, *s)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	res := segments.Create(context.TODO(), fake.ServiceClient(), segments.CreateOpts{NetworkType: "vlan"})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/segments/a8ddb0ca-4e8d-4f0f-a1fa-eab6b4d4a6bd", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UpdateResponse)
+	})
+
+	description := "First rack"
+	updateOpts := segments.UpdateOpts{
+		Description: &description,
+	}
+	s, err := segments.Update(context.TODO(), fake.ServiceClient(), "a8ddb0ca-4e8d-4f0f-a1fa-eab6b4d4a6bd", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "First rack", s.Description)
+	th.AssertEquals(t, 2, s.RevisionNumber)
+	th.AssertEquals(t, time.Date(2024, 3, 3, 12, 0, 0, 0, time.UTC), s.UpdatedAt)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/segments/a8ddb0ca-4e8d-4f0f-a1fa-eab6b4d4a6bd", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := segments.Delete(context.TODO(), fake.ServiceClient(), "a8ddb0ca-4e8d-4f0f-a1fa-eab6b4d4a6bd")
+	th.AssertNoErr(t, res.Err)
+}
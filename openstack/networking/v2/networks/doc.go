@@ -62,5 +62,12 @@ Example to Delete a Network
 	if err != nil {
 		panic(err)
 	}
+
+Example to Find a Network ID by Name
+
+	networkID, err := networks.IDFromName(context.TODO(), networkClient, "network_1")
+	if err != nil {
+		panic(err)
+	}
 */
 package networks
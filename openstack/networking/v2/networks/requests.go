@@ -164,3 +164,22 @@ func Delete(ctx context.Context, c *gophercloud.ServiceClient, networkID string)
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// IDFromName is a convenience function that returns a network's ID given its
+// name. It returns a gophercloud.ErrResourceNotFound if no network matches the
+// name, and a gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	pages, err := List(client, ListOpts{Name: name}).AllPages(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	all, err := ExtractNetworks(pages)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.IDFromName(all, name, "network", func(r Network) (string, string) {
+		return r.Name, r.ID
+	})
+}
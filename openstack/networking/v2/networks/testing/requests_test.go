@@ -354,3 +354,24 @@ func TestUpdatePortSecurity(t *testing.T) {
 	th.AssertEquals(t, networkWithExtensions.ID, "4e8e5957-649f-477b-9e5b-f1f75b21c03c")
 	th.AssertEquals(t, networkWithExtensions.PortSecurityEnabled, false)
 }
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ListResponse)
+	})
+
+	client := fake.ServiceClient()
+
+	id, err := networks.IDFromName(context.TODO(), client, "private")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "db193ab3-96e3-4cb3-8fc5-05f4296d0324", id)
+}
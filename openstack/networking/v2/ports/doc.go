@@ -62,6 +62,23 @@ Example to Update a Port
 		panic(err)
 	}
 
+Example to Reconcile the Security Groups of a Port
+
+	portID := "c34bae2b-7641-49b6-bf6d-d8e473620ed8"
+
+	ensureOpts := ports.EnsureSecurityGroupsOpts{
+		SecurityGroups:    []string{"f0ac4394-7e4a-4409-9701-ba8be283dbc3"},
+		PreserveUnmanaged: true,
+		Managed:           managedSecurityGroupIDs,
+	}
+
+	port, changes, err := ports.EnsureSecurityGroups(context.TODO(), networkClient, portID, ensureOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("added %v, removed %v\n", changes.Added, changes.Removed)
+
 Example to Delete a Port
 
 	portID := "c34bae2b-7641-49b6-bf6d-d8e473620ed8"
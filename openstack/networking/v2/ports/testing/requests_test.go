@@ -1075,3 +1075,77 @@ func TestPortsListOpts(t *testing.T) {
 		th.AssertEquals(t, expected, actual)
 	}
 }
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, `
+{
+	"ports": [
+		{"id": "2bc7a2a9-7c2a-4a8c-9f0e-5d4b7a2b1c01", "name": "port-1"},
+		{"id": "7f3c8e1d-51b4-4a8e-8a1e-3d9c0e6f4b02", "name": "port-2"},
+		{"id": "c4d1f0e2-9b7a-4f6c-8d3e-1a2b5c6d7e03", "name": "port-2"}
+	]
+}`)
+	})
+
+	id, err := ports.IDFromName(context.TODO(), fake.ServiceClient(), "port-1")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2bc7a2a9-7c2a-4a8c-9f0e-5d4b7a2b1c01", id)
+}
+
+func TestEnsureSecurityGroups(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	updates := 0
+	th.Mux.HandleFunc("/v2.0/ports/65c0ee9f-d634-4522-8954-51021b570b0d", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+
+		switch r.Method {
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, `{"port": {"id": "65c0ee9f-d634-4522-8954-51021b570b0d", "revision_number": 5, "security_groups": ["sg-a", "sg-b", "sg-unmanaged"]}}`)
+		case "PUT":
+			updates++
+			th.TestHeader(t, r, "If-Match", "revision_number=5")
+			th.TestJSONRequest(t, r, `{"port": {"security_groups": ["sg-a", "sg-unmanaged", "sg-c"]}}`)
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, `{"port": {"id": "65c0ee9f-d634-4522-8954-51021b570b0d", "revision_number": 6, "security_groups": ["sg-a", "sg-unmanaged", "sg-c"]}}`)
+		default:
+			t.Fatalf("unexpected method %s", r.Method)
+		}
+	})
+
+	opts := ports.EnsureSecurityGroupsOpts{
+		SecurityGroups:    []string{"sg-a", "sg-c"},
+		PreserveUnmanaged: true,
+		Managed:           []string{"sg-a", "sg-b", "sg-c"},
+	}
+
+	port, changes, err := ports.EnsureSecurityGroups(context.TODO(), fake.ServiceClient(), "65c0ee9f-d634-4522-8954-51021b570b0d", opts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, updates)
+	th.AssertEquals(t, 6, port.RevisionNumber)
+	th.AssertDeepEquals(t, []string{"sg-c"}, changes.Added)
+	th.AssertDeepEquals(t, []string{"sg-b"}, changes.Removed)
+
+	opts = ports.EnsureSecurityGroupsOpts{
+		SecurityGroups: []string{"sg-b", "sg-unmanaged", "sg-a"},
+	}
+
+	port, changes, err = ports.EnsureSecurityGroups(context.TODO(), fake.ServiceClient(), "65c0ee9f-d634-4522-8954-51021b570b0d", opts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, updates)
+	th.AssertEquals(t, 5, port.RevisionNumber)
+	th.AssertEquals(t, 0, len(changes.Added)+len(changes.Removed))
+}
@@ -0,0 +1,92 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// EnsureSecurityGroupsOpts describes the security groups a port must be a
+// member of.
+type EnsureSecurityGroupsOpts struct {
+	// SecurityGroups are the IDs of the security groups the port must be a
+	// member of.
+	SecurityGroups []string
+
+	// PreserveUnmanaged keeps the security groups of the port which are not
+	// listed in Managed. Otherwise the port ends up a member of exactly
+	// SecurityGroups.
+	PreserveUnmanaged bool
+
+	// Managed are the IDs of the security groups under the control of the
+	// caller. It is only used when PreserveUnmanaged is set.
+	Managed []string
+}
+
+// SecurityGroupChanges are the changes EnsureSecurityGroups made to the
+// security groups of a port.
+type SecurityGroupChanges struct {
+	Added   []string
+	Removed []string
+}
+
+// EnsureSecurityGroups compares the security groups of a port with opts and,
+// if they differ, updates the port once with the groups to add and remove.
+// The update is conditioned on the revision number of the port when the
+// Networking service reports one, so that a concurrent modification fails
+// the update instead of being overwritten.
+func EnsureSecurityGroups(ctx context.Context, c *gophercloud.ServiceClient, id string, opts EnsureSecurityGroupsOpts) (*Port, SecurityGroupChanges, error) {
+	var changes SecurityGroupChanges
+
+	port, err := Get(ctx, c, id).Extract()
+	if err != nil {
+		return nil, changes, err
+	}
+
+	wanted := make(map[string]bool, len(opts.SecurityGroups))
+	for _, sg := range opts.SecurityGroups {
+		wanted[sg] = true
+	}
+
+	managed := make(map[string]bool, len(opts.Managed))
+	for _, sg := range opts.Managed {
+		managed[sg] = true
+	}
+
+	current := make(map[string]bool, len(port.SecurityGroups))
+	securityGroups := make([]string, 0, len(port.SecurityGroups)+len(opts.SecurityGroups))
+	for _, sg := range port.SecurityGroups {
+		current[sg] = true
+		if wanted[sg] || (opts.PreserveUnmanaged && !managed[sg]) {
+			securityGroups = append(securityGroups, sg)
+		} else {
+			changes.Removed = append(changes.Removed, sg)
+		}
+	}
+
+	for _, sg := range opts.SecurityGroups {
+		if !current[sg] {
+			current[sg] = true
+			securityGroups = append(securityGroups, sg)
+			changes.Added = append(changes.Added, sg)
+		}
+	}
+
+	if len(changes.Added) == 0 && len(changes.Removed) == 0 {
+		return port, changes, nil
+	}
+
+	updateOpts := UpdateOpts{
+		SecurityGroups: &securityGroups,
+	}
+	if port.RevisionNumber > 0 {
+		updateOpts.RevisionNumber = &port.RevisionNumber
+	}
+
+	port, err = Update(ctx, c, id, updateOpts).Extract()
+	if err != nil {
+		return nil, SecurityGroupChanges{}, err
+	}
+
+	return port, changes, nil
+}
@@ -34,6 +34,7 @@ type ListOpts struct {
 	IPv6RAMode        string `q:"ipv6_ra_mode"`
 	ID                string `q:"id"`
 	SubnetPoolID      string `q:"subnetpool_id"`
+	SegmentID         string `q:"segment_id"`
 	Limit             int    `q:"limit"`
 	Marker            string `q:"marker"`
 	SortKey           string `q:"sort_key"`
@@ -148,6 +149,10 @@ type CreateOpts struct {
 	// overwrite the "default_prefixlen" value of the referenced subnetpool.
 	Prefixlen int `json:"prefixlen,omitempty"`
 
+	// SegmentID is the ID of the network segment the subnet is associated
+	// with, on routed provider networks.
+	SegmentID string `json:"segment_id,omitempty"`
+
 	VPCID string `json:"vpc_id,omitempty"`
 }
 
@@ -217,6 +222,10 @@ type UpdateOpts struct {
 	// EnableDHCP will either enable to disable the DHCP service.
 	EnableDHCP *bool `json:"enable_dhcp,omitempty"`
 
+	// SegmentID associates a subnet which has no segment yet with a network
+	// segment.
+	SegmentID *string `json:"segment_id,omitempty"`
+
 	// RevisionNumber implements extension:standard-attr-revisions. If != "" it
 	// will set revision_number=%s. If the revision number does not match, the
 	// update will fail.
@@ -270,3 +279,22 @@ func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r Del
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// IDFromName is a convenience function that returns a subnet's ID given its
+// name. It returns a gophercloud.ErrResourceNotFound if no subnet matches the
+// name, and a gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	pages, err := List(client, ListOpts{Name: name}).AllPages(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	all, err := ExtractSubnets(pages)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.IDFromName(all, name, "subnet", func(r Subnet) (string, string) {
+		return r.Name, r.ID
+	})
+}
@@ -116,6 +116,10 @@ type Subnet struct {
 	// SubnetPoolID is the id of the subnet pool associated with the subnet.
 	SubnetPoolID string `json:"subnetpool_id"`
 
+	// SegmentID is the ID of the network segment the subnet is associated
+	// with, on routed provider networks.
+	SegmentID string `json:"segment_id"`
+
 	// Tags optionally set via extensions/attributestags
 	Tags []string `json:"tags"`
 
@@ -54,6 +54,48 @@ func TestList(t *testing.T) {
 	}
 }
 
+func TestListBySegment(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"segment_id": "a8ddb0ca-4e8d-4f0f-a1fa-eab6b4d4a6bd",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, `
+{
+    "subnets": [
+        {
+            "id": "54d6f61d-db07-451c-9ab3-b9609b6b6f0b",
+            "name": "rack-1-subnet",
+            "network_id": "6ce1e5d4-a6ff-4390-b9ad-40b6a3b3bbfc",
+            "segment_id": "a8ddb0ca-4e8d-4f0f-a1fa-eab6b4d4a6bd",
+            "cidr": "10.0.1.0/24",
+            "ip_version": 4
+        }
+    ]
+}
+`)
+	})
+
+	listOpts := subnets.ListOpts{
+		SegmentID: "a8ddb0ca-4e8d-4f0f-a1fa-eab6b4d4a6bd",
+	}
+	allPages, err := subnets.List(fake.ServiceClient(), listOpts).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	actual, err := subnets.ExtractSubnets(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(actual))
+	th.AssertEquals(t, "a8ddb0ca-4e8d-4f0f-a1fa-eab6b4d4a6bd", actual[0].SegmentID)
+}
+
 func TestGet(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -673,3 +715,29 @@ func TestDelete(t *testing.T) {
 	res := subnets.Delete(context.TODO(), fake.ServiceClient(), "08eae331-0402-425a-923c-34f7cfe39c1b")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, `
+{
+	"subnets": [
+		{"id": "2bc7a2a9-7c2a-4a8c-9f0e-5d4b7a2b1c01", "name": "subnet-1"},
+		{"id": "7f3c8e1d-51b4-4a8e-8a1e-3d9c0e6f4b02", "name": "subnet-2"},
+		{"id": "c4d1f0e2-9b7a-4f6c-8d3e-1a2b5c6d7e03", "name": "subnet-2"}
+	]
+}`)
+	})
+
+	id, err := subnets.IDFromName(context.TODO(), fake.ServiceClient(), "subnet-1")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2bc7a2a9-7c2a-4a8c-9f0e-5d4b7a2b1c01", id)
+}
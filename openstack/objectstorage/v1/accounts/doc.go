@@ -12,6 +12,17 @@ Example to Get an Account
 	account, err := accounts.Get(context.TODO(), objectStorageClient, nil).Extract()
 	fmt.Printf("%+v\n", account)
 
+Example to List the Metadata Keys of an Account
+
+	keys, err := accounts.Get(context.TODO(), objectStorageClient, nil).ExtractMetadataKeys()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, key := range keys {
+		fmt.Println(key)
+	}
+
 Example to Update an Account
 
 	metadata := map[string]string{
@@ -24,5 +35,17 @@ Example to Update an Account
 
 	updateResult, err := accounts.Update(context.TODO(), objectStorageClient, updateOpts).Extract()
 	fmt.Printf("%+v\n", updateResult)
+
+Example to Set an Account Quota
+
+	quotaBytes := int64(10 * 1024 * 1024 * 1024)
+	updateOpts := accounts.UpdateOpts{
+		QuotaBytes: &quotaBytes,
+	}
+
+	_, err := accounts.Update(context.TODO(), objectStorageClient, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
 */
 package accounts
@@ -62,6 +62,10 @@ type UpdateOpts struct {
 	DetectContentType *bool   `h:"X-Detect-Content-Type"`
 	TempURLKey        string  `h:"X-Account-Meta-Temp-URL-Key"`
 	TempURLKey2       string  `h:"X-Account-Meta-Temp-URL-Key-2"`
+	// QuotaBytes sets the account quota, in bytes. Only reseller admins are
+	// allowed to set it. To remove the quota, add "Quota-Bytes" to
+	// RemoveMetadata.
+	QuotaBytes *int64 `h:"X-Account-Meta-Quota-Bytes"`
 }
 
 // ToAccountUpdateMap formats an UpdateOpts into a map[string]string of headers.
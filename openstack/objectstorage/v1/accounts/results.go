@@ -2,6 +2,7 @@ package accounts
 
 import (
 	"encoding/json"
+	"sort"
 	"strings"
 	"time"
 
@@ -110,3 +111,19 @@ func (r GetResult) ExtractMetadata() (map[string]string, error) {
 	}
 	return metadata, nil
 }
+
+// ExtractMetadataKeys returns the sorted names of the custom metadata
+// associated with the account, without the "X-Account-Meta-" prefix.
+func (r GetResult) ExtractMetadataKeys() ([]string, error) {
+	metadata, err := r.ExtractMetadata()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
@@ -60,3 +60,17 @@ func HandleUpdateAccountSuccessfully(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 }
+
+// HandleUpdateAccountQuotaSuccessfully creates an HTTP handler at `/` on the
+// test handler mux that responds with a `Update` response setting the quota.
+func HandleUpdateAccountQuotaSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "X-Account-Meta-Quota-Bytes", "1024")
+		th.TestHeader(t, r, "X-Account-Meta-Temp-URL-Key-2", "testsecret2")
+
+		w.Header().Set("Date", "Fri, 17 Jan 2014 16:09:56 UTC")
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
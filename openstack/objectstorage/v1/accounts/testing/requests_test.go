@@ -32,6 +32,20 @@ func TestUpdateAccount(t *testing.T) {
 	th.CheckDeepEquals(t, expected, actual)
 }
 
+func TestUpdateAccountQuota(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateAccountQuotaSuccessfully(t)
+
+	var quotaBytes int64 = 1024
+	options := &accounts.UpdateOpts{
+		QuotaBytes:  &quotaBytes,
+		TempURLKey2: "testsecret2",
+	}
+	res := accounts.Update(context.TODO(), fake.ServiceClient(), options)
+	th.AssertNoErr(t, res.Err)
+}
+
 func TestGetAccount(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -42,7 +56,10 @@ func TestGetAccount(t *testing.T) {
 	th.AssertNoErr(t, res.Err)
 	actualMetadata, _ := res.ExtractMetadata()
 	th.CheckDeepEquals(t, expectedMetadata, actualMetadata)
-	_, err := res.Extract()
+	actualKeys, err := res.ExtractMetadataKeys()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"Quota-Bytes", "Subject", "Temp-Url-Key"}, actualKeys)
+	_, err = res.Extract()
 	th.AssertNoErr(t, err)
 
 	var quotaBytes int64 = 42
@@ -51,6 +51,17 @@ Example to List Only Container Names
 		fmt.Printf("%+v\n", container)
 	}
 
+Example to Stream Containers
+
+	containersCh, errs := containers.ListStream(context.TODO(), objectStorageClient, nil, 0)
+	for container := range containersCh {
+		fmt.Printf("%s: %d objects\n", container.Name, container.Count)
+	}
+
+	if err := <-errs; err != nil {
+		panic(err)
+	}
+
 Example to Create a Container
 
 	createOpts := containers.CreateOpts{
@@ -83,6 +94,43 @@ Example to Update a Container
 		panic(err)
 	}
 
+Example to Serve a Container as a Static Website
+
+	read := containers.PublicReadACL
+	index := "index.html"
+	listings := false
+
+	updateOpts := containers.UpdateOpts{
+		ContainerRead: &read,
+		WebIndex:      &index,
+		WebListings:   &listings,
+	}
+
+	container, err := containers.Update(context.TODO(), objectStorageClient, "my_container", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Grant a User Read Access to a Container
+
+	header, err := containers.Get(context.TODO(), objectStorageClient, "my_container", nil).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	acl := header.ReadACL()
+	acl.Users = append(acl.Users, "project-id:user-id")
+	read := acl.String()
+
+	updateOpts := containers.UpdateOpts{
+		ContainerRead: &read,
+	}
+
+	container, err := containers.Update(context.TODO(), objectStorageClient, "my_container", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Delete a Container
 
 	containerName := "my_container"
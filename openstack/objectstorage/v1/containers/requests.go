@@ -61,6 +61,56 @@ func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
 	return pager
 }
 
+// DefaultStreamBufferSize is the number of containers buffered by ListStream
+// when bufferSize is not positive.
+const DefaultStreamBufferSize = 1000
+
+// ListStream lists containers like List, but emits them one by one on the
+// returned containers channel while the following pages are fetched in the
+// background. At most bufferSize containers are held in memory ahead of the
+// consumer.
+//
+// The containers channel is closed once the listing is over. The error of the
+// listing, if any, is then sent on the errors channel, which is closed
+// afterwards. The consumer must either read the containers channel until it
+// is closed or cancel ctx, otherwise the background listing never returns.
+func ListStream(ctx context.Context, c *gophercloud.ServiceClient, opts ListOptsBuilder, bufferSize int) (<-chan Container, <-chan error) {
+	if bufferSize <= 0 {
+		bufferSize = DefaultStreamBufferSize
+	}
+
+	containers := make(chan Container, bufferSize)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+
+		err := List(c, opts).EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
+			info, err := ExtractInfo(page)
+			if err != nil {
+				return false, err
+			}
+
+			for _, container := range info {
+				select {
+				case containers <- container:
+				case <-ctx.Done():
+					return false, ctx.Err()
+				}
+			}
+
+			return true, nil
+		})
+		close(containers)
+
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return containers, errs
+}
+
 // CreateOptsBuilder allows extensions to add additional parameters to the
 // Create request.
 type CreateOptsBuilder interface {
@@ -183,6 +233,13 @@ type UpdateOpts struct {
 	TempURLKey             string  `h:"X-Container-Meta-Temp-URL-Key"`
 	TempURLKey2            string  `h:"X-Container-Meta-Temp-URL-Key-2"`
 	VersionsEnabled        *bool   `h:"X-Versions-Enabled"`
+
+	// The staticweb options serve the container as a website. A pointer to an
+	// empty string removes the corresponding option.
+	WebIndex       *string `h:"X-Container-Meta-Web-Index"`
+	WebError       *string `h:"X-Container-Meta-Web-Error"`
+	WebListings    *bool   `h:"X-Container-Meta-Web-Listings"`
+	WebListingsCSS *string `h:"X-Container-Meta-Web-Listings-CSS"`
 }
 
 // ToContainerUpdateMap formats a UpdateOpts into a map of headers.
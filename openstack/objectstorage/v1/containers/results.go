@@ -113,6 +113,10 @@ type GetHeader struct {
 	VersionsEnabled  bool      `json:"-"`
 	SyncKey          string    `json:"X-Sync-Key"`
 	SyncTo           string    `json:"X-Sync-To"`
+	WebIndex         string    `json:"X-Container-Meta-Web-Index"`
+	WebError         string    `json:"X-Container-Meta-Web-Error"`
+	WebListings      bool      `json:"-"`
+	WebListingsCSS   string    `json:"X-Container-Meta-Web-Listings-Css"`
 }
 
 func (r *GetHeader) UnmarshalJSON(b []byte) error {
@@ -123,6 +127,7 @@ func (r *GetHeader) UnmarshalJSON(b []byte) error {
 		Read            string                  `json:"X-Container-Read"`
 		Date            gophercloud.JSONRFC1123 `json:"Date"`
 		VersionsEnabled string                  `json:"X-Versions-Enabled"`
+		WebListings     string                  `json:"X-Container-Meta-Web-Listings"`
 	}
 
 	err := json.Unmarshal(b, &s)
@@ -143,9 +148,25 @@ func (r *GetHeader) UnmarshalJSON(b []byte) error {
 		r.VersionsEnabled, err = strconv.ParseBool(s.VersionsEnabled)
 	}
 
+	// staticweb accepts the same values as the Swift configuration files
+	switch strings.ToLower(s.WebListings) {
+	case "true", "1", "yes", "on", "t", "y":
+		r.WebListings = true
+	}
+
 	return err
 }
 
+// ReadACL parses the read ACL of the container.
+func (r GetHeader) ReadACL() ACL {
+	return ParseACL(strings.Join(r.Read, ","))
+}
+
+// WriteACL parses the write ACL of the container.
+func (r GetHeader) WriteACL() ACL {
+	return ParseACL(strings.Join(r.Write, ","))
+}
+
 // GetResult represents the result of a get operation.
 type GetResult struct {
 	gophercloud.HeaderResult
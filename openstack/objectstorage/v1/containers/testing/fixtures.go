@@ -260,6 +260,23 @@ func HandleGetContainerSuccessfully(t *testing.T, options ...option) {
 		w.Header().Set("X-Versions-Enabled", "True")
 		w.Header().Set("X-Sync-Key", "272465181849")
 		w.Header().Set("X-Sync-To", "anotherContainer")
+		w.Header().Set("X-Container-Meta-Web-Index", "index.html")
+		w.Header().Set("X-Container-Meta-Web-Listings", "yes")
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleUpdateContainerStaticWeb creates an HTTP handler at `/testContainer` on the test handler mux that
+// responds with a `Update` response setting the staticweb options.
+func HandleUpdateContainerStaticWeb(t *testing.T) {
+	th.Mux.HandleFunc("/testContainer", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "X-Container-Read", ".r:*,.rlistings")
+		th.TestHeader(t, r, "X-Container-Meta-Web-Index", "index.html")
+		th.TestHeader(t, r, "X-Container-Meta-Web-Error", "error.html")
+		th.TestHeader(t, r, "X-Container-Meta-Web-Listings", "true")
+		th.TestHeader(t, r, "X-Container-Meta-Web-Listings-Css", "")
 		w.WriteHeader(http.StatusNoContent)
 	})
 }
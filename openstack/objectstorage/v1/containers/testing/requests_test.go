@@ -98,6 +98,21 @@ func TestListContainerInfo(t *testing.T) {
 	th.CheckEquals(t, 1, count)
 }
 
+func TestListStreamContainerInfo(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListContainerInfoSuccessfully(t)
+
+	containersCh, errs := containers.ListStream(context.TODO(), fake.ServiceClient(), &containers.ListOpts{}, 0)
+
+	var actual []containers.Container
+	for container := range containersCh {
+		actual = append(actual, container)
+	}
+	th.AssertNoErr(t, <-errs)
+	th.CheckDeepEquals(t, ExpectedListInfo, actual)
+}
+
 func TestListAllContainerInfo(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -246,6 +261,8 @@ func TestGetContainer(t *testing.T) {
 		VersionsEnabled: true,
 		SyncKey:         "272465181849",
 		SyncTo:          "anotherContainer",
+		WebIndex:        "index.html",
+		WebListings:     true,
 	}
 	actual, err := res.Extract()
 	th.AssertNoErr(t, err)
@@ -290,3 +307,48 @@ func TestUpdateContainerVersioningOn(t *testing.T) {
 	_, err := containers.Update(context.TODO(), fake.ServiceClient(), "testVersioning", options).Extract()
 	th.AssertNoErr(t, err)
 }
+
+func TestUpdateContainerStaticWeb(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateContainerStaticWeb(t)
+
+	read := containers.PublicReadACL
+	index := "index.html"
+	errorPage := "error.html"
+	listings := true
+	options := containers.UpdateOpts{
+		ContainerRead:  &read,
+		WebIndex:       &index,
+		WebError:       &errorPage,
+		WebListings:    &listings,
+		WebListingsCSS: new(string),
+	}
+	res := containers.Update(context.TODO(), fake.ServiceClient(), "testContainer", options)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestParseACL(t *testing.T) {
+	acl := containers.ParseACL(" .r:*, .referrer:-.bad.example.com,.rlistings,project1:user1,project2:* ")
+	th.AssertDeepEquals(t, containers.ACL{
+		Referrers: []string{"*", "-.bad.example.com"},
+		Listings:  true,
+		Users:     []string{"project1:user1", "project2:*"},
+	}, acl)
+	th.AssertEquals(t, ".r:*,.r:-.bad.example.com,.rlistings,project1:user1,project2:*", acl.String())
+
+	th.AssertDeepEquals(t, containers.ACL{}, containers.ParseACL(""))
+	th.AssertEquals(t, "", containers.ACL{}.String())
+	th.AssertEquals(t, containers.PublicReadACL, containers.ParseACL(containers.PublicReadACL).String())
+}
+
+func TestGetContainerACL(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetContainerSuccessfully(t)
+
+	actual, err := containers.Get(context.TODO(), fake.ServiceClient(), "testContainer", nil).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"test"}, actual.ReadACL().Users)
+	th.AssertDeepEquals(t, []string{"test2", "user4"}, actual.WriteACL().Users)
+}
@@ -0,0 +1,85 @@
+package containers
+
+import (
+	"strings"
+)
+
+// PublicReadACL is a read ACL allowing anyone to read and list the objects of
+// a container.
+const PublicReadACL = ".r:*,.rlistings"
+
+// referrerPrefixes are the prefixes Swift accepts for referrer designations.
+var referrerPrefixes = []string{".r:", ".ref:", ".referer:", ".referrer:"}
+
+// ACL represents the elements of a container read or write ACL, as set with
+// the ContainerRead and ContainerWrite options. Referrers and Listings are
+// only meaningful in a read ACL.
+type ACL struct {
+	// Referrers are the referrer designations, without their ".r:" prefix.
+	// "*" allows any referrer, ".example.com" allows a domain and its
+	// subdomains, and a leading "-" denies the referrer instead.
+	Referrers []string
+
+	// Listings allows listing the objects of the container.
+	Listings bool
+
+	// Users are the project and user designations, such as
+	// "project:user", "project:*", "*:user" or a role name.
+	Users []string
+}
+
+// ParseACL parses the value of an X-Container-Read or X-Container-Write
+// header. Referrer designations are recognised with any of the prefixes
+// accepted by Swift.
+func ParseACL(acl string) ACL {
+	var r ACL
+
+	for _, element := range strings.Split(acl, ",") {
+		element = strings.TrimSpace(element)
+		if element == "" {
+			continue
+		}
+
+		if element == ".rlistings" {
+			r.Listings = true
+			continue
+		}
+
+		if referrer, ok := trimReferrerPrefix(element); ok {
+			r.Referrers = append(r.Referrers, referrer)
+			continue
+		}
+
+		r.Users = append(r.Users, element)
+	}
+
+	return r
+}
+
+// String formats the ACL as the value of an X-Container-Read or
+// X-Container-Write header.
+func (r ACL) String() string {
+	elements := make([]string, 0, len(r.Referrers)+len(r.Users)+1)
+
+	for _, referrer := range r.Referrers {
+		elements = append(elements, ".r:"+referrer)
+	}
+
+	if r.Listings {
+		elements = append(elements, ".rlistings")
+	}
+
+	elements = append(elements, r.Users...)
+
+	return strings.Join(elements, ",")
+}
+
+func trimReferrerPrefix(element string) (string, bool) {
+	lower := strings.ToLower(element)
+	for _, prefix := range referrerPrefixes {
+		if strings.HasPrefix(lower, prefix) {
+			return element[len(prefix):], true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,16 @@
+/*
+Package healthcheck checks the health of an Object Storage proxy through its
+healthcheck middleware. The request is sent to the root of the endpoint and
+does not require authentication.
+
+Example to Check the Health of the Proxy
+
+	err := healthcheck.Get(context.TODO(), objectStorageClient).ExtractErr()
+	if err != nil {
+		if gophercloud.ResponseCodeIs(err, http.StatusServiceUnavailable) {
+			fmt.Println("proxy disabled")
+		}
+		panic(err)
+	}
+*/
+package healthcheck
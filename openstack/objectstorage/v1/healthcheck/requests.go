@@ -0,0 +1,18 @@
+package healthcheck
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// Get checks the health of the Object Storage proxy. It succeeds when the
+// proxy responds with 200, and fails with a 503 error when the proxy has been
+// disabled by its operators.
+func Get(ctx context.Context, c *gophercloud.ServiceClient) (r GetResult) {
+	resp, err := c.Get(ctx, getURL(c), nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,9 @@
+package healthcheck
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+// GetResult is the response from a Get operation. Call its ExtractErr method
+// to determine if the proxy is healthy.
+type GetResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,2 @@
+// healthcheck unit tests
+package testing
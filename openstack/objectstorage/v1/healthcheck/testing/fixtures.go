@@ -0,0 +1,33 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+// HandleGetSuccessfully creates an HTTP handler at `/healthcheck` on the test
+// handler mux that responds with a healthy proxy.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/healthcheck", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, "OK")
+	})
+}
+
+// HandleGetDisabled creates an HTTP handler at `/healthcheck` on the test
+// handler mux that responds with a proxy disabled by file.
+func HandleGetDisabled(t *testing.T) {
+	th.Mux.HandleFunc("/healthcheck", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, "DISABLED BY FILE")
+	})
+}
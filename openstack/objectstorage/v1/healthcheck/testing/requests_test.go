@@ -0,0 +1,42 @@
+package testing
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/objectstorage/v1/healthcheck"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	err := healthcheck.Get(context.TODO(), fake.ServiceClient()).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestGetDisabled(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetDisabled(t)
+
+	err := healthcheck.Get(context.TODO(), fake.ServiceClient()).ExtractErr()
+	th.AssertEquals(t, true, gophercloud.ResponseCodeIs(err, http.StatusServiceUnavailable))
+}
+
+func TestGetURLFromAccountEndpoint(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	client := fake.ServiceClient()
+	client.Endpoint = th.Endpoint() + "v1/AUTH_abc/"
+
+	err := healthcheck.Get(context.TODO(), client).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,13 @@
+package healthcheck
+
+import (
+	"strings"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils"
+)
+
+func getURL(c *gophercloud.ServiceClient) string {
+	baseEndpoint, _ := utils.BaseEndpoint(c.Endpoint)
+	return strings.TrimRight(baseEndpoint, "/") + "/healthcheck"
+}
@@ -30,6 +30,20 @@ Example to List Objects
 		fmt.Printf("%+v\n", object)
 	}
 
+Example to Stream the Objects of a large Container
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	objectsCh, errs := objects.ListStream(ctx, objectStorageClient, "my_container", nil, 1000)
+	for object := range objectsCh {
+		fmt.Printf("%s\n", object.Name)
+	}
+
+	if err := <-errs; err != nil {
+		panic(err)
+	}
+
 Example to List Object Names
 
 	containerName := "my_container"
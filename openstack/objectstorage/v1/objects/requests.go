@@ -98,6 +98,56 @@ func List(c *gophercloud.ServiceClient, containerName string, opts ListOptsBuild
 	return pager
 }
 
+// DefaultStreamBufferSize is the number of objects buffered by ListStream
+// when bufferSize is not positive.
+const DefaultStreamBufferSize = 1000
+
+// ListStream lists the objects of a container like List, but emits them one
+// by one on the returned objects channel while the following pages are
+// fetched in the background. At most bufferSize objects are held in memory
+// ahead of the consumer; fetching pauses until the consumer catches up.
+//
+// The objects channel is closed once the listing is over. The error of the
+// listing, if any, is then sent on the errors channel, which is closed
+// afterwards. The consumer must either read the objects channel until it is
+// closed or cancel ctx, otherwise the background listing never returns.
+func ListStream(ctx context.Context, c *gophercloud.ServiceClient, containerName string, opts ListOptsBuilder, bufferSize int) (<-chan Object, <-chan error) {
+	if bufferSize <= 0 {
+		bufferSize = DefaultStreamBufferSize
+	}
+
+	objects := make(chan Object, bufferSize)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+
+		err := List(c, containerName, opts).EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
+			info, err := ExtractInfo(page)
+			if err != nil {
+				return false, err
+			}
+
+			for _, object := range info {
+				select {
+				case objects <- object:
+				case <-ctx.Done():
+					return false, ctx.Err()
+				}
+			}
+
+			return true, nil
+		})
+		close(objects)
+
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return objects, errs
+}
+
 // DownloadOptsBuilder allows extensions to add additional parameters to the
 // Download request.
 type DownloadOptsBuilder interface {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/md5"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -205,6 +206,41 @@ func TestListObjectInfo(t *testing.T) {
 	th.CheckEquals(t, 1, count)
 }
 
+func TestListStreamObjectInfo(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListObjectsInfoSuccessfully(t)
+
+	objectsCh, errs := objects.ListStream(context.TODO(), fake.ServiceClient(), "testContainer", &objects.ListOpts{}, 1)
+
+	var actual []objects.Object
+	for object := range objectsCh {
+		actual = append(actual, object)
+	}
+	th.AssertNoErr(t, <-errs)
+	th.CheckDeepEquals(t, ExpectedListInfo, actual)
+}
+
+func TestListStreamObjectInfoCancel(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListObjectsInfoSuccessfully(t)
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	objectsCh, errs := objects.ListStream(ctx, fake.ServiceClient(), "testContainer", &objects.ListOpts{}, 1)
+
+	// The buffer only holds one of the two objects, so the listing can't
+	// complete before being cancelled.
+	cancel()
+	for range objectsCh {
+	}
+
+	err := <-errs
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
 func TestListObjectSubdir(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
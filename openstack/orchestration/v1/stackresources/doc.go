@@ -41,6 +41,17 @@ Example for list stack resources
 	    fmt.Println("Resource Name: ", rsrc.Name, ", Physical ID: ", rsrc.PhysicalID, ", Status: ", rsrc.Status)
 	}
 
+Example for list the resources of a stack and its nested stacks
+
+	rsrcs, err := stackresources.ListNested(context.TODO(), client, stack.Name, stack.ID, stackresources.ListNestedOpts{})
+	if err != nil {
+	    panic(err)
+	}
+
+	for _, rsrc := range rsrcs {
+	    fmt.Printf("%s%s: %s\n", strings.Repeat("  ", rsrc.Depth), rsrc.Name, rsrc.Status)
+	}
+
 Example for get resource type schema
 
 	schema_result := stackresources.Schema(context.TODO(), client, "OS::Heat::Stack")
@@ -456,3 +456,80 @@ func HandleMarkUnhealthySuccessfully(t *testing.T) {
 		w.WriteHeader(http.StatusOK)
 	})
 }
+
+// ListNestedTopOutput represents the resources of a stack with a nested
+// stack and a resource group.
+const ListNestedTopOutput = `
+{
+  "resources": [
+    {
+      "resource_name": "network",
+      "links": [],
+      "physical_resource_id": "a1b2c3d4-0000-4000-8000-000000000001",
+      "resource_status": "CREATE_COMPLETE",
+      "resource_type": "OS::Heat::Stack"
+    },
+    {
+      "resource_name": "servers",
+      "links": [
+        {
+          "href": "http://heat.example.com/v1/tenant/stacks/top-servers-xyz/a1b2c3d4-0000-4000-8000-000000000002",
+          "rel": "nested"
+        }
+      ],
+      "physical_resource_id": "a1b2c3d4-0000-4000-8000-000000000002",
+      "resource_status": "CREATE_FAILED",
+      "resource_type": "OS::Heat::ResourceGroup"
+    }
+  ]
+}`
+
+// ListNestedNetworkOutput represents the resources of the "network" nested
+// stack.
+const ListNestedNetworkOutput = `
+{
+  "resources": [
+    {
+      "resource_name": "net",
+      "links": [],
+      "physical_resource_id": "6f0c4e4b-6bcd-4d7a-8e3c-7d1a2b6c9e10",
+      "resource_status": "CREATE_COMPLETE",
+      "resource_type": "OS::Neutron::Net"
+    }
+  ]
+}`
+
+// ListNestedServersOutput represents the resources of the "servers" nested
+// stack.
+const ListNestedServersOutput = `
+{
+  "resources": [
+    {
+      "resource_name": "0",
+      "links": [],
+      "physical_resource_id": "",
+      "resource_status": "CREATE_FAILED",
+      "resource_status_reason": "Quota exceeded",
+      "resource_type": "OS::Nova::Server"
+    }
+  ]
+}`
+
+// HandleListNestedSuccessfully creates HTTP handlers for a stack with two
+// nested stacks.
+func HandleListNestedSuccessfully(t *testing.T) {
+	handle := func(path, output string) {
+		th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, output)
+		})
+	}
+
+	handle("/stacks/top/1d5b5e0c-5f0e-4b4b-9b3e-5d2f6c1a2b3c/resources", ListNestedTopOutput)
+	handle("/stacks/a1b2c3d4-0000-4000-8000-000000000001/resources", ListNestedNetworkOutput)
+	handle("/stacks/a1b2c3d4-0000-4000-8000-000000000002/resources", ListNestedServersOutput)
+}
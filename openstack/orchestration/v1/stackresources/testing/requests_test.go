@@ -124,3 +124,44 @@ func TestMarkUnhealthyResource(t *testing.T) {
 	err := stackresources.MarkUnhealthy(context.TODO(), fake.ServiceClient(), "teststack", "0b1771bd-9336-4f2b-ae86-a80f971faf1e", "wordpress_instance", markUnhealthyOpts).ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestListNestedStackResources(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListNestedSuccessfully(t)
+
+	actual, err := stackresources.ListNested(context.TODO(), fake.ServiceClient(), "top", "1d5b5e0c-5f0e-4b4b-9b3e-5d2f6c1a2b3c", stackresources.ListNestedOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 4, len(actual))
+
+	type node struct {
+		Name    string
+		Status  string
+		StackID string
+		Depth   int
+		Path    []string
+	}
+	nodes := make([]node, len(actual))
+	for i, r := range actual {
+		nodes[i] = node{r.Name, r.Status, r.StackID, r.Depth, r.Path}
+	}
+
+	expected := []node{
+		{"network", "CREATE_COMPLETE", "1d5b5e0c-5f0e-4b4b-9b3e-5d2f6c1a2b3c", 0, []string{"network"}},
+		{"net", "CREATE_COMPLETE", "a1b2c3d4-0000-4000-8000-000000000001", 1, []string{"network", "net"}},
+		{"servers", "CREATE_FAILED", "1d5b5e0c-5f0e-4b4b-9b3e-5d2f6c1a2b3c", 0, []string{"servers"}},
+		{"0", "CREATE_FAILED", "a1b2c3d4-0000-4000-8000-000000000002", 1, []string{"servers", "0"}},
+	}
+	th.CheckDeepEquals(t, expected, nodes)
+	th.CheckEquals(t, "Quota exceeded", actual[3].StatusReason)
+}
+
+func TestListNestedStackResourcesMaxDepth(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListNestedSuccessfully(t)
+
+	actual, err := stackresources.ListNested(context.TODO(), fake.ServiceClient(), "top", "1d5b5e0c-5f0e-4b4b-9b3e-5d2f6c1a2b3c", stackresources.ListNestedOpts{MaxDepth: 1})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 4, len(actual))
+}
@@ -0,0 +1,105 @@
+package stackresources
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// NestedStackType is the resource type of a nested stack.
+const NestedStackType = "OS::Heat::Stack"
+
+// NestedResource is a resource found by ListNested, along with its position
+// in the tree of nested stacks.
+type NestedResource struct {
+	Resource
+
+	// StackID is the ID of the stack owning the resource.
+	StackID string
+
+	// Depth is the nesting level of the resource, 0 for the resources of
+	// the top-level stack.
+	Depth int
+
+	// Path is the names of the resources leading from the top-level stack
+	// to the resource, ending with the name of the resource itself.
+	Path []string
+}
+
+// ListNestedOpts provides options to ListNested.
+type ListNestedOpts struct {
+	// MaxDepth is the number of levels of nested stacks to descend into.
+	// Zero means no limit.
+	MaxDepth int
+}
+
+// IsNestedStack reports whether the resource is a nested stack, either
+// because of its type or because it links to a nested stack, as template
+// and group resources do.
+func (r Resource) IsNestedStack() bool {
+	if r.Type == NestedStackType {
+		return true
+	}
+	for _, link := range r.Links {
+		if link.Rel == "nested" {
+			return true
+		}
+	}
+	return false
+}
+
+// ListNested lists the resources of a stack and, recursively, of its nested
+// stacks. Nested stacks are looked up by the physical ID of their resource.
+// Resources are returned depth first: each nested stack resource is followed
+// by the resources of its stack.
+func ListNested(ctx context.Context, c *gophercloud.ServiceClient, stackName, stackID string, opts ListNestedOpts) ([]NestedResource, error) {
+	allPages, err := List(c, stackName, stackID, nil).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := ExtractResources(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var nested []NestedResource
+	visited := map[string]bool{stackID: true}
+	err = walkNested(ctx, c, stackID, resources, nil, opts, visited, &nested)
+	return nested, err
+}
+
+func walkNested(ctx context.Context, c *gophercloud.ServiceClient, stackID string, resources []Resource, path []string, opts ListNestedOpts, visited map[string]bool, nested *[]NestedResource) error {
+	for _, resource := range resources {
+		resourcePath := make([]string, len(path)+1)
+		copy(resourcePath, path)
+		resourcePath[len(path)] = resource.Name
+
+		*nested = append(*nested, NestedResource{
+			Resource: resource,
+			StackID:  stackID,
+			Depth:    len(path),
+			Path:     resourcePath,
+		})
+
+		if !resource.IsNestedStack() || resource.PhysicalID == "" || visited[resource.PhysicalID] {
+			continue
+		}
+		if opts.MaxDepth > 0 && len(resourcePath) > opts.MaxDepth {
+			continue
+		}
+		visited[resource.PhysicalID] = true
+
+		children, err := Find(ctx, c, resource.PhysicalID).Extract()
+		if err != nil {
+			return err
+		}
+
+		err = walkNested(ctx, c, resource.PhysicalID, children, resourcePath, opts, visited, nested)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -101,6 +101,32 @@ Example to Create an Stack
 	}
 	fmt.Printf("Created Stack: %v", created_stack.ID)
 
+Example to Create a Stack from a Template Directory
+
+	// LoadTemplate and LoadEnvironment resolve the get_file and type
+	// references relative to the template and environment files, and
+	// collect the files sent along with the stack.
+	template, err := stacks.LoadTemplate("templates/stack.yaml")
+	if err != nil {
+	    panic(err)
+	}
+
+	env, err := stacks.LoadEnvironment("templates/env.yaml")
+	if err != nil {
+	    panic(err)
+	}
+
+	createOpts := &stacks.CreateOpts{
+	    Name:            "testing_group",
+	    TemplateOpts:    template,
+	    EnvironmentOpts: env,
+	}
+
+	created_stack, err := stacks.Create(context.TODO(), client, createOpts).Extract()
+	if err != nil {
+	    panic(err)
+	}
+
 Example for Get Stack
 
 	get_result := stacks.Get(context.TODO(), client, stackName, created_stack.ID)
@@ -34,6 +34,49 @@ func (e *Environment) Validate() error {
 	return nil
 }
 
+// LoadEnvironment reads an environment from a local path, relative to the
+// current directory, or from a URL, and resolves its files with
+// ResolveFiles. The result can be used as the EnvironmentOpts of CreateOpts
+// or UpdateOpts.
+func LoadEnvironment(location string) (*Environment, error) {
+	u, err := locationURL(location)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &Environment{TE: TE{URL: u}}
+	if _, err := e.ResolveFiles(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// ResolveFiles fetches and validates the environment, then resolves the
+// templates referenced by its resource registry from local disk or HTTP.
+// Unless the resource registry sets a base_url, references are relative to
+// the location of the environment. References are rewritten to absolute URLs
+// in the environment, and the returned files map holds the contents of every
+// referenced template, keyed by those URLs.
+func (e *Environment) ResolveFiles() (map[string]string, error) {
+	if err := e.Parse(); err != nil {
+		return nil, err
+	}
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	if e.URL != "" {
+		u, err := dirURL(e.URL)
+		if err != nil {
+			return nil, err
+		}
+		e.baseURL = u
+	}
+	if err := e.getRRFileContents(ignoreIfEnvironment); err != nil {
+		return nil, err
+	}
+	return e.Files, nil
+}
+
 // Parse environment file to resolve the URL's of the resources. This is done by
 // reading from the `Resource Registry` section, which is why the function is
 // named GetRRFileContents.
@@ -1,6 +1,9 @@
 package stacks
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
@@ -166,3 +169,27 @@ service_db:
 	th.AssertNoErr(t, env.Parse())
 	th.AssertDeepEquals(t, expectedParsed, env.Parsed)
 }
+
+func TestLoadEnvironment(t *testing.T) {
+	dir := t.TempDir()
+	th.AssertNoErr(t, os.WriteFile(filepath.Join(dir, "env.yaml"), []byte(`parameters:
+  flavor: m1.small
+resource_registry:
+  My::Server: server.yaml
+  OS::Nova::FloatingIP: OS::Neutron::FloatingIP
+`), 0o644))
+	th.AssertNoErr(t, os.WriteFile(filepath.Join(dir, "server.yaml"), []byte("heat_template_version: 2018-08-31\n"), 0o644))
+
+	environment, err := LoadEnvironment(filepath.Join(dir, "env.yaml"))
+	th.AssertNoErr(t, err)
+
+	serverURL := "file://" + filepath.ToSlash(dir) + "/server.yaml"
+	th.AssertDeepEquals(t, map[string]string{serverURL: "heat_template_version: 2018-08-31\n"}, environment.Files)
+	th.AssertEquals(t, true, strings.Contains(string(environment.Bin), serverURL))
+
+	th.AssertNoErr(t, os.WriteFile(filepath.Join(dir, "invalid.yaml"), []byte("resources: {}\n"), 0o644))
+	_, err = LoadEnvironment(filepath.Join(dir, "invalid.yaml"))
+	if _, ok := err.(ErrInvalidEnvironment); !ok {
+		t.Fatalf("Expected ErrInvalidEnvironment but got %v", err)
+	}
+}
@@ -2,8 +2,6 @@ package stacks
 
 import (
 	"fmt"
-	"net/url"
-	"path/filepath"
 	"reflect"
 	"strings"
 
@@ -41,6 +39,41 @@ func (t *Template) Validate() error {
 	return ErrInvalidTemplateFormatVersion{Version: invalid}
 }
 
+// LoadTemplate reads a template from a local path, relative to the current
+// directory, or from a URL, and resolves its files with ResolveFiles. The
+// result can be used as the TemplateOpts of CreateOpts or UpdateOpts.
+func LoadTemplate(location string) (*Template, error) {
+	u, err := locationURL(location)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Template{TE: TE{URL: u}}
+	if _, err := t.ResolveFiles(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ResolveFiles fetches and validates the template, then recursively
+// resolves its get_file and type references from local disk or HTTP, as
+// python-heatclient does. References are relative to the location of the
+// template referencing them. They are rewritten to absolute URLs in the
+// template, and the returned files map holds the contents of every
+// referenced file, keyed by those URLs.
+func (t *Template) ResolveFiles() (map[string]string, error) {
+	if err := t.Parse(); err != nil {
+		return nil, err
+	}
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	if err := t.getFileContents(t.Parsed, ignoreIfTemplate, true); err != nil {
+		return nil, err
+	}
+	return t.Files, nil
+}
+
 func (t *Template) makeChildTemplate(childURL string, ignoreIf igFunc, recurse bool) (*Template, error) {
 	// create a new child template
 	childTemplate := new(Template)
@@ -48,15 +81,17 @@ func (t *Template) makeChildTemplate(childURL string, ignoreIf igFunc, recurse b
 	// initialize child template
 
 	// get the base location of the child template. Child path is relative
-	// to its parent location so that templates can be composed
+	// to its parent location so that templates can be composed. Without a
+	// parent location, e.g. for the resource registry of an environment, the
+	// child path is relative to the base URL of the parent
 	if t.URL != "" {
-		// Preserve all elements of the URL but take the directory part of the path
-		u, err := url.Parse(t.URL)
+		u, err := dirURL(t.URL)
 		if err != nil {
 			return nil, err
 		}
-		u.Path = filepath.Dir(u.Path)
-		childTemplate.baseURL = u.String()
+		childTemplate.baseURL = u
+	} else {
+		childTemplate.baseURL = t.baseURL
 	}
 	childTemplate.URL = childURL
 	childTemplate.client = t.client
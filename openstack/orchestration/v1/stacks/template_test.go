@@ -1,6 +1,8 @@
 package stacks
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -272,3 +274,52 @@ resources:
 		th.AssertDeepEquals(t, expected, checkTe.Parsed)
 	}
 }
+
+func TestLoadTemplate(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"stack.yaml": `heat_template_version: 2018-08-31
+resources:
+  server:
+    type: server.yaml
+  config:
+    type: OS::Heat::SoftwareConfig
+    properties:
+      config: {get_file: scripts/boot.sh}
+`,
+		"server.yaml": `heat_template_version: 2018-08-31
+resources:
+  port:
+    type: nested/port.yaml
+`,
+		"nested/port.yaml": `heat_template_version: 2018-08-31
+outputs:
+  data:
+    value: {get_file: data.txt}
+`,
+		"nested/data.txt": "port data",
+		"scripts/boot.sh": "#!/bin/sh\necho hello\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		th.AssertNoErr(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		th.AssertNoErr(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	template, err := LoadTemplate(filepath.Join(dir, "stack.yaml"))
+	th.AssertNoErr(t, err)
+
+	baseURL := "file://" + filepath.ToSlash(dir)
+	th.AssertEquals(t, 4, len(template.Files))
+	th.AssertEquals(t, files["nested/data.txt"], template.Files[baseURL+"/nested/data.txt"])
+	th.AssertEquals(t, files["scripts/boot.sh"], template.Files[baseURL+"/scripts/boot.sh"])
+	th.AssertEquals(t, true, strings.Contains(template.Files[baseURL+"/server.yaml"], baseURL+"/nested/port.yaml"))
+	th.AssertEquals(t, true, strings.Contains(template.Files[baseURL+"/nested/port.yaml"], baseURL+"/nested/data.txt"))
+	th.AssertEquals(t, true, strings.Contains(string(template.Bin), baseURL+"/server.yaml"))
+	th.AssertEquals(t, true, strings.Contains(string(template.Bin), baseURL+"/scripts/boot.sh"))
+
+	_, err = LoadTemplate(filepath.Join(dir, "missing.yaml"))
+	if err == nil {
+		t.Error("LoadTemplate did not fail on a missing template")
+	}
+}
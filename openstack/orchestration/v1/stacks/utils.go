@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"path/filepath"
 	"reflect"
 
@@ -100,6 +101,27 @@ func getBasePath() (string, error) {
 	return u, nil
 }
 
+// get the URL of a template or environment location, which is either a URL or
+// a local path. Relative paths are left to Fetch, which resolves them against
+// the current directory.
+func locationURL(location string) (string, error) {
+	if filepath.IsAbs(location) {
+		return gophercloud.NormalizePathURL("", location)
+	}
+	return location, nil
+}
+
+// get the URL of the directory containing a template or environment, which
+// preserves all elements of the URL but takes the directory part of the path
+func dirURL(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	u.Path = filepath.Dir(u.Path)
+	return u.String(), nil
+}
+
 // get a an HTTP client to retrieve URL's. This client allows the use of `file`
 // scheme since we may need to fetch files from users filesystem
 func getHTTPClient() Client {
@@ -0,0 +1,72 @@
+/*
+Package allocationcandidates lists the sets of resource providers able to
+satisfy a request for resources in the OpenStack Placement service.
+
+Example to list allocation candidates
+
+	placementClient.Microversion = "1.29"
+
+	listOpts := allocationcandidates.ListOpts{
+		Resources: map[string]int{
+			"VCPU":      2,
+			"MEMORY_MB": 1024,
+			"DISK_GB":   20,
+		},
+		Required:  []string{"HW_CPU_X86_AVX2"},
+		Forbidden: []string{"CUSTOM_MAINTENANCE"},
+		MemberOf:  [][]string{{"42896e0d-205d-4fe9-9d9e-1ac63b0ae6c1", "5e08ea53-c4c6-448e-9334-ac4953de3cfa"}},
+		Limit:     10,
+	}
+
+	candidates, err := allocationcandidates.List(context.TODO(), placementClient, listOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to claim the first allocation candidate
+
+	request := candidates.AllocationRequests[0]
+
+	updateOpts := allocations.UpdateOpts{
+		Allocations: make(map[string]allocations.AllocationOpts, len(request.Allocations)),
+		ProjectID:   projectID,
+		UserID:      userID,
+		NewConsumer: true,
+	}
+	for rpID, allocation := range request.Allocations {
+		updateOpts.Allocations[rpID] = allocations.AllocationOpts{Resources: allocation.Resources}
+	}
+
+	err := allocations.Update(context.TODO(), placementClient, consumerID, updateOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to list allocation candidates with granular request groups
+
+	placementClient.Microversion = "1.34"
+
+	listOpts := allocationcandidates.ListOpts{
+		Resources: map[string]int{
+			"VCPU":      2,
+			"MEMORY_MB": 1024,
+		},
+		Groups: map[string]allocationcandidates.RequestGroup{
+			"1": {
+				Resources: map[string]int{"SRIOV_NET_VF": 1},
+				Required:  []string{"CUSTOM_PHYSNET_PUBLIC"},
+			},
+			"2": {
+				Resources: map[string]int{"SRIOV_NET_VF": 1},
+				Required:  []string{"CUSTOM_PHYSNET_PRIVATE"},
+			},
+		},
+		GroupPolicy: allocationcandidates.GroupPolicyIsolate,
+	}
+
+	candidates, err := allocationcandidates.List(context.TODO(), placementClient, listOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package allocationcandidates
@@ -0,0 +1,198 @@
+package allocationcandidates
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// Group policies controlling how granular request groups share resource
+// providers. They require microversion 1.25 or later.
+const (
+	GroupPolicyNone    = "none"
+	GroupPolicyIsolate = "isolate"
+)
+
+// groupSuffixRegexp matches the suffixes of granular request groups.
+var groupSuffixRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToAllocationCandidateListQuery() (string, error)
+}
+
+// RequestGroup is a set of resources which must be provided by a single
+// resource provider, along with the constraints on that provider.
+type RequestGroup struct {
+	// Resources are the amounts requested, keyed by resource class.
+	Resources map[string]int
+
+	// Required are the traits the resource provider must have.
+	Required []string
+
+	// Forbidden are the traits the resource provider must not have. It
+	// requires microversion 1.22 or later.
+	Forbidden []string
+
+	// MemberOf restricts the resource provider to aggregates. The provider
+	// must be a member of at least one aggregate of every list. Several lists
+	// require microversion 1.24 or later.
+	MemberOf [][]string
+
+	// InTree restricts the resource provider to the tree of the given
+	// resource provider. It requires microversion 1.31 or later.
+	InTree string
+}
+
+// ListOpts describes the resources and constraints of the allocation
+// candidates. The unsuffixed request group may be served by several resource
+// providers of the same tree.
+type ListOpts struct {
+	// Resources are the amounts requested, keyed by resource class.
+	Resources map[string]int
+
+	// Required are the traits the resource providers must have.
+	Required []string
+
+	// Forbidden are the traits the resource providers must not have. It
+	// requires microversion 1.22 or later.
+	Forbidden []string
+
+	// MemberOf restricts the resource providers to aggregates. They must be
+	// a member of at least one aggregate of every list. Several lists
+	// require microversion 1.24 or later.
+	MemberOf [][]string
+
+	// InTree restricts the resource providers to the tree of the given
+	// resource provider. It requires microversion 1.31 or later.
+	InTree string
+
+	// Groups are the granular request groups, keyed by suffix. It requires
+	// microversion 1.25 or later, and 1.33 or later for suffixes which are
+	// not integers.
+	Groups map[string]RequestGroup
+
+	// GroupPolicy is either GroupPolicyNone or GroupPolicyIsolate. It is
+	// required when more than one granular request group is specified.
+	GroupPolicy string `q:"group_policy"`
+
+	// Limit is the maximum number of allocation requests returned. It
+	// requires microversion 1.16 or later.
+	Limit int `q:"limit"`
+}
+
+// ToAllocationCandidateListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToAllocationCandidateListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	params := q.Query()
+
+	unsuffixed := RequestGroup{
+		Resources: opts.Resources,
+		Required:  opts.Required,
+		Forbidden: opts.Forbidden,
+		MemberOf:  opts.MemberOf,
+		InTree:    opts.InTree,
+	}
+	if err := unsuffixed.addParams(params, ""); err != nil {
+		return "", err
+	}
+
+	for suffix, group := range opts.Groups {
+		if !groupSuffixRegexp.MatchString(suffix) {
+			err := gophercloud.ErrInvalidInput{}
+			err.Argument = "allocationcandidates.ListOpts.Groups"
+			err.Value = suffix
+			err.Info = "group suffixes must be 1 to 64 letters, digits, underscores or dashes"
+			return "", err
+		}
+		if len(group.Resources) == 0 {
+			return "", gophercloud.ErrMissingInput{Argument: "allocationcandidates.ListOpts.Groups[" + suffix + "].Resources"}
+		}
+		if err := group.addParams(params, suffix); err != nil {
+			return "", err
+		}
+	}
+
+	if len(opts.Resources) == 0 && len(opts.Groups) == 0 {
+		return "", gophercloud.ErrMissingInput{Argument: "allocationcandidates.ListOpts.Resources"}
+	}
+
+	q = &url.URL{RawQuery: params.Encode()}
+	return q.String(), nil
+}
+
+// addParams adds the query parameters of a request group, suffixed with
+// suffix, to params.
+func (g RequestGroup) addParams(params url.Values, suffix string) error {
+	if len(g.Resources) > 0 {
+		classes := make([]string, 0, len(g.Resources))
+		for class := range g.Resources {
+			classes = append(classes, class)
+		}
+		sort.Strings(classes)
+
+		resources := make([]string, len(classes))
+		for i, class := range classes {
+			amount := g.Resources[class]
+			if amount <= 0 {
+				err := gophercloud.ErrInvalidInput{}
+				err.Argument = "allocationcandidates.RequestGroup.Resources"
+				err.Value = amount
+				err.Info = fmt.Sprintf("the amount of %s must be positive", class)
+				return err
+			}
+			resources[i] = fmt.Sprintf("%s:%d", class, amount)
+		}
+		params.Add("resources"+suffix, strings.Join(resources, ","))
+	}
+
+	traits := make([]string, 0, len(g.Required)+len(g.Forbidden))
+	traits = append(traits, g.Required...)
+	for _, trait := range g.Forbidden {
+		traits = append(traits, "!"+trait)
+	}
+	if len(traits) > 0 {
+		params.Add("required"+suffix, strings.Join(traits, ","))
+	}
+
+	for _, aggregates := range g.MemberOf {
+		switch len(aggregates) {
+		case 0:
+			continue
+		case 1:
+			params.Add("member_of"+suffix, aggregates[0])
+		default:
+			params.Add("member_of"+suffix, "in:"+strings.Join(aggregates, ","))
+		}
+	}
+
+	if g.InTree != "" {
+		params.Add("in_tree"+suffix, g.InTree)
+	}
+
+	return nil
+}
+
+// List returns the allocation candidates satisfying opts. It requires
+// microversion 1.10 or later; the results are only interpreted with
+// microversion 1.12 or later.
+func List(ctx context.Context, client *gophercloud.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	query, err := opts.ToAllocationCandidateListQuery()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Get(ctx, listURL(client)+query, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,69 @@
+package allocationcandidates
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+// Allocation is the set of resources to allocate from a single resource
+// provider.
+type Allocation struct {
+	// Resources are the amounts to allocate, keyed by resource class.
+	Resources map[string]int `json:"resources"`
+}
+
+// AllocationRequest is a candidate set of allocations satisfying the
+// request. Its allocations can be claimed with allocations.Update.
+type AllocationRequest struct {
+	// Allocations are the allocations to make, keyed by resource provider
+	// UUID.
+	Allocations map[string]Allocation `json:"allocations"`
+
+	// Mappings maps the request group suffixes to the resource providers
+	// satisfying them. It is returned with microversion 1.34 or later.
+	Mappings map[string][]string `json:"mappings"`
+}
+
+// ProviderResource is the capacity and usage of a resource class of a
+// resource provider.
+type ProviderResource struct {
+	Capacity int `json:"capacity"`
+	Used     int `json:"used"`
+}
+
+// ProviderSummary describes a resource provider involved in the allocation
+// requests.
+type ProviderSummary struct {
+	// Resources are the capacity and usage of the provider, keyed by
+	// resource class.
+	Resources map[string]ProviderResource `json:"resources"`
+
+	// Traits are the traits of the provider. They are returned with
+	// microversion 1.17 or later.
+	Traits []string `json:"traits"`
+
+	// ParentProviderUUID is the UUID of the parent of the provider. It is
+	// returned with microversion 1.29 or later.
+	ParentProviderUUID string `json:"parent_provider_uuid"`
+
+	// RootProviderUUID is the UUID of the root of the provider tree. It is
+	// returned with microversion 1.29 or later.
+	RootProviderUUID string `json:"root_provider_uuid"`
+}
+
+// AllocationCandidates are the allocation requests satisfying a List
+// request, and a summary of the resource providers they involve.
+type AllocationCandidates struct {
+	AllocationRequests []AllocationRequest        `json:"allocation_requests"`
+	ProviderSummaries  map[string]ProviderSummary `json:"provider_summaries"`
+}
+
+// ListResult is the response of a List operation. Call its Extract method to
+// interpret it as AllocationCandidates.
+type ListResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ListResult as AllocationCandidates.
+func (r ListResult) Extract() (*AllocationCandidates, error) {
+	var s AllocationCandidates
+	err := r.ExtractInto(&s)
+	return &s, err
+}
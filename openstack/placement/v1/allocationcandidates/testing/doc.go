@@ -0,0 +1,2 @@
+// placement allocation candidates
+package testing
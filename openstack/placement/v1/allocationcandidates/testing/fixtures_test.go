@@ -0,0 +1,88 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+const ComputeNodeID = "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
+
+const SharedStorageID = "d0b381e9-8761-42de-8e6c-bba99a96d5f5"
+
+const AllocationCandidatesBody = `
+{
+    "allocation_requests": [
+        {
+            "allocations": {
+                "4e8e5957-649f-477b-9e5b-f1f75b21c03c": {
+                    "resources": {
+                        "MEMORY_MB": 1024,
+                        "VCPU": 2
+                    }
+                },
+                "d0b381e9-8761-42de-8e6c-bba99a96d5f5": {
+                    "resources": {
+                        "DISK_GB": 20
+                    }
+                }
+            },
+            "mappings": {
+                "": [
+                    "4e8e5957-649f-477b-9e5b-f1f75b21c03c",
+                    "d0b381e9-8761-42de-8e6c-bba99a96d5f5"
+                ]
+            }
+        }
+    ],
+    "provider_summaries": {
+        "4e8e5957-649f-477b-9e5b-f1f75b21c03c": {
+            "resources": {
+                "MEMORY_MB": {
+                    "capacity": 8192,
+                    "used": 2048
+                },
+                "VCPU": {
+                    "capacity": 16,
+                    "used": 4
+                }
+            },
+            "traits": ["HW_CPU_X86_AVX2"],
+            "parent_provider_uuid": null,
+            "root_provider_uuid": "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
+        },
+        "d0b381e9-8761-42de-8e6c-bba99a96d5f5": {
+            "resources": {
+                "DISK_GB": {
+                    "capacity": 1900,
+                    "used": 100
+                }
+            },
+            "traits": ["MISC_SHARES_VIA_AGGREGATE"],
+            "parent_provider_uuid": null,
+            "root_provider_uuid": "d0b381e9-8761-42de-8e6c-bba99a96d5f5"
+        }
+    }
+}
+`
+
+func HandleListAllocationCandidates(t *testing.T) {
+	th.Mux.HandleFunc("/allocation_candidates",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+			th.TestFormValues(t, r, map[string]string{
+				"resources": "DISK_GB:20,MEMORY_MB:1024,VCPU:2",
+				"required":  "HW_CPU_X86_AVX2,!CUSTOM_MAINTENANCE",
+				"limit":     "1",
+			})
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+
+			fmt.Fprint(w, AllocationCandidatesBody)
+		})
+}
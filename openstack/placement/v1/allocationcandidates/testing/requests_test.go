@@ -0,0 +1,92 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/placement/v1/allocationcandidates"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestListAllocationCandidates(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleListAllocationCandidates(t)
+
+	listOpts := allocationcandidates.ListOpts{
+		Resources: map[string]int{
+			"VCPU":      2,
+			"MEMORY_MB": 1024,
+			"DISK_GB":   20,
+		},
+		Required:  []string{"HW_CPU_X86_AVX2"},
+		Forbidden: []string{"CUSTOM_MAINTENANCE"},
+		Limit:     1,
+	}
+
+	actual, err := allocationcandidates.List(context.TODO(), fake.ServiceClient(), listOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(actual.AllocationRequests))
+	request := actual.AllocationRequests[0]
+	th.AssertDeepEquals(t, map[string]int{"DISK_GB": 20}, request.Allocations[SharedStorageID].Resources)
+	th.AssertDeepEquals(t, []string{ComputeNodeID, SharedStorageID}, request.Mappings[""])
+
+	expected := allocationcandidates.ProviderSummary{
+		Resources: map[string]allocationcandidates.ProviderResource{
+			"MEMORY_MB": {Capacity: 8192, Used: 2048},
+			"VCPU":      {Capacity: 16, Used: 4},
+		},
+		Traits:           []string{"HW_CPU_X86_AVX2"},
+		RootProviderUUID: ComputeNodeID,
+	}
+	th.AssertDeepEquals(t, expected, actual.ProviderSummaries[ComputeNodeID])
+}
+
+func TestListAllocationCandidatesGranularQuery(t *testing.T) {
+	listOpts := allocationcandidates.ListOpts{
+		Resources: map[string]int{"VCPU": 1},
+		MemberOf: [][]string{
+			{"agg1", "agg2"},
+			{"agg3"},
+		},
+		InTree: "root",
+		Groups: map[string]allocationcandidates.RequestGroup{
+			"_NET": {
+				Resources: map[string]int{"SRIOV_NET_VF": 1},
+				Forbidden: []string{"CUSTOM_PHYSNET_PRIVATE"},
+			},
+		},
+		GroupPolicy: allocationcandidates.GroupPolicyIsolate,
+	}
+
+	query, err := listOpts.ToAllocationCandidateListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "?group_policy=isolate&in_tree=root&member_of=in%3Aagg1%2Cagg2&member_of=agg3&required_NET=%21CUSTOM_PHYSNET_PRIVATE&resources=VCPU%3A1&resources_NET=SRIOV_NET_VF%3A1", query)
+}
+
+func TestListAllocationCandidatesInvalidOpts(t *testing.T) {
+	_, err := allocationcandidates.ListOpts{}.ToAllocationCandidateListQuery()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("expected ErrMissingInput, got %v", err)
+	}
+
+	_, err = allocationcandidates.ListOpts{
+		Resources: map[string]int{"VCPU": 0},
+	}.ToAllocationCandidateListQuery()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+
+	_, err = allocationcandidates.ListOpts{
+		Groups: map[string]allocationcandidates.RequestGroup{
+			"bad suffix": {Resources: map[string]int{"VCPU": 1}},
+		},
+	}.ToAllocationCandidateListQuery()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+}
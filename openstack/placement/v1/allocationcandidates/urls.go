@@ -0,0 +1,9 @@
+package allocationcandidates
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+const apiName = "allocation_candidates"
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(apiName)
+}
@@ -0,0 +1,48 @@
+/*
+Package allocations manages the allocations of a consumer, such as a server,
+in the OpenStack Placement service.
+
+Example to get the allocations of a consumer
+
+	placementClient.Microversion = "1.28"
+
+	consumer, err := allocations.Get(context.TODO(), placementClient, consumerID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for rpID, allocation := range consumer.Allocations {
+		fmt.Printf("%s: %v\n", rpID, allocation.Resources)
+	}
+
+Example to allocate resources to a new consumer
+
+	placementClient.Microversion = "1.28"
+
+	updateOpts := allocations.UpdateOpts{
+		Allocations: map[string]allocations.AllocationOpts{
+			"4e8e5957-649f-477b-9e5b-f1f75b21c03c": {
+				Resources: map[string]int{
+					"VCPU":      2,
+					"MEMORY_MB": 1024,
+				},
+			},
+		},
+		ProjectID:   "616fb98f-46ca-475e-917e-2563e5a8cd19",
+		UserID:      "5a8d7c1e-3b4f-4f2a-9e6d-1c2b3a4d5e6f",
+		NewConsumer: true,
+	}
+
+	err := allocations.Update(context.TODO(), placementClient, consumerID, updateOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to delete the allocations of a consumer
+
+	err := allocations.Delete(context.TODO(), placementClient, consumerID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package allocations
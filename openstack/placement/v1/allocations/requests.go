@@ -0,0 +1,107 @@
+package allocations
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// Get returns the allocations of a consumer. A consumer without allocations
+// results in an empty set of allocations rather than an error.
+func Get(ctx context.Context, client *gophercloud.ServiceClient, consumerID string) (r GetResult) {
+	resp, err := client.Get(ctx, getURL(client, consumerID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToAllocationUpdateMap() (map[string]any, error)
+}
+
+// AllocationOpts is the set of resources a consumer allocates from a single
+// resource provider.
+type AllocationOpts struct {
+	// Resources are the amounts allocated, keyed by resource class.
+	Resources map[string]int `json:"resources" required:"true"`
+}
+
+// UpdateOpts replaces the allocations of a consumer. The allocations must be
+// keyed by resource provider UUID, which requires microversion 1.12 or later.
+type UpdateOpts struct {
+	// Allocations are the allocations of the consumer, keyed by resource
+	// provider UUID.
+	Allocations map[string]AllocationOpts `json:"allocations" required:"true"`
+
+	// ProjectID is the project of the consumer.
+	ProjectID string `json:"project_id" required:"true"`
+
+	// UserID is the user of the consumer.
+	UserID string `json:"user_id" required:"true"`
+
+	// ConsumerGeneration is the generation of the consumer, as returned by
+	// Get. It requires microversion 1.28 or later; set NewConsumer instead
+	// when the consumer has no allocations yet.
+	ConsumerGeneration *int `json:"consumer_generation,omitempty"`
+
+	// NewConsumer states that the consumer does not exist yet. With
+	// microversion 1.28 or later, the consumer generation is then sent as
+	// null.
+	NewConsumer bool `json:"-"`
+
+	// ConsumerType is the type of the consumer, such as "INSTANCE". It
+	// requires microversion 1.38 or later.
+	ConsumerType string `json:"consumer_type,omitempty"`
+
+	// Mappings maps the request group suffixes of an allocation candidate
+	// to the resource providers satisfying them. It requires microversion
+	// 1.34 or later.
+	Mappings map[string][]string `json:"mappings,omitempty"`
+}
+
+// ToAllocationUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToAllocationUpdateMap() (map[string]any, error) {
+	if opts.NewConsumer && opts.ConsumerGeneration != nil {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "allocations.UpdateOpts.ConsumerGeneration"
+		err.Value = *opts.ConsumerGeneration
+		err.Info = "ConsumerGeneration cannot be set for a new consumer"
+		return nil, err
+	}
+
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.NewConsumer {
+		b["consumer_generation"] = nil
+	}
+
+	return b, nil
+}
+
+// Update replaces the allocations of a consumer. A 409 Conflict error is
+// returned when the consumer or one of the resource providers was updated
+// concurrently, or when the resources are no longer available.
+func Update(ctx context.Context, client *gophercloud.ServiceClient, consumerID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToAllocationUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Put(ctx, updateURL(client, consumerID), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete removes all the allocations of a consumer.
+func Delete(ctx context.Context, client *gophercloud.ServiceClient, consumerID string) (r DeleteResult) {
+	resp, err := client.Delete(ctx, deleteURL(client, consumerID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,62 @@
+package allocations
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+// Allocation is the set of resources a consumer allocates from a single
+// resource provider.
+type Allocation struct {
+	// Generation is the generation of the resource provider.
+	Generation int `json:"generation"`
+
+	// Resources are the amounts allocated, keyed by resource class.
+	Resources map[string]int `json:"resources"`
+}
+
+// ConsumerAllocations are the allocations of a consumer.
+type ConsumerAllocations struct {
+	// Allocations are the allocations of the consumer, keyed by resource
+	// provider UUID.
+	Allocations map[string]Allocation `json:"allocations"`
+
+	// ConsumerGeneration is the generation of the consumer. It is returned
+	// with microversion 1.28 or later, and is nil if the consumer has no
+	// allocations.
+	ConsumerGeneration *int `json:"consumer_generation"`
+
+	// ProjectID is the project of the consumer. It is returned with
+	// microversion 1.12 or later.
+	ProjectID string `json:"project_id"`
+
+	// UserID is the user of the consumer. It is returned with microversion
+	// 1.12 or later.
+	UserID string `json:"user_id"`
+
+	// ConsumerType is the type of the consumer. It is returned with
+	// microversion 1.38 or later.
+	ConsumerType string `json:"consumer_type"`
+}
+
+// GetResult is the response of a Get operation. Call its Extract method to
+// interpret it as ConsumerAllocations.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as ConsumerAllocations.
+func (r GetResult) Extract() (*ConsumerAllocations, error) {
+	var s ConsumerAllocations
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// UpdateResult is the response of an Update operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type UpdateResult struct {
+	gophercloud.ErrResult
+}
+
+// DeleteResult is the response of a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
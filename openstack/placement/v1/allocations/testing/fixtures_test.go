@@ -0,0 +1,81 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+const ConsumerID = "8d2c5a6e-3b3b-4a2e-9a61-4b1a3f6c0b7e"
+
+const ResourceProviderID = "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
+
+const AllocationsBody = `
+{
+    "allocations": {
+        "4e8e5957-649f-477b-9e5b-f1f75b21c03c": {
+            "generation": 3,
+            "resources": {
+                "MEMORY_MB": 1024,
+                "VCPU": 2
+            }
+        }
+    },
+    "consumer_generation": 1,
+    "project_id": "616fb98f-46ca-475e-917e-2563e5a8cd19",
+    "user_id": "5a8d7c1e-3b4f-4f2a-9e6d-1c2b3a4d5e6f"
+}
+`
+
+const UpdateAllocationsRequest = `
+{
+    "allocations": {
+        "4e8e5957-649f-477b-9e5b-f1f75b21c03c": {
+            "resources": {
+                "MEMORY_MB": 1024,
+                "VCPU": 2
+            }
+        }
+    },
+    "consumer_generation": null,
+    "project_id": "616fb98f-46ca-475e-917e-2563e5a8cd19",
+    "user_id": "5a8d7c1e-3b4f-4f2a-9e6d-1c2b3a4d5e6f"
+}
+`
+
+func HandleGetAllocations(t *testing.T) {
+	th.Mux.HandleFunc("/allocations/"+ConsumerID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+
+			fmt.Fprint(w, AllocationsBody)
+		})
+}
+
+func HandleUpdateAllocations(t *testing.T) {
+	th.Mux.HandleFunc("/allocations/"+ConsumerID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "PUT")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+			th.TestJSONRequest(t, r, UpdateAllocationsRequest)
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+}
+
+func HandleDeleteAllocations(t *testing.T) {
+	th.Mux.HandleFunc("/allocations/"+ConsumerID,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "DELETE")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+}
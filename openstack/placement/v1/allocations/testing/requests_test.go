@@ -0,0 +1,90 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/placement/v1/allocations"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestGetAllocations(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleGetAllocations(t)
+
+	actual, err := allocations.Get(context.TODO(), fake.ServiceClient(), ConsumerID).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := &allocations.ConsumerAllocations{
+		Allocations: map[string]allocations.Allocation{
+			ResourceProviderID: {
+				Generation: 3,
+				Resources: map[string]int{
+					"MEMORY_MB": 1024,
+					"VCPU":      2,
+				},
+			},
+		},
+		ConsumerGeneration: gophercloud.IntToPointer(1),
+		ProjectID:          "616fb98f-46ca-475e-917e-2563e5a8cd19",
+		UserID:             "5a8d7c1e-3b4f-4f2a-9e6d-1c2b3a4d5e6f",
+	}
+	th.AssertDeepEquals(t, expected, actual)
+}
+
+func TestUpdateAllocations(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleUpdateAllocations(t)
+
+	updateOpts := allocations.UpdateOpts{
+		Allocations: map[string]allocations.AllocationOpts{
+			ResourceProviderID: {
+				Resources: map[string]int{
+					"MEMORY_MB": 1024,
+					"VCPU":      2,
+				},
+			},
+		},
+		ProjectID:   "616fb98f-46ca-475e-917e-2563e5a8cd19",
+		UserID:      "5a8d7c1e-3b4f-4f2a-9e6d-1c2b3a4d5e6f",
+		NewConsumer: true,
+	}
+
+	err := allocations.Update(context.TODO(), fake.ServiceClient(), ConsumerID, updateOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestUpdateAllocationsConsumerGeneration(t *testing.T) {
+	updateOpts := allocations.UpdateOpts{
+		Allocations:        map[string]allocations.AllocationOpts{},
+		ProjectID:          "616fb98f-46ca-475e-917e-2563e5a8cd19",
+		UserID:             "5a8d7c1e-3b4f-4f2a-9e6d-1c2b3a4d5e6f",
+		ConsumerGeneration: gophercloud.IntToPointer(2),
+	}
+
+	b, err := updateOpts.ToAllocationUpdateMap()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, float64(2), b["consumer_generation"])
+
+	updateOpts.NewConsumer = true
+	_, err = updateOpts.ToAllocationUpdateMap()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestDeleteAllocations(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleDeleteAllocations(t)
+
+	err := allocations.Delete(context.TODO(), fake.ServiceClient(), ConsumerID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
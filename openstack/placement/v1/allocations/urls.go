@@ -0,0 +1,17 @@
+package allocations
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+const apiName = "allocations"
+
+func getURL(client *gophercloud.ServiceClient, consumerID string) string {
+	return client.ServiceURL(apiName, consumerID)
+}
+
+func updateURL(client *gophercloud.ServiceClient, consumerID string) string {
+	return client.ServiceURL(apiName, consumerID)
+}
+
+func deleteURL(client *gophercloud.ServiceClient, consumerID string) string {
+	return client.ServiceURL(apiName, consumerID)
+}
@@ -0,0 +1,43 @@
+/*
+Package reshaper atomically moves inventories and allocations between
+resource providers of the OpenStack Placement service, as needed when
+migrating to nested resource providers.
+
+Example to move the VGPU inventory of a compute node to a child provider
+
+	placementClient.Microversion = "1.30"
+
+	reshapeOpts := reshaper.ReshapeOpts{
+		Inventories: map[string]resourceproviders.UpdateInventoriesOpts{
+			computeNodeID: {
+				ResourceProviderGeneration: 5,
+				Inventories: map[string]resourceproviders.InventoryOpts{
+					"VCPU": {Total: 8},
+				},
+			},
+			gpuProviderID: {
+				ResourceProviderGeneration: 0,
+				Inventories: map[string]resourceproviders.InventoryOpts{
+					"VGPU": {Total: 4},
+				},
+			},
+		},
+		Allocations: map[string]allocations.UpdateOpts{
+			consumerID: {
+				Allocations: map[string]allocations.AllocationOpts{
+					computeNodeID: {Resources: map[string]int{"VCPU": 2}},
+					gpuProviderID: {Resources: map[string]int{"VGPU": 1}},
+				},
+				ProjectID:          projectID,
+				UserID:             userID,
+				ConsumerGeneration: gophercloud.IntToPointer(1),
+			},
+		},
+	}
+
+	err := reshaper.Reshape(context.TODO(), placementClient, reshapeOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package reshaper
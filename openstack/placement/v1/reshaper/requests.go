@@ -0,0 +1,82 @@
+package reshaper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/placement/v1/allocations"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/placement/v1/resourceproviders"
+)
+
+// ReshapeOptsBuilder allows extensions to add additional parameters to the
+// Reshape request.
+type ReshapeOptsBuilder interface {
+	ToReshapeMap() (map[string]any, error)
+}
+
+// ReshapeOpts describes the inventories and allocations resulting from a
+// reshape, typically when inventory moves from a root resource provider to
+// its new child providers.
+type ReshapeOpts struct {
+	// Inventories are the complete inventories of every resource provider
+	// affected by the reshape, keyed by resource provider UUID.
+	Inventories map[string]resourceproviders.UpdateInventoriesOpts
+
+	// Allocations are the complete allocations of every consumer affected by
+	// the reshape, keyed by consumer UUID. A consumer with empty allocations
+	// has its allocations removed.
+	Allocations map[string]allocations.UpdateOpts
+}
+
+// ToReshapeMap constructs a request body from ReshapeOpts.
+func (opts ReshapeOpts) ToReshapeMap() (map[string]any, error) {
+	if opts.Inventories == nil {
+		return nil, gophercloud.ErrMissingInput{Argument: "reshaper.ReshapeOpts.Inventories"}
+	}
+	if opts.Allocations == nil {
+		return nil, gophercloud.ErrMissingInput{Argument: "reshaper.ReshapeOpts.Allocations"}
+	}
+
+	inventories := make(map[string]any, len(opts.Inventories))
+	for rpID, inventory := range opts.Inventories {
+		b, err := inventory.ToResourceProviderUpdateInventoriesMap()
+		if err != nil {
+			return nil, fmt.Errorf("invalid inventories for resource provider %s: %w", rpID, err)
+		}
+		inventories[rpID] = b
+	}
+
+	consumers := make(map[string]any, len(opts.Allocations))
+	for consumerID, allocation := range opts.Allocations {
+		b, err := allocation.ToAllocationUpdateMap()
+		if err != nil {
+			return nil, fmt.Errorf("invalid allocations for consumer %s: %w", consumerID, err)
+		}
+		consumers[consumerID] = b
+	}
+
+	return map[string]any{
+		"inventories": inventories,
+		"allocations": consumers,
+	}, nil
+}
+
+// Reshape atomically replaces the inventories and allocations of resource
+// providers and consumers. It requires microversion 1.30 or later, with which
+// the consumer generation of every consumer must be given. A 409 Conflict
+// error is returned when a resource provider or a consumer was updated
+// concurrently.
+func Reshape(ctx context.Context, client *gophercloud.ServiceClient, opts ReshapeOptsBuilder) (r ReshapeResult) {
+	b, err := opts.ToReshapeMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Post(ctx, reshapeURL(client), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
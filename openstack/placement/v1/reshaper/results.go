@@ -0,0 +1,9 @@
+package reshaper
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+// ReshapeResult is the response of a Reshape operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type ReshapeResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,2 @@
+// placement reshaper
+package testing
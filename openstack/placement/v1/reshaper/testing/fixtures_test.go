@@ -0,0 +1,68 @@
+package testing
+
+import (
+	"net/http"
+	"testing"
+
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+const ComputeNodeID = "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
+
+const GPUProviderID = "c2b4fb38-5e3e-4a4f-9b0d-3b5e0f2f7a11"
+
+const ConsumerID = "8d2c5a6e-3b3b-4a2e-9a61-4b1a3f6c0b7e"
+
+const ReshapeRequest = `
+{
+    "inventories": {
+        "4e8e5957-649f-477b-9e5b-f1f75b21c03c": {
+            "inventories": {
+                "VCPU": {
+                    "total": 8
+                }
+            },
+            "resource_provider_generation": 5
+        },
+        "c2b4fb38-5e3e-4a4f-9b0d-3b5e0f2f7a11": {
+            "inventories": {
+                "VGPU": {
+                    "total": 4
+                }
+            },
+            "resource_provider_generation": 0
+        }
+    },
+    "allocations": {
+        "8d2c5a6e-3b3b-4a2e-9a61-4b1a3f6c0b7e": {
+            "allocations": {
+                "4e8e5957-649f-477b-9e5b-f1f75b21c03c": {
+                    "resources": {
+                        "VCPU": 2
+                    }
+                },
+                "c2b4fb38-5e3e-4a4f-9b0d-3b5e0f2f7a11": {
+                    "resources": {
+                        "VGPU": 1
+                    }
+                }
+            },
+            "consumer_generation": 1,
+            "project_id": "616fb98f-46ca-475e-917e-2563e5a8cd19",
+            "user_id": "5a8d7c1e-3b4f-4f2a-9e6d-1c2b3a4d5e6f"
+        }
+    }
+}
+`
+
+func HandleReshape(t *testing.T) {
+	th.Mux.HandleFunc("/reshaper",
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "POST")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+			th.TestJSONRequest(t, r, ReshapeRequest)
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+}
@@ -0,0 +1,61 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/placement/v1/allocations"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/placement/v1/reshaper"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/placement/v1/resourceproviders"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestReshape(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleReshape(t)
+
+	reshapeOpts := reshaper.ReshapeOpts{
+		Inventories: map[string]resourceproviders.UpdateInventoriesOpts{
+			ComputeNodeID: {
+				ResourceProviderGeneration: 5,
+				Inventories: map[string]resourceproviders.InventoryOpts{
+					"VCPU": {Total: 8},
+				},
+			},
+			GPUProviderID: {
+				Inventories: map[string]resourceproviders.InventoryOpts{
+					"VGPU": {Total: 4},
+				},
+			},
+		},
+		Allocations: map[string]allocations.UpdateOpts{
+			ConsumerID: {
+				Allocations: map[string]allocations.AllocationOpts{
+					ComputeNodeID: {Resources: map[string]int{"VCPU": 2}},
+					GPUProviderID: {Resources: map[string]int{"VGPU": 1}},
+				},
+				ProjectID:          "616fb98f-46ca-475e-917e-2563e5a8cd19",
+				UserID:             "5a8d7c1e-3b4f-4f2a-9e6d-1c2b3a4d5e6f",
+				ConsumerGeneration: gophercloud.IntToPointer(1),
+			},
+		},
+	}
+
+	err := reshaper.Reshape(context.TODO(), fake.ServiceClient(), reshapeOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestReshapeMissingAllocations(t *testing.T) {
+	reshapeOpts := reshaper.ReshapeOpts{
+		Inventories: map[string]resourceproviders.UpdateInventoriesOpts{},
+	}
+
+	_, err := reshapeOpts.ToReshapeMap()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("expected ErrMissingInput, got %v", err)
+	}
+}
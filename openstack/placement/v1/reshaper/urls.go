@@ -0,0 +1,9 @@
+package reshaper
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+const apiName = "reshaper"
+
+func reshapeURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(apiName)
+}
@@ -50,13 +50,16 @@ Example to Update a resource provider
 
 	resourceProviderID := "b99b3ab4-3aa6-4fba-b827-69b88b9c544a"
 
+	name := "new-rp"
+	parentProviderUUID := "c7f50b40-6f32-4d7a-9f32-9384057be83b"
+
 	updateOpts := resourceproviders.UpdateOpts{
-		Name: "new-rp",
-		ParentProvider: "c7f50b40-6f32-4d7a-9f32-9384057be83b"
+		Name:               &name,
+		ParentProviderUUID: &parentProviderUUID,
 	}
 
 	placementClient.Microversion = "1.37"
-	resourceProvider, err := resourceproviders.Update(context.TODO(), placementClient, resourceProviderID).Extract()
+	resourceProvider, err := resourceproviders.Update(context.TODO(), placementClient, resourceProviderID, updateOpts).Extract()
 	if err != nil {
 		panic(err)
 	}
@@ -75,6 +78,60 @@ Example to get resource providers inventories
 		panic(err)
 	}
 
+Example to update resource providers inventories
+
+	inventories, err := resourceproviders.GetInventories(context.TODO(), placementClient, resourceProviderID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	updateInventoriesOpts := resourceproviders.UpdateInventoriesOpts{
+		ResourceProviderGeneration: inventories.ResourceProviderGeneration,
+		Inventories: map[string]resourceproviders.InventoryOpts{
+			"VCPU": {
+				Total:           64,
+				AllocationRatio: 4.0,
+			},
+			"MEMORY_MB": {
+				Total:    262144,
+				Reserved: 4096,
+			},
+		},
+	}
+
+	rp, err := resourceproviders.UpdateInventories(context.TODO(), placementClient, resourceProviderID, updateInventoriesOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to update the inventory of a single resource class
+
+	inventory, err := resourceproviders.GetInventory(context.TODO(), placementClient, resourceProviderID, "VCPU").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	updateInventoryOpts := resourceproviders.UpdateInventoryOpts{
+		ResourceProviderGeneration: inventory.ResourceProviderGeneration,
+		Inventory: resourceproviders.InventoryOpts{
+			Total:           inventory.Total,
+			Reserved:        2,
+			AllocationRatio: inventory.AllocationRatio,
+		},
+	}
+
+	inventory, err = resourceproviders.UpdateInventory(context.TODO(), placementClient, resourceProviderID, "VCPU", updateInventoryOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to delete the inventory of a single resource class
+
+	err := resourceproviders.DeleteInventory(context.TODO(), placementClient, resourceProviderID, "CUSTOM_FPGA").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
 Example to get resource providers traits
 
 	rp, err := resourceproviders.GetTraits(context.TODO(), placementClient, resourceProviderID).Extract()
@@ -82,6 +139,48 @@ Example to get resource providers traits
 		panic(err)
 	}
 
+Example to add traits to a resource provider
+
+	rp, err := resourceproviders.AddTraits(context.TODO(), placementClient, resourceProviderID, "CUSTOM_HW_FPGA_CLASS1")
+	if err != nil {
+		panic(err)
+	}
+
+Example to replace the traits of a resource provider
+
+	updateTraitsOpts := resourceproviders.UpdateTraitsOpts{
+		ResourceProviderGeneration: 1,
+		Traits:                     []string{"HW_CPU_X86_AVX2", "CUSTOM_HW_FPGA_CLASS1"},
+	}
+
+	rp, err := resourceproviders.UpdateTraits(context.TODO(), placementClient, resourceProviderID, updateTraitsOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to get resource providers aggregates
+
+	placementClient.Microversion = "1.19"
+
+	rp, err := resourceproviders.GetAggregates(context.TODO(), placementClient, resourceProviderID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to replace the aggregates of a resource provider
+
+	placementClient.Microversion = "1.19"
+
+	updateAggregatesOpts := resourceproviders.UpdateAggregatesOpts{
+		ResourceProviderGeneration: rp.ResourceProviderGeneration,
+		Aggregates:                 []string{"42896e0d-205d-4fe9-9d9e-1ac63b0ae6c1"},
+	}
+
+	rp, err := resourceproviders.UpdateAggregates(context.TODO(), placementClient, resourceProviderID, updateAggregatesOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to get resource providers allocations
 
 	rp, err := resourceproviders.GetAllocations(context.TODO(), placementClient, resourceProviderID).Extract()
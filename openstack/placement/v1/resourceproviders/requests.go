@@ -2,6 +2,7 @@ package resourceproviders
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
@@ -175,3 +176,242 @@ func GetTraits(ctx context.Context, client *gophercloud.ServiceClient, resourceP
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// UpdateInventoriesOptsBuilder allows extensions to add additional parameters
+// to the UpdateInventories request.
+type UpdateInventoriesOptsBuilder interface {
+	ToResourceProviderUpdateInventoriesMap() (map[string]any, error)
+}
+
+// InventoryOpts represents the inventory of a single resource class. Fields
+// left empty use the defaults of the placement service.
+type InventoryOpts struct {
+	// The amount of the resource the provider can accommodate.
+	Total int `json:"total" required:"true"`
+
+	// The amount of the resource that is not available for allocation.
+	Reserved int `json:"reserved,omitempty"`
+
+	// The smallest amount of the resource a single allocation can request.
+	MinUnit int `json:"min_unit,omitempty"`
+
+	// The largest amount of the resource a single allocation can request.
+	MaxUnit int `json:"max_unit,omitempty"`
+
+	// The amount allocations must be a multiple of.
+	StepSize int `json:"step_size,omitempty"`
+
+	// The ratio used to compute the capacity available for allocation.
+	AllocationRatio float32 `json:"allocation_ratio,omitempty"`
+}
+
+// UpdateInventoriesOpts represents options used to replace all the
+// inventories of a resource provider.
+type UpdateInventoriesOpts struct {
+	// The generation of the resource provider, as returned by Get or
+	// GetInventories. The request fails with a conflict if it is outdated.
+	ResourceProviderGeneration int `json:"resource_provider_generation"`
+
+	// The inventories, keyed by resource class. Resource classes which are
+	// not listed are removed from the resource provider.
+	Inventories map[string]InventoryOpts `json:"inventories" required:"true"`
+}
+
+// ToResourceProviderUpdateInventoriesMap constructs a request body from
+// UpdateInventoriesOpts.
+func (opts UpdateInventoriesOpts) ToResourceProviderUpdateInventoriesMap() (map[string]any, error) {
+	for class, inventory := range opts.Inventories {
+		if _, err := gophercloud.BuildRequestBody(inventory, ""); err != nil {
+			return nil, fmt.Errorf("invalid inventory for resource class %s: %w", class, err)
+		}
+	}
+
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// UpdateInventories replaces all the inventories of a resource provider.
+func UpdateInventories(ctx context.Context, client *gophercloud.ServiceClient, resourceProviderID string, opts UpdateInventoriesOptsBuilder) (r UpdateInventoriesResult) {
+	b, err := opts.ToResourceProviderUpdateInventoriesMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Put(ctx, updateResourceProviderInventoriesURL(client, resourceProviderID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteInventories removes all the inventories of a resource provider. It
+// requires microversion 1.5 or later.
+func DeleteInventories(ctx context.Context, client *gophercloud.ServiceClient, resourceProviderID string) (r DeleteInventoriesResult) {
+	resp, err := client.Delete(ctx, deleteResourceProviderInventoriesURL(client, resourceProviderID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetInventory retrieves the inventory of a single resource class of a
+// resource provider.
+func GetInventory(ctx context.Context, client *gophercloud.ServiceClient, resourceProviderID, resourceClass string) (r GetInventoryResult) {
+	resp, err := client.Get(ctx, getResourceProviderInventoryURL(client, resourceProviderID, resourceClass), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateInventoryOptsBuilder allows extensions to add additional parameters
+// to the UpdateInventory request.
+type UpdateInventoryOptsBuilder interface {
+	ToResourceProviderUpdateInventoryMap() (map[string]any, error)
+}
+
+// UpdateInventoryOpts represents options used to create or replace the
+// inventory of a single resource class of a resource provider.
+type UpdateInventoryOpts struct {
+	// The generation of the resource provider, as returned by Get or
+	// GetInventories. The request fails with a conflict if it is outdated.
+	ResourceProviderGeneration int
+
+	// The inventory of the resource class.
+	Inventory InventoryOpts
+}
+
+// ToResourceProviderUpdateInventoryMap constructs a request body from
+// UpdateInventoryOpts.
+func (opts UpdateInventoryOpts) ToResourceProviderUpdateInventoryMap() (map[string]any, error) {
+	b, err := gophercloud.BuildRequestBody(opts.Inventory, "")
+	if err != nil {
+		return nil, err
+	}
+
+	b["resource_provider_generation"] = opts.ResourceProviderGeneration
+
+	return b, nil
+}
+
+// UpdateInventory creates or replaces the inventory of a single resource
+// class of a resource provider.
+func UpdateInventory(ctx context.Context, client *gophercloud.ServiceClient, resourceProviderID, resourceClass string, opts UpdateInventoryOptsBuilder) (r UpdateInventoryResult) {
+	b, err := opts.ToResourceProviderUpdateInventoryMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Put(ctx, updateResourceProviderInventoryURL(client, resourceProviderID, resourceClass), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteInventory removes the inventory of a single resource class of a
+// resource provider.
+func DeleteInventory(ctx context.Context, client *gophercloud.ServiceClient, resourceProviderID, resourceClass string) (r DeleteInventoryResult) {
+	resp, err := client.Delete(ctx, deleteResourceProviderInventoryURL(client, resourceProviderID, resourceClass), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateTraitsOptsBuilder allows extensions to add additional parameters to
+// the UpdateTraits request.
+type UpdateTraitsOptsBuilder interface {
+	ToResourceProviderUpdateTraitsMap() (map[string]any, error)
+}
+
+// UpdateTraitsOpts represents options used to replace the traits of a
+// resource provider.
+type UpdateTraitsOpts struct {
+	// The generation of the resource provider, as returned by Get or
+	// GetTraits. The request fails with a conflict if it is outdated.
+	ResourceProviderGeneration int `json:"resource_provider_generation"`
+
+	// The traits of the resource provider. Traits which are not listed are
+	// removed from the resource provider.
+	Traits []string `json:"traits"`
+}
+
+// ToResourceProviderUpdateTraitsMap constructs a request body from
+// UpdateTraitsOpts.
+func (opts UpdateTraitsOpts) ToResourceProviderUpdateTraitsMap() (map[string]any, error) {
+	if opts.Traits == nil {
+		opts.Traits = []string{}
+	}
+
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// UpdateTraits replaces all the traits of a resource provider.
+func UpdateTraits(ctx context.Context, client *gophercloud.ServiceClient, resourceProviderID string, opts UpdateTraitsOptsBuilder) (r UpdateTraitsResult) {
+	b, err := opts.ToResourceProviderUpdateTraitsMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Put(ctx, updateResourceProviderTraitsURL(client, resourceProviderID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteTraits removes all the traits of a resource provider.
+func DeleteTraits(ctx context.Context, client *gophercloud.ServiceClient, resourceProviderID string) (r DeleteTraitsResult) {
+	resp, err := client.Delete(ctx, deleteResourceProviderTraitsURL(client, resourceProviderID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetAggregates returns the aggregates a resource provider is a member of.
+// The resource provider generation is returned with microversion 1.19 or
+// later.
+func GetAggregates(ctx context.Context, client *gophercloud.ServiceClient, resourceProviderID string) (r GetAggregatesResult) {
+	resp, err := client.Get(ctx, getResourceProviderAggregatesURL(client, resourceProviderID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateAggregatesOptsBuilder allows extensions to add additional parameters
+// to the UpdateAggregates request.
+type UpdateAggregatesOptsBuilder interface {
+	ToResourceProviderUpdateAggregatesMap() (map[string]any, error)
+}
+
+// UpdateAggregatesOpts represents options used to replace the aggregates of a
+// resource provider. It requires microversion 1.19 or later.
+type UpdateAggregatesOpts struct {
+	// The generation of the resource provider, as returned by Get or
+	// GetAggregates. The request fails with a conflict if it is outdated.
+	ResourceProviderGeneration int `json:"resource_provider_generation"`
+
+	// The UUIDs of the aggregates of the resource provider. Aggregates which
+	// are not listed are removed from the resource provider.
+	Aggregates []string `json:"aggregates"`
+}
+
+// ToResourceProviderUpdateAggregatesMap constructs a request body from
+// UpdateAggregatesOpts.
+func (opts UpdateAggregatesOpts) ToResourceProviderUpdateAggregatesMap() (map[string]any, error) {
+	if opts.Aggregates == nil {
+		opts.Aggregates = []string{}
+	}
+
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// UpdateAggregates replaces all the aggregates of a resource provider.
+func UpdateAggregates(ctx context.Context, client *gophercloud.ServiceClient, resourceProviderID string, opts UpdateAggregatesOptsBuilder) (r UpdateAggregatesResult) {
+	b, err := opts.ToResourceProviderUpdateAggregatesMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Put(ctx, updateResourceProviderAggregatesURL(client, resourceProviderID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -56,6 +56,13 @@ type ResourceProviderInventories struct {
 	Inventories                map[string]Inventory `json:"inventories"`
 }
 
+// ResourceProviderInventory is the inventory of a single resource class of a
+// resource provider.
+type ResourceProviderInventory struct {
+	Inventory
+	ResourceProviderGeneration int `json:"resource_provider_generation"`
+}
+
 type ResourceProviderAllocations struct {
 	ResourceProviderGeneration int                   `json:"resource_provider_generation"`
 	Allocations                map[string]Allocation `json:"allocations"`
@@ -66,6 +73,13 @@ type ResourceProviderTraits struct {
 	Traits                     []string `json:"traits"`
 }
 
+// ResourceProviderAggregates are the aggregates a resource provider is a
+// member of.
+type ResourceProviderAggregates struct {
+	ResourceProviderGeneration int      `json:"resource_provider_generation"`
+	Aggregates                 []string `json:"aggregates"`
+}
+
 // resourceProviderResult is the response of a base ResourceProvider result.
 type resourceProviderResult struct {
 	gophercloud.Result
@@ -178,3 +192,95 @@ func (r GetTraitsResult) Extract() (*ResourceProviderTraits, error) {
 	err := r.ExtractInto(&s)
 	return &s, err
 }
+
+// UpdateInventoriesResult is the response of an UpdateInventories operation.
+// Call its Extract method to interpret it as a ResourceProviderInventories.
+type UpdateInventoriesResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an UpdateInventoriesResult as a
+// ResourceProviderInventories.
+func (r UpdateInventoriesResult) Extract() (*ResourceProviderInventories, error) {
+	var s ResourceProviderInventories
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type inventoryResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetInventoryResult or an UpdateInventoryResult as a
+// ResourceProviderInventory.
+func (r inventoryResult) Extract() (*ResourceProviderInventory, error) {
+	var s ResourceProviderInventory
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// GetInventoryResult is the response of a GetInventory operation. Call its
+// Extract method to interpret it as a ResourceProviderInventory.
+type GetInventoryResult struct {
+	inventoryResult
+}
+
+// UpdateInventoryResult is the response of an UpdateInventory operation. Call
+// its Extract method to interpret it as a ResourceProviderInventory.
+type UpdateInventoryResult struct {
+	inventoryResult
+}
+
+// DeleteInventoryResult is the response of a DeleteInventory operation. Call
+// its ExtractErr method to determine if the request succeeded or failed.
+type DeleteInventoryResult struct {
+	gophercloud.ErrResult
+}
+
+// DeleteInventoriesResult is the response of a DeleteInventories operation.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type DeleteInventoriesResult struct {
+	gophercloud.ErrResult
+}
+
+// UpdateTraitsResult is the response of an UpdateTraits operation. Call its
+// Extract method to interpret it as a ResourceProviderTraits.
+type UpdateTraitsResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an UpdateTraitsResult as a ResourceProviderTraits.
+func (r UpdateTraitsResult) Extract() (*ResourceProviderTraits, error) {
+	var s ResourceProviderTraits
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// DeleteTraitsResult is the response of a DeleteTraits operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteTraitsResult struct {
+	gophercloud.ErrResult
+}
+
+type aggregatesResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an aggregatesResult as a ResourceProviderAggregates.
+func (r aggregatesResult) Extract() (*ResourceProviderAggregates, error) {
+	var s ResourceProviderAggregates
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// GetAggregatesResult is the response of a GetAggregates operation. Call its
+// Extract method to interpret it as a ResourceProviderAggregates.
+type GetAggregatesResult struct {
+	aggregatesResult
+}
+
+// UpdateAggregatesResult is the response of an UpdateAggregates operation.
+// Call its Extract method to interpret it as a ResourceProviderAggregates.
+type UpdateAggregatesResult struct {
+	aggregatesResult
+}
@@ -387,3 +387,248 @@ func HandleResourceProviderGetTraits(t *testing.T) {
 			fmt.Fprint(w, TraitsBody)
 		})
 }
+
+const UpdateInventoriesRequest = `
+{
+    "inventories": {
+        "MEMORY_MB": {
+            "allocation_ratio": 1.5,
+            "reserved": 512,
+            "total": 5825
+        },
+        "VCPU": {
+            "allocation_ratio": 16.0,
+            "max_unit": 4,
+            "total": 4
+        }
+    },
+    "resource_provider_generation": 7
+}
+`
+
+const UpdateInventoriesBody = `
+{
+    "inventories": {
+        "MEMORY_MB": {
+            "allocation_ratio": 1.5,
+            "max_unit": 2147483647,
+            "min_unit": 1,
+            "reserved": 512,
+            "step_size": 1,
+            "total": 5825
+        },
+        "VCPU": {
+            "allocation_ratio": 16.0,
+            "max_unit": 4,
+            "min_unit": 1,
+            "reserved": 0,
+            "step_size": 1,
+            "total": 4
+        }
+    },
+    "resource_provider_generation": 8
+}
+`
+
+func HandleResourceProviderUpdateInventories(t *testing.T) {
+	inventoriesTestUrl := fmt.Sprintf("/resource_providers/%s/inventories", ResourceProviderTestID)
+
+	th.Mux.HandleFunc(inventoriesTestUrl,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "PUT")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+			th.TestJSONRequest(t, r, UpdateInventoriesRequest)
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+
+			fmt.Fprint(w, UpdateInventoriesBody)
+		})
+}
+
+const InventoryBody = `
+{
+    "allocation_ratio": 16.0,
+    "max_unit": 4,
+    "min_unit": 1,
+    "reserved": 0,
+    "resource_provider_generation": 9,
+    "step_size": 1,
+    "total": 4
+}
+`
+
+const UpdateInventoryRequest = `
+{
+    "allocation_ratio": 16.0,
+    "resource_provider_generation": 8,
+    "total": 4
+}
+`
+
+var ExpectedInventory = resourceproviders.ResourceProviderInventory{
+	Inventory: resourceproviders.Inventory{
+		AllocationRatio: 16.0,
+		MaxUnit:         4,
+		MinUnit:         1,
+		Reserved:        0,
+		StepSize:        1,
+		Total:           4,
+	},
+	ResourceProviderGeneration: 9,
+}
+
+func HandleResourceProviderInventory(t *testing.T) {
+	inventoryTestUrl := fmt.Sprintf("/resource_providers/%s/inventories/VCPU", ResourceProviderTestID)
+
+	th.Mux.HandleFunc(inventoryTestUrl,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+			switch r.Method {
+			case "GET":
+			case "PUT":
+				th.TestJSONRequest(t, r, UpdateInventoryRequest)
+			case "DELETE":
+				w.WriteHeader(http.StatusNoContent)
+				return
+			default:
+				t.Fatalf("unexpected method %s", r.Method)
+			}
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+
+			fmt.Fprint(w, InventoryBody)
+		})
+}
+
+func HandleResourceProviderDeleteInventories(t *testing.T) {
+	inventoriesTestUrl := fmt.Sprintf("/resource_providers/%s/inventories", ResourceProviderTestID)
+
+	th.Mux.HandleFunc(inventoriesTestUrl,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "DELETE")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+}
+
+const UpdateTraitsRequest = `
+{
+    "resource_provider_generation": 1,
+    "traits": [
+        "CUSTOM_HW_FPGA_CLASS1",
+        "CUSTOM_HW_FPGA_CLASS3",
+        "CUSTOM_HW_FPGA_CLASS4"
+    ]
+}
+`
+
+const UpdateTraitsBody = `
+{
+    "resource_provider_generation": 2,
+    "traits": [
+        "CUSTOM_HW_FPGA_CLASS1",
+        "CUSTOM_HW_FPGA_CLASS3",
+        "CUSTOM_HW_FPGA_CLASS4"
+    ]
+}
+`
+
+// HandleResourceProviderAddTraits responds to the GET and PUT requests of
+// AddTraits. The first PUT fails with a generation conflict.
+func HandleResourceProviderAddTraits(t *testing.T) (puts *int) {
+	traitsTestUrl := fmt.Sprintf("/resource_providers/%s/traits", ResourceProviderTestID)
+	puts = new(int)
+
+	th.Mux.HandleFunc(traitsTestUrl,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+			w.Header().Add("Content-Type", "application/json")
+
+			switch r.Method {
+			case "GET":
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprint(w, TraitsBody)
+			case "PUT":
+				th.TestJSONRequest(t, r, UpdateTraitsRequest)
+				*puts++
+				if *puts == 1 {
+					w.WriteHeader(http.StatusConflict)
+					fmt.Fprint(w, `{"errors": [{"status": 409, "code": "placement.concurrent_update"}]}`)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprint(w, UpdateTraitsBody)
+			default:
+				t.Fatalf("unexpected method %s", r.Method)
+			}
+		})
+
+	return puts
+}
+
+func HandleResourceProviderDeleteTraits(t *testing.T) {
+	traitsTestUrl := fmt.Sprintf("/resource_providers/%s/traits", ResourceProviderTestID)
+
+	th.Mux.HandleFunc(traitsTestUrl,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "DELETE")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+}
+
+const AggregatesBody = `
+{
+    "aggregates": [
+        "42896e0d-205d-4fe9-9d9e-1ac63b0ae6c1",
+        "5e08ea53-c4c6-448e-9334-ac4953de3cfa"
+    ],
+    "resource_provider_generation": 8
+}
+`
+
+const UpdateAggregatesRequest = `
+{
+    "aggregates": [
+        "42896e0d-205d-4fe9-9d9e-1ac63b0ae6c1",
+        "5e08ea53-c4c6-448e-9334-ac4953de3cfa"
+    ],
+    "resource_provider_generation": 7
+}
+`
+
+func HandleResourceProviderGetAggregates(t *testing.T) {
+	aggregatesTestUrl := fmt.Sprintf("/resource_providers/%s/aggregates", ResourceProviderTestID)
+
+	th.Mux.HandleFunc(aggregatesTestUrl,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+
+			fmt.Fprint(w, AggregatesBody)
+		})
+}
+
+func HandleResourceProviderUpdateAggregates(t *testing.T) {
+	aggregatesTestUrl := fmt.Sprintf("/resource_providers/%s/aggregates", ResourceProviderTestID)
+
+	th.Mux.HandleFunc(aggregatesTestUrl,
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "PUT")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+			th.TestJSONRequest(t, r, UpdateAggregatesRequest)
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+
+			fmt.Fprint(w, AggregatesBody)
+		})
+}
@@ -145,3 +145,162 @@ func TestGetResourceProvidersTraits(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.AssertDeepEquals(t, ExpectedTraits, *actual)
 }
+
+func TestUpdateResourceProvidersInventories(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderUpdateInventories(t)
+
+	opts := resourceproviders.UpdateInventoriesOpts{
+		ResourceProviderGeneration: 7,
+		Inventories: map[string]resourceproviders.InventoryOpts{
+			"MEMORY_MB": {
+				Total:           5825,
+				Reserved:        512,
+				AllocationRatio: 1.5,
+			},
+			"VCPU": {
+				Total:           4,
+				MaxUnit:         4,
+				AllocationRatio: 16.0,
+			},
+		},
+	}
+
+	actual, err := resourceproviders.UpdateInventories(context.TODO(), fake.ServiceClient(), ResourceProviderTestID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 8, actual.ResourceProviderGeneration)
+	th.AssertEquals(t, 512, actual.Inventories["MEMORY_MB"].Reserved)
+	th.AssertEquals(t, 4, actual.Inventories["VCPU"].MaxUnit)
+}
+
+func TestUpdateResourceProvidersInventoriesMissingTotal(t *testing.T) {
+	opts := resourceproviders.UpdateInventoriesOpts{
+		Inventories: map[string]resourceproviders.InventoryOpts{
+			"VCPU": {MaxUnit: 4},
+		},
+	}
+
+	_, err := opts.ToResourceProviderUpdateInventoriesMap()
+	if err == nil {
+		t.Fatal("expected an error for an inventory without total")
+	}
+}
+
+func TestGetResourceProviderInventory(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderInventory(t)
+
+	actual, err := resourceproviders.GetInventory(context.TODO(), fake.ServiceClient(), ResourceProviderTestID, "VCPU").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, ExpectedInventory, *actual)
+}
+
+func TestUpdateResourceProviderInventory(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderInventory(t)
+
+	opts := resourceproviders.UpdateInventoryOpts{
+		ResourceProviderGeneration: 8,
+		Inventory: resourceproviders.InventoryOpts{
+			Total:           4,
+			AllocationRatio: 16.0,
+		},
+	}
+
+	actual, err := resourceproviders.UpdateInventory(context.TODO(), fake.ServiceClient(), ResourceProviderTestID, "VCPU", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, ExpectedInventory, *actual)
+}
+
+func TestDeleteResourceProviderInventory(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderInventory(t)
+
+	err := resourceproviders.DeleteInventory(context.TODO(), fake.ServiceClient(), ResourceProviderTestID, "VCPU").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestDeleteResourceProviderInventories(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderDeleteInventories(t)
+
+	err := resourceproviders.DeleteInventories(context.TODO(), fake.ServiceClient(), ResourceProviderTestID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestAddResourceProviderTraits(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	puts := HandleResourceProviderAddTraits(t)
+
+	actual, err := resourceproviders.AddTraits(context.TODO(), fake.ServiceClient(), ResourceProviderTestID, "CUSTOM_HW_FPGA_CLASS3", "CUSTOM_HW_FPGA_CLASS4")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, *puts)
+	th.AssertEquals(t, 2, actual.ResourceProviderGeneration)
+	th.AssertDeepEquals(t, []string{"CUSTOM_HW_FPGA_CLASS1", "CUSTOM_HW_FPGA_CLASS3", "CUSTOM_HW_FPGA_CLASS4"}, actual.Traits)
+}
+
+func TestUpdateResourceProviderTraitsEmpty(t *testing.T) {
+	b, err := resourceproviders.UpdateTraitsOpts{ResourceProviderGeneration: 3}.ToResourceProviderUpdateTraitsMap()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []any{}, b["traits"])
+}
+
+func TestDeleteResourceProviderTraits(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderDeleteTraits(t)
+
+	err := resourceproviders.DeleteTraits(context.TODO(), fake.ServiceClient(), ResourceProviderTestID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestGetResourceProviderAggregates(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderGetAggregates(t)
+
+	actual, err := resourceproviders.GetAggregates(context.TODO(), fake.ServiceClient(), ResourceProviderTestID).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := &resourceproviders.ResourceProviderAggregates{
+		ResourceProviderGeneration: 8,
+		Aggregates: []string{
+			"42896e0d-205d-4fe9-9d9e-1ac63b0ae6c1",
+			"5e08ea53-c4c6-448e-9334-ac4953de3cfa",
+		},
+	}
+	th.AssertDeepEquals(t, expected, actual)
+}
+
+func TestUpdateResourceProviderAggregates(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderUpdateAggregates(t)
+
+	updateOpts := resourceproviders.UpdateAggregatesOpts{
+		ResourceProviderGeneration: 7,
+		Aggregates: []string{
+			"42896e0d-205d-4fe9-9d9e-1ac63b0ae6c1",
+			"5e08ea53-c4c6-448e-9334-ac4953de3cfa",
+		},
+	}
+
+	actual, err := resourceproviders.UpdateAggregates(context.TODO(), fake.ServiceClient(), ResourceProviderTestID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 8, actual.ResourceProviderGeneration)
+}
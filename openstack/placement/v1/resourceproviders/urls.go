@@ -37,3 +37,39 @@ func getResourceProviderAllocationsURL(client *gophercloud.ServiceClient, resour
 func getResourceProviderTraitsURL(client *gophercloud.ServiceClient, resourceProviderID string) string {
 	return client.ServiceURL(apiName, resourceProviderID, "traits")
 }
+
+func updateResourceProviderInventoriesURL(client *gophercloud.ServiceClient, resourceProviderID string) string {
+	return client.ServiceURL(apiName, resourceProviderID, "inventories")
+}
+
+func deleteResourceProviderInventoriesURL(client *gophercloud.ServiceClient, resourceProviderID string) string {
+	return client.ServiceURL(apiName, resourceProviderID, "inventories")
+}
+
+func getResourceProviderInventoryURL(client *gophercloud.ServiceClient, resourceProviderID, resourceClass string) string {
+	return client.ServiceURL(apiName, resourceProviderID, "inventories", resourceClass)
+}
+
+func updateResourceProviderInventoryURL(client *gophercloud.ServiceClient, resourceProviderID, resourceClass string) string {
+	return client.ServiceURL(apiName, resourceProviderID, "inventories", resourceClass)
+}
+
+func deleteResourceProviderInventoryURL(client *gophercloud.ServiceClient, resourceProviderID, resourceClass string) string {
+	return client.ServiceURL(apiName, resourceProviderID, "inventories", resourceClass)
+}
+
+func updateResourceProviderTraitsURL(client *gophercloud.ServiceClient, resourceProviderID string) string {
+	return client.ServiceURL(apiName, resourceProviderID, "traits")
+}
+
+func deleteResourceProviderTraitsURL(client *gophercloud.ServiceClient, resourceProviderID string) string {
+	return client.ServiceURL(apiName, resourceProviderID, "traits")
+}
+
+func getResourceProviderAggregatesURL(client *gophercloud.ServiceClient, resourceProviderID string) string {
+	return client.ServiceURL(apiName, resourceProviderID, "aggregates")
+}
+
+func updateResourceProviderAggregatesURL(client *gophercloud.ServiceClient, resourceProviderID string) string {
+	return client.ServiceURL(apiName, resourceProviderID, "aggregates")
+}
@@ -0,0 +1,65 @@
+package resourceproviders
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// maxGenerationConflictRetries is the number of times AddTraits and
+// RemoveTraits retry after a concurrent update of the resource provider.
+const maxGenerationConflictRetries = 5
+
+// AddTraits adds traits to a resource provider, keeping its other traits.
+// The traits are read and written again when the resource provider is
+// updated concurrently, up to a few times, before the conflict error is
+// returned.
+func AddTraits(ctx context.Context, client *gophercloud.ServiceClient, resourceProviderID string, traits ...string) (*ResourceProviderTraits, error) {
+	return modifyTraits(ctx, client, resourceProviderID, func(current []string) []string {
+		for _, trait := range traits {
+			if !slices.Contains(current, trait) {
+				current = append(current, trait)
+			}
+		}
+		return current
+	})
+}
+
+// RemoveTraits removes traits from a resource provider, keeping its other
+// traits. Concurrent updates are handled as in AddTraits.
+func RemoveTraits(ctx context.Context, client *gophercloud.ServiceClient, resourceProviderID string, traits ...string) (*ResourceProviderTraits, error) {
+	return modifyTraits(ctx, client, resourceProviderID, func(current []string) []string {
+		return slices.DeleteFunc(current, func(trait string) bool {
+			return slices.Contains(traits, trait)
+		})
+	})
+}
+
+func modifyTraits(ctx context.Context, client *gophercloud.ServiceClient, resourceProviderID string, modify func([]string) []string) (*ResourceProviderTraits, error) {
+	var err error
+	for i := 0; i <= maxGenerationConflictRetries; i++ {
+		var current *ResourceProviderTraits
+		current, err = GetTraits(ctx, client, resourceProviderID).Extract()
+		if err != nil {
+			return nil, err
+		}
+
+		opts := UpdateTraitsOpts{
+			ResourceProviderGeneration: current.ResourceProviderGeneration,
+			Traits:                     modify(current.Traits),
+		}
+
+		var updated *ResourceProviderTraits
+		updated, err = UpdateTraits(ctx, client, resourceProviderID, opts).Extract()
+		if err == nil {
+			return updated, nil
+		}
+		if !gophercloud.ResponseCodeIs(err, http.StatusConflict) {
+			return nil, err
+		}
+	}
+
+	return nil, err
+}
@@ -0,0 +1,40 @@
+/*
+Package traits lists, creates and deletes traits in the OpenStack Placement
+service. The traits of a resource provider are managed with the
+resourceproviders package.
+
+Example to list custom traits
+
+	listOpts := traits.ListOpts{
+		NameStartsWith: traits.CustomPrefix,
+	}
+
+	allPages, err := traits.List(placementClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allTraits, err := traits.ExtractTraits(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, trait := range allTraits {
+		fmt.Println(trait)
+	}
+
+Example to create a custom trait
+
+	err := traits.Create(context.TODO(), placementClient, "CUSTOM_HW_FPGA_CLASS1").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to delete a custom trait
+
+	err := traits.Delete(context.TODO(), placementClient, "CUSTOM_HW_FPGA_CLASS1").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package traits
@@ -0,0 +1,111 @@
+package traits
+
+import (
+	"context"
+	"strings"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToTraitListQuery() (string, error)
+}
+
+// ListOpts allows filtering the traits returned by List. NameIn and
+// NameStartsWith can't be used together.
+type ListOpts struct {
+	// NameIn returns only the traits with one of the given names.
+	NameIn []string
+
+	// NameStartsWith returns only the traits whose name starts with the given
+	// prefix, such as "CUSTOM_".
+	NameStartsWith string
+
+	// Associated returns only the traits which are, or are not, associated
+	// with at least one resource provider.
+	Associated *bool `q:"associated"`
+}
+
+// ToTraitListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToTraitListQuery() (string, error) {
+	if len(opts.NameIn) > 0 && opts.NameStartsWith != "" {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "traits.ListOpts.NameStartsWith"
+		err.Value = opts.NameStartsWith
+		err.Info = "NameIn and NameStartsWith can't be used together"
+		return "", err
+	}
+
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+
+	params := q.Query()
+	if len(opts.NameIn) > 0 {
+		params.Set("name", "in:"+strings.Join(opts.NameIn, ","))
+	}
+	if opts.NameStartsWith != "" {
+		params.Set("name", "startswith:"+opts.NameStartsWith)
+	}
+	q.RawQuery = params.Encode()
+
+	return q.String(), nil
+}
+
+// List makes a request against the API to list traits.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+
+	if opts != nil {
+		query, err := opts.ToTraitListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return TraitPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get checks whether a trait exists. The result has no body, and a 404 error
+// is returned when the trait does not exist.
+func Get(ctx context.Context, client *gophercloud.ServiceClient, name string) (r GetResult) {
+	resp, err := client.Get(ctx, getURL(client, name), nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Create creates a custom trait. The name must start with "CUSTOM_". Creating
+// a trait which already exists succeeds.
+func Create(ctx context.Context, client *gophercloud.ServiceClient, name string) (r CreateResult) {
+	if !strings.HasPrefix(name, CustomPrefix) {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "name"
+		err.Value = name
+		err.Info = "custom traits must start with " + CustomPrefix
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Put(ctx, createURL(client, name), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{201, 204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete deletes a custom trait. Standard traits and traits associated with
+// a resource provider can't be deleted.
+func Delete(ctx context.Context, client *gophercloud.ServiceClient, name string) (r DeleteResult) {
+	resp, err := client.Delete(ctx, deleteURL(client, name), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
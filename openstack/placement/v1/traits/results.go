@@ -0,0 +1,51 @@
+package traits
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// CustomPrefix is the prefix of the names of custom traits.
+const CustomPrefix = "CUSTOM_"
+
+// TraitPage contains a single page of all traits from a List call.
+type TraitPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines if a TraitPage contains any results.
+func (page TraitPage) IsEmpty() (bool, error) {
+	if page.StatusCode == 204 {
+		return true, nil
+	}
+
+	traits, err := ExtractTraits(page)
+	return len(traits) == 0, err
+}
+
+// ExtractTraits returns the names of the traits from a List operation.
+func ExtractTraits(r pagination.Page) ([]string, error) {
+	var s struct {
+		Traits []string `json:"traits"`
+	}
+	err := (r.(TraitPage)).ExtractInto(&s)
+	return s.Traits, err
+}
+
+// GetResult is the response of a Get operation. Call its ExtractErr method
+// to determine if the trait exists.
+type GetResult struct {
+	gophercloud.ErrResult
+}
+
+// CreateResult is the response of a Create operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type CreateResult struct {
+	gophercloud.ErrResult
+}
+
+// DeleteResult is the response of a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,67 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+const TraitsListBody = `
+{
+    "traits": [
+        "CUSTOM_HW_FPGA_CLASS1",
+        "CUSTOM_HW_FPGA_CLASS3"
+    ]
+}
+`
+
+var ExpectedTraits = []string{
+	"CUSTOM_HW_FPGA_CLASS1",
+	"CUSTOM_HW_FPGA_CLASS3",
+}
+
+func HandleTraitList(t *testing.T) {
+	th.Mux.HandleFunc("/traits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"name":       "in:CUSTOM_HW_FPGA_CLASS1,CUSTOM_HW_FPGA_CLASS3",
+			"associated": "true",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, TraitsListBody)
+	})
+}
+
+func HandleTraitGet(t *testing.T) {
+	th.Mux.HandleFunc("/traits/CUSTOM_HW_FPGA_CLASS1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func HandleTraitCreate(t *testing.T) {
+	th.Mux.HandleFunc("/traits/CUSTOM_HW_FPGA_CLASS1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusCreated)
+	})
+}
+
+func HandleTraitDelete(t *testing.T) {
+	th.Mux.HandleFunc("/traits/CUSTOM_HW_FPGA_CLASS1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,80 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/placement/v1/traits"
+
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestListTraits(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleTraitList(t)
+
+	associated := true
+	listOpts := traits.ListOpts{
+		NameIn:     []string{"CUSTOM_HW_FPGA_CLASS1", "CUSTOM_HW_FPGA_CLASS3"},
+		Associated: &associated,
+	}
+
+	allPages, err := traits.List(fake.ServiceClient(), listOpts).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	actual, err := traits.ExtractTraits(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, ExpectedTraits, actual)
+}
+
+func TestListOptsNameStartsWith(t *testing.T) {
+	query, err := traits.ListOpts{NameStartsWith: "CUSTOM_"}.ToTraitListQuery()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "?name=startswith%3ACUSTOM_", query)
+
+	_, err = traits.ListOpts{NameIn: []string{"HW_CPU_X86_AVX"}, NameStartsWith: "CUSTOM_"}.ToTraitListQuery()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestGetTrait(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleTraitGet(t)
+
+	err := traits.Get(context.TODO(), fake.ServiceClient(), "CUSTOM_HW_FPGA_CLASS1").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestCreateTrait(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleTraitCreate(t)
+
+	err := traits.Create(context.TODO(), fake.ServiceClient(), "CUSTOM_HW_FPGA_CLASS1").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestCreateStandardTrait(t *testing.T) {
+	err := traits.Create(context.TODO(), fake.ServiceClient(), "HW_CPU_X86_AVX").ExtractErr()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestDeleteTrait(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleTraitDelete(t)
+
+	err := traits.Delete(context.TODO(), fake.ServiceClient(), "CUSTOM_HW_FPGA_CLASS1").ExtractErr()
+	th.AssertNoErr(t, err)
+}
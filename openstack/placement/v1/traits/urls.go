@@ -0,0 +1,21 @@
+package traits
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+const apiName = "traits"
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(apiName)
+}
+
+func getURL(client *gophercloud.ServiceClient, name string) string {
+	return client.ServiceURL(apiName, name)
+}
+
+func createURL(client *gophercloud.ServiceClient, name string) string {
+	return client.ServiceURL(apiName, name)
+}
+
+func deleteURL(client *gophercloud.ServiceClient, name string) string {
+	return client.ServiceURL(apiName, name)
+}
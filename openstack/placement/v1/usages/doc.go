@@ -0,0 +1,36 @@
+/*
+Package usages retrieves the resource usage of a project or a user from the
+OpenStack Placement service.
+
+Example to get the usages of a project
+
+	placementClient.Microversion = "1.9"
+
+	getOpts := usages.GetOpts{
+		ProjectID: "616fb98f-46ca-475e-917e-2563e5a8cd19",
+	}
+
+	usage, err := usages.Get(context.TODO(), placementClient, getOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("VCPU: %d\n", usage["VCPU"])
+
+Example to get the usages of a project by consumer type
+
+	placementClient.Microversion = "1.38"
+
+	getOpts := usages.GetOpts{
+		ProjectID:    "616fb98f-46ca-475e-917e-2563e5a8cd19",
+		ConsumerType: "INSTANCE",
+	}
+
+	usage, err := usages.Get(context.TODO(), placementClient, getOpts).ExtractByConsumerType()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%d instances\n", usage["INSTANCE"]["consumer_count"])
+*/
+package usages
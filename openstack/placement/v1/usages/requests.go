@@ -0,0 +1,50 @@
+package usages
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// GetOptsBuilder allows extensions to add additional parameters to the
+// Get request.
+type GetOptsBuilder interface {
+	ToUsageGetQuery() (string, error)
+}
+
+// GetOpts selects the consumers whose usages are reported.
+type GetOpts struct {
+	// ProjectID is the project of the consumers.
+	ProjectID string `q:"project_id" required:"true"`
+
+	// UserID restricts the usages to the consumers of a user.
+	UserID string `q:"user_id"`
+
+	// ConsumerType restricts the usages to a consumer type, such as
+	// "INSTANCE", or "all" and "unknown". It requires microversion 1.38 or
+	// later.
+	ConsumerType string `q:"consumer_type"`
+}
+
+// ToUsageGetQuery formats a GetOpts into a query string.
+func (opts GetOpts) ToUsageGetQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// Get returns the total resource usage of the consumers of a project. It
+// requires microversion 1.9 or later.
+func Get(ctx context.Context, client *gophercloud.ServiceClient, opts GetOptsBuilder) (r GetResult) {
+	query, err := opts.ToUsageGetQuery()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Get(ctx, getURL(client)+query, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
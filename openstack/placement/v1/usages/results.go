@@ -0,0 +1,31 @@
+package usages
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+// GetResult is the response of a Get operation. Call its Extract or
+// ExtractByConsumerType method to interpret it.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as the usages keyed by resource class. It is
+// used with microversions older than 1.38.
+func (r GetResult) Extract() (map[string]int, error) {
+	var s struct {
+		Usages map[string]int `json:"usages"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Usages, err
+}
+
+// ExtractByConsumerType interprets a GetResult as the usages keyed by
+// consumer type, then by resource class. Each consumer type also reports its
+// number of consumers under the "consumer_count" key. It is used with
+// microversion 1.38 or later.
+func (r GetResult) ExtractByConsumerType() (map[string]map[string]int, error) {
+	var s struct {
+		Usages map[string]map[string]int `json:"usages"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Usages, err
+}
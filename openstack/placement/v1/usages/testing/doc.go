@@ -0,0 +1,2 @@
+// placement usages
+package testing
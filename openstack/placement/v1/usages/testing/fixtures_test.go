@@ -0,0 +1,69 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+const ProjectID = "616fb98f-46ca-475e-917e-2563e5a8cd19"
+
+const UserID = "5a8d7c1e-3b4f-4f2a-9e6d-1c2b3a4d5e6f"
+
+const UsagesBody = `
+{
+    "usages": {
+        "DISK_GB": 5,
+        "MEMORY_MB": 512,
+        "VCPU": 2
+    }
+}
+`
+
+const UsagesByConsumerTypeBody = `
+{
+    "usages": {
+        "INSTANCE": {
+            "consumer_count": 1,
+            "DISK_GB": 5,
+            "MEMORY_MB": 512,
+            "VCPU": 2
+        }
+    }
+}
+`
+
+func HandleGetUsages(t *testing.T) {
+	th.Mux.HandleFunc("/usages", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"project_id": ProjectID,
+			"user_id":    UserID,
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UsagesBody)
+	})
+}
+
+func HandleGetUsagesByConsumerType(t *testing.T) {
+	th.Mux.HandleFunc("/usages", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"project_id":    ProjectID,
+			"consumer_type": "INSTANCE",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UsagesByConsumerTypeBody)
+	})
+}
@@ -0,0 +1,51 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/placement/v1/usages"
+
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestGetUsages(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleGetUsages(t)
+
+	getOpts := usages.GetOpts{
+		ProjectID: ProjectID,
+		UserID:    UserID,
+	}
+
+	actual, err := usages.Get(context.TODO(), fake.ServiceClient(), getOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, map[string]int{"DISK_GB": 5, "MEMORY_MB": 512, "VCPU": 2}, actual)
+}
+
+func TestGetUsagesByConsumerType(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleGetUsagesByConsumerType(t)
+
+	getOpts := usages.GetOpts{
+		ProjectID:    ProjectID,
+		ConsumerType: "INSTANCE",
+	}
+
+	actual, err := usages.Get(context.TODO(), fake.ServiceClient(), getOpts).ExtractByConsumerType()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, actual["INSTANCE"]["consumer_count"])
+	th.AssertEquals(t, 2, actual["INSTANCE"]["VCPU"])
+}
+
+func TestGetUsagesRequiresProject(t *testing.T) {
+	_, err := usages.GetOpts{UserID: UserID}.ToUsageGetQuery()
+	if err == nil {
+		t.Fatal("expected an error when the project is missing")
+	}
+}
@@ -0,0 +1,9 @@
+package usages
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+const apiName = "usages"
+
+func getURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(apiName)
+}
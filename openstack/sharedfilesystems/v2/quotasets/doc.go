@@ -0,0 +1,55 @@
+/*
+Package quotasets enables retrieving and managing the quotas of the Shared
+File Systems service for a project, one of its users or a share type.
+
+Example to Get the Quotas of a Project
+
+	quotaSet, err := quotasets.Get(context.TODO(), sharedFileSystemV2, "project-id", nil).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", quotaSet)
+
+Example to Get the Quotas and Usage of a Share Type
+
+	sharedFileSystemV2.Microversion = "2.39"
+
+	getOpts := quotasets.GetOpts{
+		ShareType: "default",
+	}
+
+	quotaDetailSet, err := quotasets.GetDetail(context.TODO(), sharedFileSystemV2, "project-id", getOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("shares: %d of %d\n", quotaDetailSet.Shares.InUse, quotaDetailSet.Shares.Limit)
+
+Example to Update the Quotas of a Share Type
+
+	sharedFileSystemV2.Microversion = "2.39"
+
+	updateOpts := quotasets.UpdateOpts{
+		ShareType: "default",
+		Shares:    gophercloud.IntToPointer(20),
+		Gigabytes: gophercloud.IntToPointer(1000),
+	}
+
+	quotaSet, err := quotasets.Update(context.TODO(), sharedFileSystemV2, "project-id", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Reset the Quotas of a Share Type
+
+	deleteOpts := quotasets.GetOpts{
+		ShareType: "default",
+	}
+
+	err := quotasets.Delete(context.TODO(), sharedFileSystemV2, "project-id", deleteOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package quotasets
@@ -0,0 +1,191 @@
+package quotasets
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// GetOptsBuilder allows extensions to add additional parameters to the Get,
+// GetDetail and Delete requests.
+type GetOptsBuilder interface {
+	ToQuotaSetGetQuery() (string, error)
+}
+
+// GetOpts selects the quotas of a user or of a share type within a project.
+// The quotas of the project are used when both are empty.
+type GetOpts struct {
+	// UserID is the ID of a user of the project.
+	UserID string `q:"user_id"`
+
+	// ShareType is the name or ID of a share type. It requires
+	// microversion 2.39 or later.
+	ShareType string `q:"share_type"`
+}
+
+// ToQuotaSetGetQuery formats a GetOpts into a query string.
+func (opts GetOpts) ToQuotaSetGetQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// Get returns the quotas of a project.
+func Get(ctx context.Context, client *gophercloud.ServiceClient, projectID string, opts GetOptsBuilder) (r GetResult) {
+	url := getURL(client, projectID)
+	if opts != nil {
+		query, err := opts.ToQuotaSetGetQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+
+	resp, err := client.Get(ctx, url, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetDetail returns the quotas of a project along with their usage. It
+// requires microversion 2.25 or later.
+func GetDetail(ctx context.Context, client *gophercloud.ServiceClient, projectID string, opts GetOptsBuilder) (r GetDetailResult) {
+	url := getDetailURL(client, projectID)
+	if opts != nil {
+		query, err := opts.ToQuotaSetGetQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+
+	resp, err := client.Get(ctx, url, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetDefaults returns the default quotas of a project.
+func GetDefaults(ctx context.Context, client *gophercloud.ServiceClient, projectID string) (r GetResult) {
+	resp, err := client.Get(ctx, getDefaultsURL(client, projectID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToQuotaSetUpdateMap() (map[string]any, error)
+	ToQuotaSetUpdateQuery() (string, error)
+}
+
+// UpdateOpts contains the quotas to update. All int-values are pointers so
+// they can be nil if they are not needed.
+type UpdateOpts struct {
+	// UserID is the ID of a user of the project whose quotas are updated.
+	UserID string `q:"user_id" json:"-"`
+
+	// ShareType is the name or ID of a share type whose quotas are updated.
+	// It requires microversion 2.39 or later. ShareNetworks can't be set
+	// along with a share type.
+	ShareType string `q:"share_type" json:"-"`
+
+	// Shares is the number of shares allowed.
+	Shares *int `json:"shares,omitempty"`
+
+	// Snapshots is the number of snapshots allowed.
+	Snapshots *int `json:"snapshots,omitempty"`
+
+	// Gigabytes is the total size (GiB) of shares allowed.
+	Gigabytes *int `json:"gigabytes,omitempty"`
+
+	// SnapshotGigabytes is the total size (GiB) of snapshots allowed.
+	SnapshotGigabytes *int `json:"snapshot_gigabytes,omitempty"`
+
+	// ShareNetworks is the number of share networks allowed.
+	ShareNetworks *int `json:"share_networks,omitempty"`
+
+	// ShareGroups is the number of share groups allowed. It requires
+	// microversion 2.40 or later.
+	ShareGroups *int `json:"share_groups,omitempty"`
+
+	// ShareGroupSnapshots is the number of share group snapshots allowed.
+	// It requires microversion 2.40 or later.
+	ShareGroupSnapshots *int `json:"share_group_snapshots,omitempty"`
+
+	// ShareReplicas is the number of share replicas allowed. It requires
+	// microversion 2.53 or later.
+	ShareReplicas *int `json:"share_replicas,omitempty"`
+
+	// ReplicaGigabytes is the total size (GiB) of share replicas allowed.
+	// It requires microversion 2.53 or later.
+	ReplicaGigabytes *int `json:"replica_gigabytes,omitempty"`
+
+	// PerShareGigabytes is the maximum size (GiB) of a single share. It
+	// requires microversion 2.62 or later.
+	PerShareGigabytes *int `json:"per_share_gigabytes,omitempty"`
+
+	// Force updates the quotas even if they are already exceeded by the
+	// current usage.
+	Force bool `json:"force,omitempty"`
+}
+
+// ToQuotaSetUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToQuotaSetUpdateMap() (map[string]any, error) {
+	if opts.ShareType != "" && opts.ShareNetworks != nil {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "quotasets.UpdateOpts.ShareNetworks"
+		err.Value = *opts.ShareNetworks
+		err.Info = "share networks quota can't be set for a share type"
+		return nil, err
+	}
+
+	return gophercloud.BuildRequestBody(opts, "quota_set")
+}
+
+// ToQuotaSetUpdateQuery formats the user and share type of UpdateOpts into a
+// query string.
+func (opts UpdateOpts) ToQuotaSetUpdateQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// Update updates the quotas of a project and returns the new QuotaSet.
+func Update(ctx context.Context, client *gophercloud.ServiceClient, projectID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToQuotaSetUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	query, err := opts.ToQuotaSetUpdateQuery()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Put(ctx, updateURL(client, projectID)+query, b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete resets the quotas of a project, of one of its users or of a share
+// type to their default values.
+func Delete(ctx context.Context, client *gophercloud.ServiceClient, projectID string, opts GetOptsBuilder) (r DeleteResult) {
+	url := deleteURL(client, projectID)
+	if opts != nil {
+		query, err := opts.ToQuotaSetGetQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+
+	resp, err := client.Delete(ctx, url, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,119 @@
+package quotasets
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// QuotaSet is a set of operational limits of the Shared File Systems service.
+type QuotaSet struct {
+	// ID is the project associated with this QuotaSet.
+	ID string `json:"id"`
+
+	// Shares is the number of shares allowed.
+	Shares int `json:"shares"`
+
+	// Snapshots is the number of snapshots allowed.
+	Snapshots int `json:"snapshots"`
+
+	// Gigabytes is the total size (GiB) of shares allowed.
+	Gigabytes int `json:"gigabytes"`
+
+	// SnapshotGigabytes is the total size (GiB) of snapshots allowed.
+	SnapshotGigabytes int `json:"snapshot_gigabytes"`
+
+	// ShareNetworks is the number of share networks allowed. It is not
+	// reported for share type quotas.
+	ShareNetworks int `json:"share_networks"`
+
+	// ShareGroups is the number of share groups allowed.
+	ShareGroups int `json:"share_groups"`
+
+	// ShareGroupSnapshots is the number of share group snapshots allowed.
+	ShareGroupSnapshots int `json:"share_group_snapshots"`
+
+	// ShareReplicas is the number of share replicas allowed.
+	ShareReplicas int `json:"share_replicas"`
+
+	// ReplicaGigabytes is the total size (GiB) of share replicas allowed.
+	ReplicaGigabytes int `json:"replica_gigabytes"`
+
+	// PerShareGigabytes is the maximum size (GiB) of a single share.
+	PerShareGigabytes int `json:"per_share_gigabytes"`
+}
+
+// QuotaDetailSet represents both the quotas of the Shared File Systems
+// service and their usage.
+type QuotaDetailSet struct {
+	// ID is the project associated with this QuotaDetailSet.
+	ID string `json:"id"`
+
+	Shares              QuotaDetail `json:"shares"`
+	Snapshots           QuotaDetail `json:"snapshots"`
+	Gigabytes           QuotaDetail `json:"gigabytes"`
+	SnapshotGigabytes   QuotaDetail `json:"snapshot_gigabytes"`
+	ShareNetworks       QuotaDetail `json:"share_networks"`
+	ShareGroups         QuotaDetail `json:"share_groups"`
+	ShareGroupSnapshots QuotaDetail `json:"share_group_snapshots"`
+	ShareReplicas       QuotaDetail `json:"share_replicas"`
+	ReplicaGigabytes    QuotaDetail `json:"replica_gigabytes"`
+	PerShareGigabytes   QuotaDetail `json:"per_share_gigabytes"`
+}
+
+// QuotaDetail is the limit and usage of a single quota.
+type QuotaDetail struct {
+	// InUse is the amount of the resource currently in use.
+	InUse int `json:"in_use"`
+
+	// Reserved is the amount of the resource claimed by requests in
+	// progress.
+	Reserved int `json:"reserved"`
+
+	// Limit is the maximum amount of the resource. -1 means unlimited.
+	Limit int `json:"limit"`
+}
+
+type quotaResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult or an UpdateResult as a QuotaSet.
+func (r quotaResult) Extract() (*QuotaSet, error) {
+	var s struct {
+		QuotaSet *QuotaSet `json:"quota_set"`
+	}
+	err := r.ExtractInto(&s)
+	return s.QuotaSet, err
+}
+
+// GetResult is the response from a Get or GetDefaults operation. Call its
+// Extract method to interpret it as a QuotaSet.
+type GetResult struct {
+	quotaResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a QuotaSet.
+type UpdateResult struct {
+	quotaResult
+}
+
+// GetDetailResult is the response from a GetDetail operation. Call its
+// Extract method to interpret it as a QuotaDetailSet.
+type GetDetailResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetDetailResult as a QuotaDetailSet.
+func (r GetDetailResult) Extract() (*QuotaDetailSet, error) {
+	var s struct {
+		QuotaDetailSet *QuotaDetailSet `json:"quota_set"`
+	}
+	err := r.ExtractInto(&s)
+	return s.QuotaDetailSet, err
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,163 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/sharedfilesystems/v2/quotasets"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+const FirstProjectID = "555544443333222211110000ffffeeee"
+
+const GetResponse = `
+{
+    "quota_set": {
+        "id": "555544443333222211110000ffffeeee",
+        "shares": 50,
+        "snapshots": 50,
+        "gigabytes": 1000,
+        "snapshot_gigabytes": 1000,
+        "share_networks": 10,
+        "share_groups": 50,
+        "share_group_snapshots": 50,
+        "share_replicas": 100,
+        "replica_gigabytes": 1000,
+        "per_share_gigabytes": -1
+    }
+}
+`
+
+var ExpectedQuotaSet = quotasets.QuotaSet{
+	ID:                  FirstProjectID,
+	Shares:              50,
+	Snapshots:           50,
+	Gigabytes:           1000,
+	SnapshotGigabytes:   1000,
+	ShareNetworks:       10,
+	ShareGroups:         50,
+	ShareGroupSnapshots: 50,
+	ShareReplicas:       100,
+	ReplicaGigabytes:    1000,
+	PerShareGigabytes:   -1,
+}
+
+const GetDetailResponse = `
+{
+    "quota_set": {
+        "id": "555544443333222211110000ffffeeee",
+        "shares": {"in_use": 3, "reserved": 1, "limit": 20},
+        "snapshots": {"in_use": 0, "reserved": 0, "limit": 50},
+        "gigabytes": {"in_use": 30, "reserved": 10, "limit": 500},
+        "snapshot_gigabytes": {"in_use": 0, "reserved": 0, "limit": 1000},
+        "share_groups": {"in_use": 0, "reserved": 0, "limit": 50},
+        "share_group_snapshots": {"in_use": 0, "reserved": 0, "limit": 50},
+        "share_replicas": {"in_use": 0, "reserved": 0, "limit": 100},
+        "replica_gigabytes": {"in_use": 0, "reserved": 0, "limit": 1000},
+        "per_share_gigabytes": {"in_use": 0, "reserved": 0, "limit": -1}
+    }
+}
+`
+
+var ExpectedQuotaDetailSet = quotasets.QuotaDetailSet{
+	ID:                  FirstProjectID,
+	Shares:              quotasets.QuotaDetail{InUse: 3, Reserved: 1, Limit: 20},
+	Snapshots:           quotasets.QuotaDetail{Limit: 50},
+	Gigabytes:           quotasets.QuotaDetail{InUse: 30, Reserved: 10, Limit: 500},
+	SnapshotGigabytes:   quotasets.QuotaDetail{Limit: 1000},
+	ShareGroups:         quotasets.QuotaDetail{Limit: 50},
+	ShareGroupSnapshots: quotasets.QuotaDetail{Limit: 50},
+	ShareReplicas:       quotasets.QuotaDetail{Limit: 100},
+	ReplicaGigabytes:    quotasets.QuotaDetail{Limit: 1000},
+	PerShareGigabytes:   quotasets.QuotaDetail{Limit: -1},
+}
+
+const UpdateRequest = `
+{
+    "quota_set": {
+        "shares": 20,
+        "gigabytes": 500
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "quota_set": {
+        "shares": 20,
+        "snapshots": 50,
+        "gigabytes": 500,
+        "snapshot_gigabytes": 1000,
+        "share_groups": 50,
+        "share_group_snapshots": 50,
+        "share_replicas": 100,
+        "replica_gigabytes": 1000,
+        "per_share_gigabytes": -1
+    }
+}
+`
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request for the project quotas.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/quota-sets/"+FirstProjectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleGetDetailSuccessfully configures the test server to respond to a
+// GetDetail request for the quotas of the "default" share type.
+func HandleGetDetailSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/quota-sets/"+FirstProjectID+"/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"share_type": "default"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetDetailResponse)
+	})
+}
+
+// HandleGetDefaultsSuccessfully configures the test server to respond to a
+// GetDefaults request.
+func HandleGetDefaultsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/quota-sets/"+FirstProjectID+"/defaults", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an Update
+// request for the quotas of the "default" share type.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/quota-sets/"+FirstProjectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"share_type": "default"})
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, UpdateResponse)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete
+// request for the quotas of the "default" share type.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/quota-sets/"+FirstProjectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"share_type": "default"})
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
@@ -0,0 +1,79 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/sharedfilesystems/v2/quotasets"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := quotasets.Get(context.TODO(), fake.ServiceClient(), FirstProjectID, nil).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ExpectedQuotaSet, actual)
+}
+
+func TestGetDetail(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetDetailSuccessfully(t)
+
+	getOpts := quotasets.GetOpts{ShareType: "default"}
+	actual, err := quotasets.GetDetail(context.TODO(), fake.ServiceClient(), FirstProjectID, getOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ExpectedQuotaDetailSet, actual)
+}
+
+func TestGetDefaults(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetDefaultsSuccessfully(t)
+
+	actual, err := quotasets.GetDefaults(context.TODO(), fake.ServiceClient(), FirstProjectID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ExpectedQuotaSet, actual)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	updateOpts := quotasets.UpdateOpts{
+		ShareType: "default",
+		Shares:    gophercloud.IntToPointer(20),
+		Gigabytes: gophercloud.IntToPointer(500),
+	}
+	actual, err := quotasets.Update(context.TODO(), fake.ServiceClient(), FirstProjectID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 20, actual.Shares)
+	th.AssertEquals(t, 500, actual.Gigabytes)
+}
+
+func TestUpdateShareNetworksForShareType(t *testing.T) {
+	updateOpts := quotasets.UpdateOpts{
+		ShareType:     "default",
+		ShareNetworks: gophercloud.IntToPointer(5),
+	}
+	_, err := updateOpts.ToQuotaSetUpdateMap()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	deleteOpts := quotasets.GetOpts{ShareType: "default"}
+	err := quotasets.Delete(context.TODO(), fake.ServiceClient(), FirstProjectID, deleteOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
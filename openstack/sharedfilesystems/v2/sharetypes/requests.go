@@ -7,6 +7,21 @@ import (
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
 
+// Share replication types accepted by the replication_type extra
+// specification.
+const (
+	ReplicationTypeReadable = "readable"
+	ReplicationTypeWritable = "writable"
+	ReplicationTypeDR       = "dr"
+)
+
+// Well-known extra specification keys.
+const (
+	ExtraSpecDriverHandlesShareServers = "driver_handles_share_servers"
+	ExtraSpecSnapshotSupport           = "snapshot_support"
+	ExtraSpecReplicationType           = "replication_type"
+)
+
 // CreateOptsBuilder allows extensions to add additional parameters to the
 // Create request.
 type CreateOptsBuilder interface {
@@ -31,11 +46,20 @@ type ExtraSpecsOpts struct {
 	DriverHandlesShareServers bool `json:"driver_handles_share_servers" required:"true"`
 	// An extra specification that filters back ends by whether they do or do not support share snapshots
 	SnapshotSupport *bool `json:"snapshot_support,omitempty"`
+	// An extra specification that filters back ends by the share replication
+	// type they support. Must be one of ReplicationTypeReadable,
+	// ReplicationTypeWritable or ReplicationTypeDR.
+	ReplicationType string `json:"replication_type,omitempty"`
 }
 
 // ToShareTypeCreateMap assembles a request body based on the contents of a
 // CreateOpts.
 func (opts CreateOpts) ToShareTypeCreateMap() (map[string]any, error) {
+	if opts.ExtraSpecs.ReplicationType != "" {
+		if err := validateReplicationType(opts.ExtraSpecs.ReplicationType); err != nil {
+			return nil, err
+		}
+	}
 	return gophercloud.BuildRequestBody(opts, "share_type")
 }
 
@@ -117,6 +141,7 @@ type SetExtraSpecsOptsBuilder interface {
 	ToShareTypeSetExtraSpecsMap() (map[string]any, error)
 }
 
+// SetExtraSpecsOpts contains the extra specifications to set on a ShareType.
 type SetExtraSpecsOpts struct {
 	// A list of all extra specifications to be added to a ShareType
 	ExtraSpecs map[string]any `json:"extra_specs" required:"true"`
@@ -124,7 +149,28 @@ type SetExtraSpecsOpts struct {
 
 // ToShareTypeSetExtraSpecsMap assembles a request body based on the contents of a
 // SetExtraSpecsOpts.
+//
+// The values of the driver_handles_share_servers and replication_type extra
+// specifications are validated when present.
 func (opts SetExtraSpecsOpts) ToShareTypeSetExtraSpecsMap() (map[string]any, error) {
+	if v, ok := opts.ExtraSpecs[ExtraSpecDriverHandlesShareServers]; ok {
+		if _, err := parseBoolSpec(ExtraSpecDriverHandlesShareServers, v); err != nil {
+			return nil, err
+		}
+	}
+	if v, ok := opts.ExtraSpecs[ExtraSpecReplicationType]; ok {
+		s, isString := v.(string)
+		if !isString {
+			err := gophercloud.ErrInvalidInput{}
+			err.Argument = "sharetypes.SetExtraSpecsOpts.ExtraSpecs." + ExtraSpecReplicationType
+			err.Value = v
+			err.Info = "must be a string"
+			return nil, err
+		}
+		if err := validateReplicationType(s); err != nil {
+			return nil, err
+		}
+	}
 	return gophercloud.BuildRequestBody(opts, "")
 }
 
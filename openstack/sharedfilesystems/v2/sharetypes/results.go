@@ -77,6 +77,33 @@ type GetDefaultResult struct {
 // for an Openstack ShareType.
 type ExtraSpecs map[string]any
 
+// DriverHandlesShareServers returns the value of the
+// driver_handles_share_servers extra specification. An error is returned if
+// the specification is missing or is not a boolean.
+func (es ExtraSpecs) DriverHandlesShareServers() (bool, error) {
+	v, ok := es[ExtraSpecDriverHandlesShareServers]
+	if !ok {
+		return false, gophercloud.ErrMissingInput{Argument: ExtraSpecDriverHandlesShareServers}
+	}
+	return parseBoolSpec(ExtraSpecDriverHandlesShareServers, v)
+}
+
+// ReplicationType returns the value of the replication_type extra
+// specification, or an empty string if share replication is not enabled
+// for the ShareType. An error is returned if the value is not a supported
+// replication type.
+func (es ExtraSpecs) ReplicationType() (string, error) {
+	v, ok := es[ExtraSpecReplicationType]
+	if !ok {
+		return "", nil
+	}
+	s, _ := v.(string)
+	if err := validateReplicationType(s); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
 type extraSpecsResult struct {
 	gophercloud.Result
 }
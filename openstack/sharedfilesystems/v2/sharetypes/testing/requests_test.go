@@ -61,6 +61,27 @@ func TestCreateFails(t *testing.T) {
 	}
 }
 
+// Verifies that an unsupported replication type is rejected
+func TestCreateInvalidReplicationType(t *testing.T) {
+	options := &sharetypes.CreateOpts{
+		Name: "my_new_share_type",
+		ExtraSpecs: sharetypes.ExtraSpecsOpts{
+			DriverHandlesShareServers: true,
+			ReplicationType:           "async",
+		},
+	}
+
+	_, err := options.ToShareTypeCreateMap()
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("ErrInvalidInput was expected to occur, got %v", err)
+	}
+
+	options.ExtraSpecs.ReplicationType = sharetypes.ReplicationTypeDR
+	b, err := options.ToShareTypeCreateMap()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "dr", b["share_type"].(map[string]any)["extra_specs"].(map[string]any)["replication_type"])
+}
+
 // Verifies that share type deletion works
 func TestDelete(t *testing.T) {
 	th.SetupHTTP()
@@ -154,6 +175,59 @@ func TestSetExtraSpecs(t *testing.T) {
 }
 
 // Verifies that an extra specification can be unset for a share type
+// Verifies that invalid well-known extra specifications are rejected
+func TestSetExtraSpecsValidation(t *testing.T) {
+	for _, specs := range []map[string]any{
+		{"driver_handles_share_servers": "maybe"},
+		{"driver_handles_share_servers": 1},
+		{"replication_type": "async"},
+		{"replication_type": true},
+	} {
+		options := sharetypes.SetExtraSpecsOpts{ExtraSpecs: specs}
+		_, err := options.ToShareTypeSetExtraSpecsMap()
+		if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+			t.Fatalf("ErrInvalidInput was expected to occur for %v, got %v", specs, err)
+		}
+	}
+
+	options := sharetypes.SetExtraSpecsOpts{ExtraSpecs: map[string]any{
+		"driver_handles_share_servers": "False",
+		"replication_type":             "readable",
+	}}
+	_, err := options.ToShareTypeSetExtraSpecsMap()
+	th.AssertNoErr(t, err)
+}
+
+// Verifies that typed extra specifications are parsed
+func TestExtraSpecsHelpers(t *testing.T) {
+	es := sharetypes.ExtraSpecs{
+		"driver_handles_share_servers": "True",
+		"replication_type":             "writable",
+	}
+
+	dhss, err := es.DriverHandlesShareServers()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, dhss)
+
+	rt, err := es.ReplicationType()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, sharetypes.ReplicationTypeWritable, rt)
+
+	es = sharetypes.ExtraSpecs{"driver_handles_share_servers": false}
+	dhss, err = es.DriverHandlesShareServers()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, false, dhss)
+
+	rt, err = es.ReplicationType()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "", rt)
+
+	_, err = sharetypes.ExtraSpecs{}.DriverHandlesShareServers()
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("ErrMissingInput was expected to occur, got %v", err)
+	}
+}
+
 func TestUnsetExtraSpecs(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
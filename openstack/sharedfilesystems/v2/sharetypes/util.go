@@ -0,0 +1,42 @@
+package sharetypes
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// validateReplicationType checks that v is a replication type supported by
+// the Shared File Systems service.
+func validateReplicationType(v string) error {
+	switch v {
+	case ReplicationTypeReadable, ReplicationTypeWritable, ReplicationTypeDR:
+		return nil
+	}
+	err := gophercloud.ErrInvalidInput{}
+	err.Argument = ExtraSpecReplicationType
+	err.Value = v
+	err.Info = fmt.Sprintf("must be one of %q, %q or %q", ReplicationTypeReadable, ReplicationTypeWritable, ReplicationTypeDR)
+	return err
+}
+
+// parseBoolSpec interprets an extra specification value as a boolean. The
+// service reports boolean extra specifications as strings such as "True" or
+// "false", while they may be set as native booleans.
+func parseBoolSpec(key string, v any) (bool, error) {
+	switch t := v.(type) {
+	case bool:
+		return t, nil
+	case string:
+		b, err := strconv.ParseBool(t)
+		if err == nil {
+			return b, nil
+		}
+	}
+	err := gophercloud.ErrInvalidInput{}
+	err.Argument = key
+	err.Value = v
+	err.Info = "must be a boolean"
+	return false, err
+}
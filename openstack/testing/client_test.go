@@ -2,6 +2,7 @@ package testing
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -314,3 +315,99 @@ func TestAuthenticatedClientV3Fails(t *testing.T) {
 func TestAuthenticatedClientV2Fails(t *testing.T) {
 	testAuthenticatedClientFails(t, "http://bad-address.example.com/v2.0")
 }
+
+func setupV3Identity(t *testing.T, status int) {
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `
+			{
+				"versions": {
+					"values": [
+						{
+							"status": "stable",
+							"id": "v3.0",
+							"links": [
+								{ "href": "%s", "rel": "self" }
+							]
+						}
+					]
+				}
+			}
+		`, th.Endpoint()+"v3/")
+	})
+
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		w.Header().Add("X-Subject-Token", ID)
+
+		w.WriteHeader(status)
+		fmt.Fprint(w, `{ "token": { "expires_at": "2013-02-02T18:30:59.000000Z" } }`)
+	})
+}
+
+func TestAuthenticateFailover(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	setupV3Identity(t, http.StatusCreated)
+
+	unreachable := "http://127.0.0.1:1/"
+	client, err := openstack.NewFailoverClient(unreachable, th.Endpoint())
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, unreachable, client.IdentityEndpoint)
+
+	options := gophercloud.AuthOptions{
+		Username:    "me",
+		Password:    "secret",
+		DomainName:  "default",
+		TenantName:  "project",
+		AllowReauth: true,
+	}
+	err = openstack.Authenticate(context.TODO(), client, options)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, ID, client.TokenID)
+	th.CheckEquals(t, th.Endpoint(), client.IdentityEndpoint)
+	th.CheckEquals(t, th.Endpoint(), client.IdentityFailover.Current())
+	th.CheckDeepEquals(t, []string{th.Endpoint(), unreachable}, client.IdentityFailover.Endpoints())
+
+	client.SetToken("")
+	err = client.Reauthenticate(context.TODO(), "")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, ID, client.TokenID)
+}
+
+func TestAuthenticateFailoverKeepsAuthErrors(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	setupV3Identity(t, http.StatusUnauthorized)
+
+	client, err := openstack.NewFailoverClient(th.Endpoint(), "http://127.0.0.1:1/")
+	th.AssertNoErr(t, err)
+
+	options := gophercloud.AuthOptions{
+		Username:   "me",
+		Password:   "wrong",
+		DomainName: "default",
+	}
+	err = openstack.Authenticate(context.TODO(), client, options)
+	if !gophercloud.ResponseCodeIs(err, http.StatusUnauthorized) {
+		t.Fatalf("expected a 401 error, got %v", err)
+	}
+	th.CheckEquals(t, th.Endpoint(), client.IdentityEndpoint)
+}
+
+func TestAuthenticateFailoverUnreachable(t *testing.T) {
+	client, err := openstack.NewFailoverClient("http://127.0.0.1:1/", "http://127.0.0.1:2/v3")
+	th.AssertNoErr(t, err)
+
+	options := gophercloud.AuthOptions{
+		Username:   "me",
+		Password:   "secret",
+		DomainName: "default",
+	}
+	err = openstack.Authenticate(context.TODO(), client, options)
+
+	var unreachableErr openstack.ErrIdentityUnreachable
+	if !errors.As(err, &unreachableErr) {
+		t.Fatalf("expected ErrIdentityUnreachable, got %v", err)
+	}
+	th.CheckEquals(t, 2, len(unreachableErr.Errors))
+}
@@ -0,0 +1,20 @@
+/*
+Package attachvalidation checks, before attaching an interface to a server,
+that the port or network to attach belongs to the project of the server. It
+combines the Compute and Networking services and requires a client for each.
+
+Example to Attach an Interface after Checking Project Ownership
+
+	attachOpts := attachinterfaces.CreateOpts{
+		PortID: "0dde1598-b374-474e-986f-5b8dd1df1d4e",
+		Tag:    "data",
+	}
+	interface, err := attachvalidation.ValidateAndCreate(context.TODO(), computeClient, networkClient, serverID, attachOpts).Extract()
+	if err != nil {
+		if e, ok := err.(attachvalidation.ErrPortProjectMismatch); ok {
+			fmt.Printf("port is owned by project %s\n", e.PortProjectID)
+		}
+		panic(err)
+	}
+*/
+package attachvalidation
@@ -0,0 +1,38 @@
+package attachvalidation
+
+import (
+	"fmt"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// ErrPortProjectMismatch is returned by ValidateProject when the port to be
+// attached is owned by a different project than the server.
+type ErrPortProjectMismatch struct {
+	gophercloud.BaseError
+	ServerID        string
+	ServerProjectID string
+	PortID          string
+	PortProjectID   string
+}
+
+func (e ErrPortProjectMismatch) Error() string {
+	return fmt.Sprintf("Port [%s] belongs to project [%s], but server [%s] belongs to project [%s]",
+		e.PortID, e.PortProjectID, e.ServerID, e.ServerProjectID)
+}
+
+// ErrNetworkProjectMismatch is returned by ValidateProject when the network
+// to attach to is owned by a different project than the server and is not
+// shared.
+type ErrNetworkProjectMismatch struct {
+	gophercloud.BaseError
+	ServerID         string
+	ServerProjectID  string
+	NetworkID        string
+	NetworkProjectID string
+}
+
+func (e ErrNetworkProjectMismatch) Error() string {
+	return fmt.Sprintf("Network [%s] belongs to project [%s] and is not shared, but server [%s] belongs to project [%s]",
+		e.NetworkID, e.NetworkProjectID, e.ServerID, e.ServerProjectID)
+}
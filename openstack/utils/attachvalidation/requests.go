@@ -0,0 +1,85 @@
+package attachvalidation
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/attachinterfaces"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/servers"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/ports"
+)
+
+// ValidateProject checks that the port or network referenced by opts belongs
+// to the same project as the server. Shared networks are accepted regardless
+// of their owner. An ErrPortProjectMismatch or ErrNetworkProjectMismatch
+// error is returned when the ownership does not match.
+//
+// computeClient must be a Compute v2 client and networkClient a Networking
+// v2 client.
+func ValidateProject(ctx context.Context, computeClient, networkClient *gophercloud.ServiceClient, serverID string, opts attachinterfaces.CreateOpts) error {
+	if opts.PortID == "" && opts.NetworkID == "" {
+		return nil
+	}
+
+	server, err := servers.Get(ctx, computeClient, serverID).Extract()
+	if err != nil {
+		return err
+	}
+
+	if opts.PortID != "" {
+		port, err := ports.Get(ctx, networkClient, opts.PortID).Extract()
+		if err != nil {
+			return err
+		}
+
+		projectID := port.ProjectID
+		if projectID == "" {
+			projectID = port.TenantID
+		}
+
+		if projectID != server.TenantID {
+			return ErrPortProjectMismatch{
+				ServerID:        serverID,
+				ServerProjectID: server.TenantID,
+				PortID:          opts.PortID,
+				PortProjectID:   projectID,
+			}
+		}
+
+		return nil
+	}
+
+	network, err := networks.Get(ctx, networkClient, opts.NetworkID).Extract()
+	if err != nil {
+		return err
+	}
+
+	projectID := network.ProjectID
+	if projectID == "" {
+		projectID = network.TenantID
+	}
+
+	if !network.Shared && projectID != server.TenantID {
+		return ErrNetworkProjectMismatch{
+			ServerID:         serverID,
+			ServerProjectID:  server.TenantID,
+			NetworkID:        opts.NetworkID,
+			NetworkProjectID: projectID,
+		}
+	}
+
+	return nil
+}
+
+// ValidateAndCreate behaves like attachinterfaces.Create, but first calls
+// ValidateProject to make sure the port or network belongs to the server's
+// project.
+func ValidateAndCreate(ctx context.Context, computeClient, networkClient *gophercloud.ServiceClient, serverID string, opts attachinterfaces.CreateOpts) (r attachinterfaces.CreateResult) {
+	if err := ValidateProject(ctx, computeClient, networkClient, serverID, opts); err != nil {
+		r.Err = err
+		return
+	}
+
+	return attachinterfaces.Create(ctx, computeClient, serverID, opts)
+}
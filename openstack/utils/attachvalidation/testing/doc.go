@@ -0,0 +1,2 @@
+// attachvalidation unit tests
+package testing
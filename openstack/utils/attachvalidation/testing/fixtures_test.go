@@ -0,0 +1,90 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+// HandleInterfaceCreateSuccessfully sets up the test server to respond to a
+// CreateInterface request attaching the network.
+func HandleInterfaceCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/b07e7a3b-d951-4efc-a4f9-ac9f001afb7f/os-interface", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{
+			  "interfaceAttachment": {
+				"net_id": "8a5fe506-7e9f-4091-899b-96336909d93c"
+			  }
+		}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"interfaceAttachment": {
+				"port_state": "ACTIVE",
+				"port_id": "0dde1598-b374-474e-986f-5b8dd1df1d4e",
+				"net_id": "8a5fe506-7e9f-4091-899b-96336909d93c",
+				"mac_addr": "fa:16:3e:38:2d:80"
+			}
+		}`)
+	})
+}
+
+// HandleServerGetSuccessfully sets up the test server to respond to a server
+// Get request for the server the interfaces are attached to.
+func HandleServerGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/b07e7a3b-d951-4efc-a4f9-ac9f001afb7f", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"server": {
+				"id": "b07e7a3b-d951-4efc-a4f9-ac9f001afb7f",
+				"name": "server-1",
+				"tenant_id": "fcad67a6189847c4aecfa3c81a05783b"
+			}
+		}`)
+	})
+}
+
+// HandlePortGetSuccessfully sets up the test server to respond to a port Get
+// request, returning a port owned by the given project.
+func HandlePortGetSuccessfully(t *testing.T, projectID string) {
+	th.Mux.HandleFunc("/ports/0dde1598-b374-474e-986f-5b8dd1df1d4e", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{
+			"port": {
+				"id": "0dde1598-b374-474e-986f-5b8dd1df1d4e",
+				"network_id": "8a5fe506-7e9f-4091-899b-96336909d93c",
+				"tenant_id": "%[1]s",
+				"project_id": "%[1]s"
+			}
+		}`, projectID)
+	})
+}
+
+// HandleNetworkGetSuccessfully sets up the test server to respond to a
+// network Get request, returning a network owned by the given project.
+func HandleNetworkGetSuccessfully(t *testing.T, projectID string, shared bool) {
+	th.Mux.HandleFunc("/networks/8a5fe506-7e9f-4091-899b-96336909d93c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{
+			"network": {
+				"id": "8a5fe506-7e9f-4091-899b-96336909d93c",
+				"tenant_id": "%[1]s",
+				"project_id": "%[1]s",
+				"shared": %[2]t
+			}
+		}`, projectID, shared)
+	})
+}
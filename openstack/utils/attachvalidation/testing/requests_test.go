@@ -0,0 +1,68 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/attachinterfaces"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils/attachvalidation"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestValidateAndCreateInterface(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGetSuccessfully(t)
+	HandleNetworkGetSuccessfully(t, "fcad67a6189847c4aecfa3c81a05783b", false)
+	HandleInterfaceCreateSuccessfully(t)
+
+	actual, err := attachvalidation.ValidateAndCreate(context.TODO(), client.ServiceClient(), client.ServiceClient(), "b07e7a3b-d951-4efc-a4f9-ac9f001afb7f", attachinterfaces.CreateOpts{
+		NetworkID: "8a5fe506-7e9f-4091-899b-96336909d93c",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "0dde1598-b374-474e-986f-5b8dd1df1d4e", actual.PortID)
+}
+
+func TestValidateProjectSharedNetwork(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGetSuccessfully(t)
+	HandleNetworkGetSuccessfully(t, "a99e9b4e620e4db09a2dfb6e42a01e66", true)
+
+	err := attachvalidation.ValidateProject(context.TODO(), client.ServiceClient(), client.ServiceClient(), "b07e7a3b-d951-4efc-a4f9-ac9f001afb7f", attachinterfaces.CreateOpts{
+		NetworkID: "8a5fe506-7e9f-4091-899b-96336909d93c",
+	})
+	th.AssertNoErr(t, err)
+}
+
+func TestValidateProjectNetworkMismatch(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGetSuccessfully(t)
+	HandleNetworkGetSuccessfully(t, "a99e9b4e620e4db09a2dfb6e42a01e66", false)
+
+	res := attachvalidation.ValidateAndCreate(context.TODO(), client.ServiceClient(), client.ServiceClient(), "b07e7a3b-d951-4efc-a4f9-ac9f001afb7f", attachinterfaces.CreateOpts{
+		NetworkID: "8a5fe506-7e9f-4091-899b-96336909d93c",
+	})
+	err, ok := res.Err.(attachvalidation.ErrNetworkProjectMismatch)
+	if !ok {
+		t.Fatalf("expected ErrNetworkProjectMismatch, got %v", res.Err)
+	}
+	th.AssertEquals(t, "a99e9b4e620e4db09a2dfb6e42a01e66", err.NetworkProjectID)
+	th.AssertEquals(t, "fcad67a6189847c4aecfa3c81a05783b", err.ServerProjectID)
+}
+
+func TestValidateProjectPortMismatch(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGetSuccessfully(t)
+	HandlePortGetSuccessfully(t, "a99e9b4e620e4db09a2dfb6e42a01e66")
+
+	err := attachvalidation.ValidateProject(context.TODO(), client.ServiceClient(), client.ServiceClient(), "b07e7a3b-d951-4efc-a4f9-ac9f001afb7f", attachinterfaces.CreateOpts{
+		PortID: "0dde1598-b374-474e-986f-5b8dd1df1d4e",
+	})
+	if _, ok := err.(attachvalidation.ErrPortProjectMismatch); !ok {
+		t.Fatalf("expected ErrPortProjectMismatch, got %v", err)
+	}
+}
@@ -234,3 +234,30 @@ func ParseMicroversion(version string) (major int, minor int, err error) {
 	}
 	return major, minor, nil
 }
+
+// MicroversionAtLeast reports whether microversion is the same as or later
+// than required. "latest" is later than any microversion. An empty
+// microversion, which requests the base version of an API, is never reported
+// as later than required.
+func MicroversionAtLeast(microversion, required string) (bool, error) {
+	switch microversion {
+	case "latest":
+		return true, nil
+	case "":
+		return false, nil
+	}
+
+	major, minor, err := ParseMicroversion(microversion)
+	if err != nil {
+		return false, err
+	}
+	requiredMajor, requiredMinor, err := ParseMicroversion(required)
+	if err != nil {
+		return false, err
+	}
+
+	if major != requiredMajor {
+		return major > requiredMajor, nil
+	}
+	return minor >= requiredMinor, nil
+}
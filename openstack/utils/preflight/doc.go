@@ -0,0 +1,37 @@
+/*
+Package preflight checks, before provisioning, that the quota of a project
+can accommodate the servers, volumes and floating IPs a job is about to
+create. It combines the limits reported by the Compute, Block Storage and
+Networking services, and requires a client for each service to check.
+
+Example to Check Quota before Provisioning
+
+	clients := preflight.PreflightClients{
+		Compute:      computeClient,
+		BlockStorage: blockStorageClient,
+		Network:      networkClient,
+	}
+
+	preflightOpts := preflight.PreflightOpts{
+		ProjectID:       "project-id",
+		Servers:         3,
+		VCPUs:           6,
+		RAM:             12288,
+		Volumes:         3,
+		VolumeGigabytes: 150,
+		FloatingIPs:     3,
+	}
+
+	report, err := preflight.Preflight(context.TODO(), clients, preflightOpts)
+	if err != nil {
+		if quotaErr, ok := err.(preflight.ErrInsufficientQuota); ok {
+			for _, c := range quotaErr.Insufficient {
+				fmt.Printf("%s: requested %d, available %d\n", c.Resource, c.Requested, c.Available())
+			}
+		}
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", report)
+*/
+package preflight
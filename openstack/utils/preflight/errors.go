@@ -0,0 +1,23 @@
+package preflight
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// ErrInsufficientQuota is returned by Preflight when the requested resources
+// exceed the remaining quota of at least one resource.
+type ErrInsufficientQuota struct {
+	gophercloud.BaseError
+	Insufficient []ResourceCheck
+}
+
+func (e ErrInsufficientQuota) Error() string {
+	msgs := make([]string, len(e.Insufficient))
+	for i, c := range e.Insufficient {
+		msgs[i] = fmt.Sprintf("%s (requested %d, available %d of %d)", c.Resource, c.Requested, c.Available(), c.Limit)
+	}
+	return fmt.Sprintf("Insufficient quota for %s", strings.Join(msgs, ", "))
+}
@@ -0,0 +1,176 @@
+package preflight
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	volumelimits "github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/limits"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/limits"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/quotas"
+)
+
+// Resources checked by Preflight.
+const (
+	ResourceServers         = "servers"
+	ResourceVCPUs           = "cores"
+	ResourceRAM             = "ram"
+	ResourceVolumes         = "volumes"
+	ResourceVolumeGigabytes = "gigabytes"
+	ResourceFloatingIPs     = "floatingip"
+)
+
+// PreflightClients holds the service clients used by Preflight. A nil client
+// skips the checks of the corresponding service.
+type PreflightClients struct {
+	// Compute is a Compute v2 client, used to check servers, vCPUs and RAM.
+	Compute *gophercloud.ServiceClient
+
+	// BlockStorage is a Block Storage v3 client, used to check volumes and
+	// volume gigabytes of the current project.
+	BlockStorage *gophercloud.ServiceClient
+
+	// Network is a Networking v2 client, used to check floating IPs.
+	Network *gophercloud.ServiceClient
+}
+
+// PreflightOpts describes the resources a provisioning job is about to
+// consume.
+type PreflightOpts struct {
+	// ProjectID is the project the resources are checked for. It is required
+	// to check floating IPs, and defaults to the current project for compute.
+	ProjectID string
+
+	// Servers is the number of servers to create.
+	Servers int
+
+	// VCPUs is the total number of vCPUs of the servers to create.
+	VCPUs int
+
+	// RAM is the total RAM of the servers to create, in megabytes (MB).
+	RAM int
+
+	// Volumes is the number of volumes to create.
+	Volumes int
+
+	// VolumeGigabytes is the total size of the volumes to create, in
+	// gibibytes (GiB).
+	VolumeGigabytes int
+
+	// FloatingIPs is the number of floating IPs to allocate.
+	FloatingIPs int
+}
+
+// ResourceCheck is the result of checking a single resource.
+type ResourceCheck struct {
+	// Resource is the name of the resource, such as ResourceVCPUs.
+	Resource string
+
+	// Requested is the amount of the resource the job needs.
+	Requested int
+
+	// Used is the amount of the resource already in use.
+	Used int
+
+	// Limit is the maximum amount of the resource. -1 means unlimited.
+	Limit int
+}
+
+// Available returns the amount of the resource which can still be consumed,
+// or -1 if the resource is unlimited.
+func (c ResourceCheck) Available() int {
+	if c.Limit < 0 {
+		return -1
+	}
+	if c.Used >= c.Limit {
+		return 0
+	}
+	return c.Limit - c.Used
+}
+
+// Sufficient reports whether the requested amount fits in the limit.
+func (c ResourceCheck) Sufficient() bool {
+	return c.Limit < 0 || c.Used+c.Requested <= c.Limit
+}
+
+// PreflightReport lists the checks performed by Preflight.
+type PreflightReport struct {
+	Checks []ResourceCheck
+}
+
+// Insufficient returns the checks whose requested amount exceeds the limit.
+func (r PreflightReport) Insufficient() []ResourceCheck {
+	var insufficient []ResourceCheck
+	for _, c := range r.Checks {
+		if !c.Sufficient() {
+			insufficient = append(insufficient, c)
+		}
+	}
+	return insufficient
+}
+
+// Preflight compares the resources described by opts with the current limits
+// and usage reported by Nova, Cinder and Neutron. Only the resources with a
+// non-zero requested amount are checked, and only against the services whose
+// client is set.
+//
+// The report is returned along with an ErrInsufficientQuota error if at least
+// one of the resources is insufficient.
+func Preflight(ctx context.Context, clients PreflightClients, opts PreflightOpts) (PreflightReport, error) {
+	var report PreflightReport
+
+	add := func(resource string, requested, used, limit int) {
+		if requested > 0 {
+			report.Checks = append(report.Checks, ResourceCheck{
+				Resource:  resource,
+				Requested: requested,
+				Used:      used,
+				Limit:     limit,
+			})
+		}
+	}
+
+	if clients.Compute != nil && (opts.Servers > 0 || opts.VCPUs > 0 || opts.RAM > 0) {
+		var getOpts limits.GetOptsBuilder
+		if opts.ProjectID != "" {
+			getOpts = limits.GetOpts{TenantID: opts.ProjectID}
+		}
+
+		l, err := limits.Get(ctx, clients.Compute, getOpts).Extract()
+		if err != nil {
+			return report, err
+		}
+
+		add(ResourceServers, opts.Servers, l.Absolute.TotalInstancesUsed, l.Absolute.MaxTotalInstances)
+		add(ResourceVCPUs, opts.VCPUs, l.Absolute.TotalCoresUsed, l.Absolute.MaxTotalCores)
+		add(ResourceRAM, opts.RAM, l.Absolute.TotalRAMUsed, l.Absolute.MaxTotalRAMSize)
+	}
+
+	if clients.BlockStorage != nil && (opts.Volumes > 0 || opts.VolumeGigabytes > 0) {
+		l, err := volumelimits.Get(ctx, clients.BlockStorage).Extract()
+		if err != nil {
+			return report, err
+		}
+
+		add(ResourceVolumes, opts.Volumes, l.Absolute.TotalVolumesUsed, l.Absolute.MaxTotalVolumes)
+		add(ResourceVolumeGigabytes, opts.VolumeGigabytes, l.Absolute.TotalGigabytesUsed, l.Absolute.MaxTotalVolumeGigabytes)
+	}
+
+	if clients.Network != nil && opts.FloatingIPs > 0 {
+		if opts.ProjectID == "" {
+			return report, gophercloud.ErrMissingInput{Argument: "ProjectID"}
+		}
+
+		q, err := quotas.GetDetail(ctx, clients.Network, opts.ProjectID).Extract()
+		if err != nil {
+			return report, err
+		}
+
+		add(ResourceFloatingIPs, opts.FloatingIPs, q.FloatingIP.Used+q.FloatingIP.Reserved, q.FloatingIP.Limit)
+	}
+
+	if insufficient := report.Insufficient(); len(insufficient) > 0 {
+		return report, ErrInsufficientQuota{Insufficient: insufficient}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,2 @@
+// preflight unit tests
+package testing
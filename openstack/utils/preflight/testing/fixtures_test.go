@@ -0,0 +1,104 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils/preflight"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+// ProjectID is the project the quota is checked for.
+const ProjectID = "555544443333222211110000ffffeeee"
+
+// ComputeLimitsOutput is a sample response of the Compute limits API.
+const ComputeLimitsOutput = `
+{
+    "limits": {
+        "rate": [],
+        "absolute": {
+            "totalCoresUsed": 1,
+            "totalRAMUsed": 2048,
+            "totalInstancesUsed": 1,
+            "maxTotalCores": 20,
+            "maxTotalInstances": 10,
+            "maxTotalRAMSize": 51200
+        }
+    }
+}
+`
+
+// VolumeLimitsOutput is a sample response of the Block Storage limits API.
+const VolumeLimitsOutput = `
+{
+    "limits": {
+        "rate": [],
+        "absolute": {
+            "maxTotalVolumes": 10,
+            "totalVolumesUsed": 4,
+            "maxTotalVolumeGigabytes": 1000,
+            "totalGigabytesUsed": 100
+        }
+    }
+}
+`
+
+// NetworkQuotaDetailOutput is a sample response of the Networking quota
+// details API.
+const NetworkQuotaDetailOutput = `
+{
+    "quota": {
+        "floatingip": {
+            "used": 8,
+            "reserved": 1,
+            "limit": 10
+        }
+    }
+}
+`
+
+// PreflightClients returns the clients used by Preflight, each of them
+// served under its own prefix of the test server.
+func PreflightClients() preflight.PreflightClients {
+	volumeClient := client.ServiceClient()
+	volumeClient.Endpoint += "volume/"
+	networkClient := client.ServiceClient()
+	networkClient.Endpoint += "network/"
+
+	return preflight.PreflightClients{
+		Compute:      client.ServiceClient(),
+		BlockStorage: volumeClient,
+		Network:      networkClient,
+	}
+}
+
+// HandlePreflightSuccessfully configures the test server to respond to the
+// requests performed by Preflight.
+func HandlePreflightSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"tenant_id": ProjectID})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ComputeLimitsOutput)
+	})
+
+	th.Mux.HandleFunc("/volume/limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, VolumeLimitsOutput)
+	})
+
+	th.Mux.HandleFunc("/network/quotas/"+ProjectID+"/details.json", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, NetworkQuotaDetailOutput)
+	})
+}
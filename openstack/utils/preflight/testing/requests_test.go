@@ -0,0 +1,85 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils/preflight"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestPreflight(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePreflightSuccessfully(t)
+
+	opts := preflight.PreflightOpts{
+		ProjectID:       ProjectID,
+		Servers:         2,
+		VCPUs:           4,
+		RAM:             8192,
+		Volumes:         2,
+		VolumeGigabytes: 200,
+		FloatingIPs:     1,
+	}
+
+	report, err := preflight.Preflight(context.TODO(), PreflightClients(), opts)
+	th.AssertNoErr(t, err)
+
+	expected := []preflight.ResourceCheck{
+		{Resource: preflight.ResourceServers, Requested: 2, Used: 1, Limit: 10},
+		{Resource: preflight.ResourceVCPUs, Requested: 4, Used: 1, Limit: 20},
+		{Resource: preflight.ResourceRAM, Requested: 8192, Used: 2048, Limit: 51200},
+		{Resource: preflight.ResourceVolumes, Requested: 2, Used: 4, Limit: 10},
+		{Resource: preflight.ResourceVolumeGigabytes, Requested: 200, Used: 100, Limit: 1000},
+		{Resource: preflight.ResourceFloatingIPs, Requested: 1, Used: 9, Limit: 10},
+	}
+	th.CheckDeepEquals(t, expected, report.Checks)
+	th.AssertEquals(t, 0, len(report.Insufficient()))
+}
+
+func TestPreflightInsufficient(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePreflightSuccessfully(t)
+
+	opts := preflight.PreflightOpts{
+		ProjectID:   ProjectID,
+		VCPUs:       20,
+		FloatingIPs: 2,
+	}
+
+	report, err := preflight.Preflight(context.TODO(), PreflightClients(), opts)
+	quotaErr, ok := err.(preflight.ErrInsufficientQuota)
+	if !ok {
+		t.Fatalf("expected ErrInsufficientQuota, got %v", err)
+	}
+
+	expected := []preflight.ResourceCheck{
+		{Resource: preflight.ResourceVCPUs, Requested: 20, Used: 1, Limit: 20},
+		{Resource: preflight.ResourceFloatingIPs, Requested: 2, Used: 9, Limit: 10},
+	}
+	th.CheckDeepEquals(t, expected, report.Checks)
+	th.CheckDeepEquals(t, expected, quotaErr.Insufficient)
+	th.AssertEquals(t, 19, quotaErr.Insufficient[0].Available())
+	th.AssertEquals(t, "Insufficient quota for cores (requested 20, available 19 of 20), floatingip (requested 2, available 1 of 10)", err.Error())
+}
+
+func TestPreflightMissingProjectID(t *testing.T) {
+	clients := preflight.PreflightClients{
+		Network: client.ServiceClient(),
+	}
+
+	_, err := preflight.Preflight(context.TODO(), clients, preflight.PreflightOpts{FloatingIPs: 1})
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("expected ErrMissingInput, got %v", err)
+	}
+}
+
+func TestResourceCheckUnlimited(t *testing.T) {
+	c := preflight.ResourceCheck{Resource: preflight.ResourceRAM, Requested: 1024, Used: 4096, Limit: -1}
+	th.AssertEquals(t, -1, c.Available())
+	th.AssertEquals(t, true, c.Sufficient())
+}
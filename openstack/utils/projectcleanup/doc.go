@@ -0,0 +1,53 @@
+/*
+Package projectcleanup deletes the resources of a project across several
+OpenStack services, then the project itself. It is intended for
+administrators decommissioning projects and requires administrative
+privileges on every service.
+
+Resources are deleted in dependency order: servers and load balancers first,
+waiting for them to be gone, then volumes, floating IPs, routers (after
+removing their interfaces), ports, networks along with their subnets, and
+security groups. A service is skipped when its client is not set.
+
+The project is only deleted when all its resources were deleted. Failures
+don't stop the cleanup; they are reported in the returned Report and in an
+ErrCleanup error.
+
+Example to preview the Cleanup of a Project
+
+	clients := projectcleanup.Clients{
+		Identity:     identityClient,
+		Compute:      computeClient,
+		BlockStorage: blockStorageClient,
+		LoadBalancer: loadBalancerClient,
+		Network:      networkClient,
+	}
+
+	report, err := projectcleanup.Cleanup(context.TODO(), clients, projectcleanup.Opts{
+		ProjectID: "4335d1f0-f793-11e2-b778-0800200c9a66",
+		DryRun:    true,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, entry := range report.Entries {
+		fmt.Printf("would delete %s %s (%s)\n", entry.Type, entry.ID, entry.Name)
+	}
+
+Example to Cleanup a Project
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 30*time.Minute)
+	defer cancel()
+
+	report, err := projectcleanup.Cleanup(ctx, clients, projectcleanup.Opts{
+		ProjectID: "4335d1f0-f793-11e2-b778-0800200c9a66",
+	})
+	if err != nil {
+		for _, entry := range report.Failed() {
+			fmt.Printf("failed to delete %s %s: %s\n", entry.Type, entry.ID, entry.Err)
+		}
+		panic(err)
+	}
+*/
+package projectcleanup
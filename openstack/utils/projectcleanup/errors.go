@@ -0,0 +1,36 @@
+package projectcleanup
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// ErrCleanup is returned by Cleanup when one or more resources could not be
+// listed or deleted. The project is not deleted in that case.
+type ErrCleanup struct {
+	gophercloud.BaseError
+	ProjectID string
+	Failed    []Entry
+}
+
+func (e ErrCleanup) Error() string {
+	msgs := make([]string, len(e.Failed))
+	for i, f := range e.Failed {
+		if f.ID == "" {
+			msgs[i] = fmt.Sprintf("%s: %s", f.Type, f.Err)
+		} else {
+			msgs[i] = fmt.Sprintf("%s %s: %s", f.Type, f.ID, f.Err)
+		}
+	}
+	return fmt.Sprintf("failed to clean up %d resource(s) of project [%s]: %s", len(e.Failed), e.ProjectID, strings.Join(msgs, "; "))
+}
+
+func (e ErrCleanup) Unwrap() []error {
+	errs := make([]error, len(e.Failed))
+	for i, f := range e.Failed {
+		errs[i] = f.Err
+	}
+	return errs
+}
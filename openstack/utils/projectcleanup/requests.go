@@ -0,0 +1,412 @@
+package projectcleanup
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/servers"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/identity/v3/projects"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/ports"
+)
+
+// Clients holds the service clients used by Cleanup. A nil client skips the
+// resources of the corresponding service.
+type Clients struct {
+	// Identity is an Identity v3 client, used to delete the project. It is
+	// required unless Opts.KeepProject is set.
+	Identity *gophercloud.ServiceClient
+
+	// Compute is a Compute v2 client, used to delete servers.
+	Compute *gophercloud.ServiceClient
+
+	// BlockStorage is a Block Storage v3 client, used to delete volumes
+	// along with their snapshots.
+	BlockStorage *gophercloud.ServiceClient
+
+	// LoadBalancer is a Load Balancer v2 client, used to delete load
+	// balancers along with their listeners, pools and members.
+	LoadBalancer *gophercloud.ServiceClient
+
+	// Network is a Networking v2 client, used to delete floating IPs,
+	// routers, ports, networks and security groups.
+	Network *gophercloud.ServiceClient
+}
+
+// Opts specifies the project to clean up.
+type Opts struct {
+	// ProjectID is the ID of the project to clean up.
+	ProjectID string
+
+	// DryRun only lists the resources which would be deleted.
+	DryRun bool
+
+	// KeepProject deletes the resources of the project but not the project
+	// itself.
+	KeepProject bool
+}
+
+// step lists and deletes the resources of a single type.
+type step struct {
+	resourceType ResourceType
+	client       *gophercloud.ServiceClient
+	list         func(context.Context, *gophercloud.ServiceClient, string) ([]Resource, error)
+	delete       func(context.Context, *gophercloud.ServiceClient, Resource) error
+}
+
+// Cleanup deletes the resources of a project, then the project itself, and
+// returns a Report of every resource handled. Failures don't stop the
+// cleanup of the other resources, but prevent the deletion of the project;
+// they are returned as an ErrCleanup error along with the report.
+//
+// Deleting servers and load balancers is asynchronous, so Cleanup waits for
+// them to be gone, or soft deleted for servers, before deleting the
+// resources they use. The wait is bounded by ctx only.
+//
+// Listed resources which are not owned by opts.ProjectID are skipped, in case
+// a service ignores the project filter of the list request.
+func Cleanup(ctx context.Context, clients Clients, opts Opts) (Report, error) {
+	report := Report{ProjectID: opts.ProjectID, DryRun: opts.DryRun}
+
+	if opts.ProjectID == "" {
+		return report, gophercloud.ErrMissingInput{Argument: "projectcleanup.Opts.ProjectID"}
+	}
+	if clients.Identity == nil && !opts.KeepProject {
+		return report, gophercloud.ErrMissingInput{Argument: "projectcleanup.Clients.Identity"}
+	}
+
+	steps := []step{
+		{ResourceTypeServer, clients.Compute, listServers, deleteServer},
+		{ResourceTypeLoadBalancer, clients.LoadBalancer, listLoadBalancers, deleteLoadBalancer},
+		{ResourceTypeVolume, clients.BlockStorage, listVolumes, deleteVolume},
+		{ResourceTypeFloatingIP, clients.Network, listFloatingIPs, deleteFloatingIP},
+		{ResourceTypeRouter, clients.Network, listRouters, deleteRouter},
+		{ResourceTypePort, clients.Network, listPorts, deletePort},
+		{ResourceTypeNetwork, clients.Network, listNetworks, deleteNetwork},
+		{ResourceTypeSecurityGroup, clients.Network, listSecurityGroups, deleteSecurityGroup},
+	}
+
+	failed := false
+	for _, s := range steps {
+		if s.client == nil {
+			continue
+		}
+
+		resources, err := s.list(ctx, s.client, opts.ProjectID)
+		if err != nil {
+			report.Entries = append(report.Entries, Entry{Resource: Resource{Type: s.resourceType}, Err: err})
+			failed = true
+			continue
+		}
+
+		for _, r := range resources {
+			// Never trust the project filter of the list request alone: a
+			// service ignoring it would return the resources of other
+			// projects.
+			if r.ProjectID != opts.ProjectID {
+				continue
+			}
+
+			entry := Entry{Resource: r}
+			if !opts.DryRun {
+				entry.Err = s.delete(ctx, s.client, r)
+				entry.Deleted = entry.Err == nil
+				failed = failed || entry.Err != nil
+			}
+			report.Entries = append(report.Entries, entry)
+		}
+	}
+
+	if !opts.KeepProject && !failed {
+		entry := Entry{Resource: Resource{Type: ResourceTypeProject, ID: opts.ProjectID}}
+		if !opts.DryRun {
+			entry.Err = projects.Delete(ctx, clients.Identity, opts.ProjectID).ExtractErr()
+			entry.Deleted = entry.Err == nil
+		}
+		report.Entries = append(report.Entries, entry)
+	}
+
+	if failures := report.Failed(); len(failures) > 0 {
+		return report, ErrCleanup{ProjectID: opts.ProjectID, Failed: failures}
+	}
+
+	return report, nil
+}
+
+// owner returns the project owning a Networking resource, which older
+// Networking APIs only report as tenant_id.
+func owner(projectID, tenantID string) string {
+	if projectID != "" {
+		return projectID
+	}
+	return tenantID
+}
+
+// waitForDeletion polls get until it returns a 404 error.
+func waitForDeletion(ctx context.Context, get func(context.Context) error) error {
+	return gophercloud.WaitFor(ctx, func(ctx context.Context) (bool, error) {
+		err := get(ctx)
+		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return true, nil
+		}
+		return false, err
+	})
+}
+
+func listServers(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]Resource, error) {
+	allPages, err := servers.List(client, servers.ListOpts{
+		AllTenants: true,
+		TenantID:   projectID,
+	}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allServers, err := servers.ExtractServers(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]Resource, len(allServers))
+	for i, s := range allServers {
+		resources[i] = Resource{Type: ResourceTypeServer, ID: s.ID, Name: s.Name, ProjectID: s.TenantID}
+	}
+	return resources, nil
+}
+
+func deleteServer(ctx context.Context, client *gophercloud.ServiceClient, r Resource) error {
+	if err := servers.Delete(ctx, client, r.ID).ExtractErr(); err != nil {
+		return err
+	}
+	// With reclaim_instance_interval set, a deleted server is only soft
+	// deleted and remains visible until it is reclaimed.
+	return gophercloud.WaitFor(ctx, func(ctx context.Context) (bool, error) {
+		server, err := servers.Get(ctx, client, r.ID).Extract()
+		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return true, nil
+		}
+		if err != nil || server == nil {
+			return false, err
+		}
+		return server.Status == "SOFT_DELETED" || server.Status == "DELETED", nil
+	})
+}
+
+func listLoadBalancers(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]Resource, error) {
+	allPages, err := loadbalancers.List(client, loadbalancers.ListOpts{
+		ProjectID: projectID,
+	}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allLoadBalancers, err := loadbalancers.ExtractLoadBalancers(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]Resource, len(allLoadBalancers))
+	for i, lb := range allLoadBalancers {
+		resources[i] = Resource{Type: ResourceTypeLoadBalancer, ID: lb.ID, Name: lb.Name, ProjectID: lb.ProjectID}
+	}
+	return resources, nil
+}
+
+func deleteLoadBalancer(ctx context.Context, client *gophercloud.ServiceClient, r Resource) error {
+	err := loadbalancers.Delete(ctx, client, r.ID, loadbalancers.DeleteOpts{Cascade: true}).ExtractErr()
+	if err != nil {
+		return err
+	}
+	return waitForDeletion(ctx, func(ctx context.Context) error {
+		return loadbalancers.Get(ctx, client, r.ID).Err
+	})
+}
+
+func listVolumes(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]Resource, error) {
+	allPages, err := volumes.List(client, volumes.ListOpts{
+		AllTenants: true,
+		TenantID:   projectID,
+	}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allVolumes, err := volumes.ExtractVolumes(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]Resource, len(allVolumes))
+	for i, v := range allVolumes {
+		resources[i] = Resource{Type: ResourceTypeVolume, ID: v.ID, Name: v.Name, ProjectID: v.TenantID}
+	}
+	return resources, nil
+}
+
+func deleteVolume(ctx context.Context, client *gophercloud.ServiceClient, r Resource) error {
+	return volumes.Delete(ctx, client, r.ID, volumes.DeleteOpts{Cascade: true}).ExtractErr()
+}
+
+func listFloatingIPs(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]Resource, error) {
+	allPages, err := floatingips.List(client, floatingips.ListOpts{
+		ProjectID: projectID,
+	}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allFloatingIPs, err := floatingips.ExtractFloatingIPs(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]Resource, len(allFloatingIPs))
+	for i, fip := range allFloatingIPs {
+		resources[i] = Resource{Type: ResourceTypeFloatingIP, ID: fip.ID, Name: fip.FloatingIP, ProjectID: owner(fip.ProjectID, fip.TenantID)}
+	}
+	return resources, nil
+}
+
+func deleteFloatingIP(ctx context.Context, client *gophercloud.ServiceClient, r Resource) error {
+	return floatingips.Delete(ctx, client, r.ID).ExtractErr()
+}
+
+func listRouters(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]Resource, error) {
+	allPages, err := routers.List(client, routers.ListOpts{
+		ProjectID: projectID,
+	}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allRouters, err := routers.ExtractRouters(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]Resource, len(allRouters))
+	for i, router := range allRouters {
+		resources[i] = Resource{Type: ResourceTypeRouter, ID: router.ID, Name: router.Name, ProjectID: owner(router.ProjectID, router.TenantID)}
+	}
+	return resources, nil
+}
+
+// deleteRouter removes the interfaces of a router before deleting it. Its
+// external gateway is removed along with the router.
+func deleteRouter(ctx context.Context, client *gophercloud.ServiceClient, r Resource) error {
+	allPages, err := ports.List(client, ports.ListOpts{
+		DeviceID: r.ID,
+	}).AllPages(ctx)
+	if err != nil {
+		return err
+	}
+
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range allPorts {
+		if !isRouterInterface(p.DeviceOwner) {
+			continue
+		}
+		err := routers.RemoveInterface(ctx, client, r.ID, routers.RemoveInterfaceOpts{PortID: p.ID}).Err
+		if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return err
+		}
+	}
+
+	return routers.Delete(ctx, client, r.ID).ExtractErr()
+}
+
+// isRouterInterface reports whether a port with the given device owner is
+// an interface of a router, as opposed to its external gateway.
+func isRouterInterface(deviceOwner string) bool {
+	return strings.HasPrefix(deviceOwner, "network:router_interface") ||
+		deviceOwner == "network:ha_router_replicated_interface"
+}
+
+// listPorts lists the ports of a project which are not managed by the
+// Networking service itself, such as DHCP or router ports.
+func listPorts(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]Resource, error) {
+	allPages, err := ports.List(client, ports.ListOpts{
+		ProjectID: projectID,
+	}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []Resource
+	for _, p := range allPorts {
+		if strings.HasPrefix(p.DeviceOwner, "network:") {
+			continue
+		}
+		resources = append(resources, Resource{Type: ResourceTypePort, ID: p.ID, Name: p.Name, ProjectID: owner(p.ProjectID, p.TenantID)})
+	}
+	return resources, nil
+}
+
+func deletePort(ctx context.Context, client *gophercloud.ServiceClient, r Resource) error {
+	return ports.Delete(ctx, client, r.ID).ExtractErr()
+}
+
+func listNetworks(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]Resource, error) {
+	allPages, err := networks.List(client, networks.ListOpts{
+		ProjectID: projectID,
+	}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allNetworks, err := networks.ExtractNetworks(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]Resource, len(allNetworks))
+	for i, n := range allNetworks {
+		resources[i] = Resource{Type: ResourceTypeNetwork, ID: n.ID, Name: n.Name, ProjectID: owner(n.ProjectID, n.TenantID)}
+	}
+	return resources, nil
+}
+
+// deleteNetwork deletes a network along with its subnets.
+func deleteNetwork(ctx context.Context, client *gophercloud.ServiceClient, r Resource) error {
+	return networks.Delete(ctx, client, r.ID).ExtractErr()
+}
+
+func listSecurityGroups(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]Resource, error) {
+	allPages, err := groups.List(client, groups.ListOpts{
+		ProjectID: projectID,
+	}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allGroups, err := groups.ExtractGroups(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]Resource, len(allGroups))
+	for i, g := range allGroups {
+		resources[i] = Resource{Type: ResourceTypeSecurityGroup, ID: g.ID, Name: g.Name, ProjectID: owner(g.ProjectID, g.TenantID)}
+	}
+	return resources, nil
+}
+
+func deleteSecurityGroup(ctx context.Context, client *gophercloud.ServiceClient, r Resource) error {
+	return groups.Delete(ctx, client, r.ID).ExtractErr()
+}
@@ -0,0 +1,80 @@
+package projectcleanup
+
+// ResourceType is the type of a resource handled by Cleanup.
+type ResourceType string
+
+const (
+	ResourceTypeServer        ResourceType = "server"
+	ResourceTypeLoadBalancer  ResourceType = "loadbalancer"
+	ResourceTypeVolume        ResourceType = "volume"
+	ResourceTypeFloatingIP    ResourceType = "floatingip"
+	ResourceTypeRouter        ResourceType = "router"
+	ResourceTypePort          ResourceType = "port"
+	ResourceTypeNetwork       ResourceType = "network"
+	ResourceTypeSecurityGroup ResourceType = "securitygroup"
+	ResourceTypeProject       ResourceType = "project"
+)
+
+// Resource is a resource of the project.
+type Resource struct {
+	// Type is the type of the resource.
+	Type ResourceType
+
+	// ID is the unique ID of the resource. It is empty if the resources of
+	// the type could not be listed.
+	ID string
+
+	// Name is the name of the resource. For floating IPs, it is the address.
+	Name string
+
+	// ProjectID is the ID of the project owning the resource. Cleanup skips
+	// the listed resources which are not owned by Opts.ProjectID.
+	ProjectID string
+}
+
+// Entry is the outcome of the cleanup of a single resource.
+type Entry struct {
+	Resource
+
+	// Deleted reports whether the resource was deleted. It is always false
+	// in dry-run mode.
+	Deleted bool
+
+	// Err is the error returned while listing or deleting the resource.
+	Err error
+}
+
+// Report lists the resources handled by Cleanup, in the order they were
+// deleted or, in dry-run mode, would have been deleted.
+type Report struct {
+	// ProjectID is the ID of the cleaned up project.
+	ProjectID string
+
+	// DryRun reports whether the resources were only listed.
+	DryRun bool
+
+	// Entries are the outcomes of the cleanup of each resource.
+	Entries []Entry
+}
+
+// Failed returns the entries which could not be listed or deleted.
+func (r Report) Failed() []Entry {
+	var failed []Entry
+	for _, e := range r.Entries {
+		if e.Err != nil {
+			failed = append(failed, e)
+		}
+	}
+	return failed
+}
+
+// ByType returns the entries of the given type.
+func (r Report) ByType(t ResourceType) []Entry {
+	var entries []Entry
+	for _, e := range r.Entries {
+		if e.Type == t {
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
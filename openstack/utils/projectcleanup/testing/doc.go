@@ -0,0 +1,2 @@
+// projectcleanup unit tests
+package testing
@@ -0,0 +1,231 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+// ProjectID is the ID of the cleaned up project.
+const ProjectID = "fcad67a6189847c4aecfa3c81a05783b"
+
+// ServerListOutput is a sample response to a server List request.
+const ServerListOutput = `
+{
+	"servers": [
+		{"id": "9e5476bd-a4ec-4653-93d6-72c93aa682ba", "name": "web", "tenant_id": "fcad67a6189847c4aecfa3c81a05783b"}
+	]
+}
+`
+
+// ForeignServerListOutput is a sample response to a server List request
+// ignoring the project filter.
+const ForeignServerListOutput = `
+{
+	"servers": [
+		{"id": "9e5476bd-a4ec-4653-93d6-72c93aa682ba", "name": "web", "tenant_id": "fcad67a6189847c4aecfa3c81a05783b"},
+		{"id": "d2e7c1a4-3b6f-4f0e-a1c9-8e5b7d3f2a10", "name": "web", "tenant_id": "0fbd1bc0c3c84c4ebbc7ab6a1cc2c7e6"}
+	]
+}
+`
+
+// LoadBalancerListOutput is a sample response to a load balancer List
+// request.
+const LoadBalancerListOutput = `
+{
+	"loadbalancers": [
+		{"id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab", "name": "web-lb", "project_id": "fcad67a6189847c4aecfa3c81a05783b"}
+	]
+}
+`
+
+// VolumeListOutput is a sample response to a volume List request.
+const VolumeListOutput = `
+{
+	"volumes": [
+		{"id": "289da7f8-6440-407c-9fb4-7db01ec49164", "name": "web-data", "os-vol-tenant-attr:tenant_id": "fcad67a6189847c4aecfa3c81a05783b"}
+	]
+}
+`
+
+// FloatingIPListOutput is a sample response to a floating IP List request.
+const FloatingIPListOutput = `
+{
+	"floatingips": [
+		{"id": "2f245a7b-796b-4f26-9cf9-9e82d248fda7", "floating_ip_address": "172.24.4.228", "project_id": "fcad67a6189847c4aecfa3c81a05783b"}
+	]
+}
+`
+
+// RouterListOutput is a sample response to a router List request.
+const RouterListOutput = `
+{
+	"routers": [
+		{"id": "f8a44de0-fc8e-45df-93c7-f79bf3b01c95", "name": "router1", "project_id": "fcad67a6189847c4aecfa3c81a05783b"}
+	]
+}
+`
+
+// RouterPortListOutput is a sample response to a port List request filtered
+// by the ID of the router.
+const RouterPortListOutput = `
+{
+	"ports": [
+		{"id": "0a9d6c5e-46e3-4a1c-8c36-a5c8ce6a3f7b", "device_id": "f8a44de0-fc8e-45df-93c7-f79bf3b01c95", "device_owner": "network:router_interface"},
+		{"id": "5fd8e1a9-93b5-4dc0-9bd8-4f5bf2d0a1a1", "device_id": "f8a44de0-fc8e-45df-93c7-f79bf3b01c95", "device_owner": "network:router_gateway"}
+	]
+}
+`
+
+// PortListOutput is a sample response to a port List request filtered by
+// the ID of the project. Only the last port is not managed by the
+// Networking service.
+const PortListOutput = `
+{
+	"ports": [
+		{"id": "0a9d6c5e-46e3-4a1c-8c36-a5c8ce6a3f7b", "device_owner": "network:router_interface", "project_id": "fcad67a6189847c4aecfa3c81a05783b"},
+		{"id": "ab6e3b5d-9c7e-4f17-9b0c-8a2f4c1d6e3f", "device_owner": "network:dhcp", "project_id": "fcad67a6189847c4aecfa3c81a05783b"},
+		{"id": "46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2", "name": "vip", "device_owner": "", "project_id": "fcad67a6189847c4aecfa3c81a05783b"}
+	]
+}
+`
+
+// NetworkListOutput is a sample response to a network List request.
+const NetworkListOutput = `
+{
+	"networks": [
+		{"id": "a87cc70a-3e15-4acf-8205-9b711a3531b7", "name": "private", "project_id": "fcad67a6189847c4aecfa3c81a05783b"}
+	]
+}
+`
+
+// SecurityGroupListOutput is a sample response to a security group List
+// request.
+const SecurityGroupListOutput = `
+{
+	"security_groups": [
+		{"id": "85cc3048-abc3-43cc-89b3-377341426ac5", "name": "default", "project_id": "fcad67a6189847c4aecfa3c81a05783b"}
+	]
+}
+`
+
+// Requests records the requests modifying resources received by the test
+// server, as "METHOD path".
+type Requests struct {
+	mu       sync.Mutex
+	requests []string
+}
+
+func (r *Requests) add(req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, req.Method+" "+req.URL.Path)
+}
+
+// List returns the recorded requests.
+func (r *Requests) List() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.requests...)
+}
+
+// HandleProjectResources sets up the test server to respond to the List and
+// Delete requests of the resources of ProjectID. Deleting the network
+// responds with networkDeleteStatus.
+func HandleProjectResources(t *testing.T, networkDeleteStatus int) *Requests {
+	requests := &Requests{}
+	deleted := make(map[string]bool)
+	var mu sync.Mutex
+
+	list := func(path, body string, query map[string]string) {
+		th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			th.TestFormValues(t, r, query)
+
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprint(w, body)
+		})
+	}
+
+	remove := func(path string, status int) {
+		th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch r.Method {
+			case "DELETE":
+				requests.add(r)
+				if status == http.StatusNoContent {
+					deleted[path] = true
+				}
+				w.WriteHeader(status)
+			case "GET":
+				// Polled while waiting for the deletion.
+				if deleted[path] {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Header().Add("Content-Type", "application/json")
+				fmt.Fprint(w, `{}`)
+			default:
+				t.Errorf("unexpected method %s", r.Method)
+			}
+		})
+	}
+
+	list("/servers/detail", ServerListOutput, map[string]string{"all_tenants": "true", "tenant_id": ProjectID})
+	remove("/servers/9e5476bd-a4ec-4653-93d6-72c93aa682ba", http.StatusNoContent)
+
+	list("/lbaas/loadbalancers", LoadBalancerListOutput, map[string]string{"project_id": ProjectID})
+	remove("/lbaas/loadbalancers/36e08a3e-a78f-4b40-a229-1e7e23eee1ab", http.StatusNoContent)
+
+	list("/volumes/detail", VolumeListOutput, map[string]string{"all_tenants": "true", "project_id": ProjectID})
+	remove("/volumes/289da7f8-6440-407c-9fb4-7db01ec49164", http.StatusNoContent)
+
+	list("/floatingips", FloatingIPListOutput, map[string]string{"project_id": ProjectID})
+	remove("/floatingips/2f245a7b-796b-4f26-9cf9-9e82d248fda7", http.StatusNoContent)
+
+	list("/routers", RouterListOutput, map[string]string{"project_id": ProjectID})
+	remove("/routers/f8a44de0-fc8e-45df-93c7-f79bf3b01c95", http.StatusNoContent)
+	th.Mux.HandleFunc("/routers/f8a44de0-fc8e-45df-93c7-f79bf3b01c95/remove_router_interface", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"port_id": "0a9d6c5e-46e3-4a1c-8c36-a5c8ce6a3f7b"}`)
+		requests.add(r)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": "f8a44de0-fc8e-45df-93c7-f79bf3b01c95", "port_id": "0a9d6c5e-46e3-4a1c-8c36-a5c8ce6a3f7b"}`)
+	})
+
+	th.Mux.HandleFunc("/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		switch {
+		case r.URL.Query().Get("device_id") == "f8a44de0-fc8e-45df-93c7-f79bf3b01c95":
+			fmt.Fprint(w, RouterPortListOutput)
+		case r.URL.Query().Get("project_id") == ProjectID:
+			fmt.Fprint(w, PortListOutput)
+		default:
+			t.Errorf("unexpected port List query %q", r.URL.RawQuery)
+		}
+	})
+	remove("/ports/46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2", http.StatusNoContent)
+
+	list("/networks", NetworkListOutput, map[string]string{"project_id": ProjectID})
+	remove("/networks/a87cc70a-3e15-4acf-8205-9b711a3531b7", networkDeleteStatus)
+
+	list("/security-groups", SecurityGroupListOutput, map[string]string{"project_id": ProjectID})
+	remove("/security-groups/85cc3048-abc3-43cc-89b3-377341426ac5", http.StatusNoContent)
+
+	remove("/projects/"+ProjectID, http.StatusNoContent)
+
+	return requests
+}
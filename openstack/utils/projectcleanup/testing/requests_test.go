@@ -0,0 +1,199 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils/projectcleanup"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func allClients() projectcleanup.Clients {
+	c := client.ServiceClient()
+	return projectcleanup.Clients{
+		Identity:     c,
+		Compute:      c,
+		BlockStorage: c,
+		LoadBalancer: c,
+		Network:      c,
+	}
+}
+
+var expectedResources = []projectcleanup.Resource{
+	{Type: projectcleanup.ResourceTypeServer, ID: "9e5476bd-a4ec-4653-93d6-72c93aa682ba", Name: "web", ProjectID: ProjectID},
+	{Type: projectcleanup.ResourceTypeLoadBalancer, ID: "36e08a3e-a78f-4b40-a229-1e7e23eee1ab", Name: "web-lb", ProjectID: ProjectID},
+	{Type: projectcleanup.ResourceTypeVolume, ID: "289da7f8-6440-407c-9fb4-7db01ec49164", Name: "web-data", ProjectID: ProjectID},
+	{Type: projectcleanup.ResourceTypeFloatingIP, ID: "2f245a7b-796b-4f26-9cf9-9e82d248fda7", Name: "172.24.4.228", ProjectID: ProjectID},
+	{Type: projectcleanup.ResourceTypeRouter, ID: "f8a44de0-fc8e-45df-93c7-f79bf3b01c95", Name: "router1", ProjectID: ProjectID},
+	{Type: projectcleanup.ResourceTypePort, ID: "46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2", Name: "vip", ProjectID: ProjectID},
+	{Type: projectcleanup.ResourceTypeNetwork, ID: "a87cc70a-3e15-4acf-8205-9b711a3531b7", Name: "private", ProjectID: ProjectID},
+	{Type: projectcleanup.ResourceTypeSecurityGroup, ID: "85cc3048-abc3-43cc-89b3-377341426ac5", Name: "default", ProjectID: ProjectID},
+	{Type: projectcleanup.ResourceTypeProject, ID: ProjectID},
+}
+
+func TestCleanup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	requests := HandleProjectResources(t, http.StatusNoContent)
+
+	report, err := projectcleanup.Cleanup(context.TODO(), allClients(), projectcleanup.Opts{ProjectID: ProjectID})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, false, report.DryRun)
+
+	th.AssertEquals(t, len(expectedResources), len(report.Entries))
+	for i, entry := range report.Entries {
+		th.CheckDeepEquals(t, expectedResources[i], entry.Resource)
+		th.CheckEquals(t, true, entry.Deleted)
+		th.AssertNoErr(t, entry.Err)
+	}
+
+	expectedRequests := []string{
+		"DELETE /servers/9e5476bd-a4ec-4653-93d6-72c93aa682ba",
+		"DELETE /lbaas/loadbalancers/36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
+		"DELETE /volumes/289da7f8-6440-407c-9fb4-7db01ec49164",
+		"DELETE /floatingips/2f245a7b-796b-4f26-9cf9-9e82d248fda7",
+		"PUT /routers/f8a44de0-fc8e-45df-93c7-f79bf3b01c95/remove_router_interface",
+		"DELETE /routers/f8a44de0-fc8e-45df-93c7-f79bf3b01c95",
+		"DELETE /ports/46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2",
+		"DELETE /networks/a87cc70a-3e15-4acf-8205-9b711a3531b7",
+		"DELETE /security-groups/85cc3048-abc3-43cc-89b3-377341426ac5",
+		"DELETE /projects/" + ProjectID,
+	}
+	th.CheckDeepEquals(t, expectedRequests, requests.List())
+}
+
+func TestCleanupDryRun(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	requests := HandleProjectResources(t, http.StatusNoContent)
+
+	report, err := projectcleanup.Cleanup(context.TODO(), allClients(), projectcleanup.Opts{ProjectID: ProjectID, DryRun: true})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, report.DryRun)
+
+	th.AssertEquals(t, len(expectedResources), len(report.Entries))
+	for i, entry := range report.Entries {
+		th.CheckDeepEquals(t, expectedResources[i], entry.Resource)
+		th.CheckEquals(t, false, entry.Deleted)
+	}
+	th.AssertEquals(t, 0, len(requests.List()))
+}
+
+func TestCleanupFailureKeepsProject(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	requests := HandleProjectResources(t, http.StatusConflict)
+
+	report, err := projectcleanup.Cleanup(context.TODO(), allClients(), projectcleanup.Opts{ProjectID: ProjectID})
+
+	var cleanupErr projectcleanup.ErrCleanup
+	if !errors.As(err, &cleanupErr) {
+		t.Fatalf("ErrCleanup was expected to occur, got %v", err)
+	}
+	th.AssertEquals(t, 1, len(cleanupErr.Failed))
+	th.AssertEquals(t, projectcleanup.ResourceTypeNetwork, cleanupErr.Failed[0].Type)
+	th.AssertEquals(t, true, gophercloud.ResponseCodeIs(err, http.StatusConflict))
+
+	// The security group is still deleted, but not the project.
+	th.AssertEquals(t, 1, len(report.ByType(projectcleanup.ResourceTypeSecurityGroup)))
+	th.AssertEquals(t, 0, len(report.ByType(projectcleanup.ResourceTypeProject)))
+	for _, r := range requests.List() {
+		if r == "DELETE /projects/"+ProjectID {
+			t.Fatal("the project was deleted")
+		}
+	}
+}
+
+func TestCleanupKeepProject(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleProjectResources(t, http.StatusNoContent)
+
+	clients := allClients()
+	clients.Identity = nil
+	report, err := projectcleanup.Cleanup(context.TODO(), clients, projectcleanup.Opts{ProjectID: ProjectID, KeepProject: true, DryRun: true})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(report.ByType(projectcleanup.ResourceTypeProject)))
+}
+
+func TestCleanupSkipsForeignResources(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	// The service ignores the project filter.
+	th.Mux.HandleFunc("/servers/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ForeignServerListOutput)
+	})
+
+	deleted := false
+	th.Mux.HandleFunc("/servers/9e5476bd-a4ec-4653-93d6-72c93aa682ba", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+	th.Mux.HandleFunc("/servers/d2e7c1a4-3b6f-4f0e-a1c9-8e5b7d3f2a10", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s request on a server of another project", r.Method)
+	})
+
+	report, err := projectcleanup.Cleanup(context.TODO(), projectcleanup.Clients{Compute: client.ServiceClient()}, projectcleanup.Opts{
+		ProjectID:   ProjectID,
+		KeepProject: true,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, deleted)
+	th.AssertEquals(t, 1, len(report.Entries))
+	th.AssertEquals(t, "9e5476bd-a4ec-4653-93d6-72c93aa682ba", report.Entries[0].ID)
+}
+
+func TestCleanupSoftDeletedServer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ServerListOutput)
+	})
+	th.Mux.HandleFunc("/servers/9e5476bd-a4ec-4653-93d6-72c93aa682ba", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		// The server is kept until reclaim_instance_interval expires.
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"server": {"id": "9e5476bd-a4ec-4653-93d6-72c93aa682ba", "status": "SOFT_DELETED"}}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+
+	report, err := projectcleanup.Cleanup(ctx, projectcleanup.Clients{Compute: client.ServiceClient()}, projectcleanup.Opts{
+		ProjectID:   ProjectID,
+		KeepProject: true,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, report.Entries[0].Deleted)
+}
+
+func TestCleanupRequiresProjectID(t *testing.T) {
+	_, err := projectcleanup.Cleanup(context.TODO(), allClients(), projectcleanup.Opts{})
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("ErrMissingInput was expected to occur, got %v", err)
+	}
+
+	_, err = projectcleanup.Cleanup(context.TODO(), projectcleanup.Clients{}, projectcleanup.Opts{ProjectID: ProjectID})
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("ErrMissingInput was expected to occur, got %v", err)
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Package search finds resources matching a name or a tag across several
+OpenStack services at once and returns them as a single inventory. It is
+intended for cleanup and audit tools.
+
+Servers, ports, volumes, load balancers and DNS record sets are searched
+concurrently. A service is skipped when its client is not set. Volumes and
+DNS record sets have no tags, so they are skipped when searching by tag.
+
+Example to Find Resources by Name
+
+	clients := search.Clients{
+		Compute:      computeClient,
+		Network:      networkClient,
+		BlockStorage: blockStorageClient,
+		LoadBalancer: loadBalancerClient,
+		DNS:          dnsClient,
+	}
+
+	inventory, err := search.Search(context.TODO(), clients, search.Opts{Name: "ci-runner"})
+	if err != nil {
+		// err may be an ErrSearch, in which case inventory still holds the
+		// resources found by the services which answered.
+		fmt.Println(err)
+	}
+
+	for _, r := range inventory.Resources {
+		fmt.Printf("%s %s %s\n", r.Type, r.ID, r.Name)
+	}
+
+Example to Find Resources by Tag in all Projects
+
+	searchOpts := search.Opts{
+		Tag:         "owner=ci",
+		AllProjects: true,
+	}
+
+	computeClient.Microversion = "2.26"
+
+	inventory, err := search.Search(context.TODO(), clients, searchOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, server := range inventory.ByType(search.ResourceTypeServer) {
+		fmt.Printf("%s %s\n", server.ID, server.ProjectID)
+	}
+*/
+package search
@@ -0,0 +1,48 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// ServiceError is the failure of searching a single resource type.
+type ServiceError struct {
+	// Type is the resource type which could not be searched.
+	Type ResourceType
+
+	// Err is the error returned while searching.
+	Err error
+}
+
+func (e ServiceError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Type, e.Err)
+}
+
+func (e ServiceError) Unwrap() error {
+	return e.Err
+}
+
+// ErrSearch is returned by Search when one or more resource types could not
+// be searched. The resources found by the other services are still returned.
+type ErrSearch struct {
+	gophercloud.BaseError
+	Errors []ServiceError
+}
+
+func (e ErrSearch) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("failed to search %d resource type(s): %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+func (e ErrSearch) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
+}
@@ -0,0 +1,303 @@
+package search
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/servers"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/dns/v2/recordsets"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/dns/v2/zones"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/ports"
+)
+
+// Clients holds the service clients used by Search. A nil client skips the
+// resources of the corresponding service.
+type Clients struct {
+	// Compute is a Compute v2 client, used to search servers. Searching
+	// servers by tag requires microversion 2.26 or later.
+	Compute *gophercloud.ServiceClient
+
+	// Network is a Networking v2 client, used to search ports.
+	Network *gophercloud.ServiceClient
+
+	// BlockStorage is a Block Storage v3 client, used to search volumes.
+	BlockStorage *gophercloud.ServiceClient
+
+	// LoadBalancer is a Load Balancer v2 client, used to search load
+	// balancers.
+	LoadBalancer *gophercloud.ServiceClient
+
+	// DNS is a DNS v2 client, used to search record sets of the zones of
+	// the current project.
+	DNS *gophercloud.ServiceClient
+}
+
+// Opts specifies the resources to search for. At least one of Name and Tag
+// is required. When both are set, resources must match both.
+type Opts struct {
+	// Name is the exact name of the resources.
+	Name string
+
+	// Tag is a tag the resources must have.
+	Tag string
+
+	// AllProjects searches the servers and volumes of all projects. It
+	// requires administrative privileges. Ports and load balancers of all
+	// projects are visible to administrators regardless of this option.
+	AllProjects bool
+}
+
+// Search concurrently queries the services of clients for resources matching
+// opts and returns them as a single Inventory. All services are queried even
+// if some of them fail; failures are reported together in an ErrSearch
+// error, along with the resources found by the other services.
+func Search(ctx context.Context, clients Clients, opts Opts) (Inventory, error) {
+	if opts.Name == "" && opts.Tag == "" {
+		return Inventory{}, gophercloud.ErrMissingInput{Argument: "search.Opts.Name"}
+	}
+
+	type searchFunc func(context.Context, *gophercloud.ServiceClient, Opts) ([]Resource, error)
+	type searcher struct {
+		resourceType ResourceType
+		client       *gophercloud.ServiceClient
+		search       searchFunc
+		tags         bool
+	}
+
+	searchers := []searcher{
+		{ResourceTypeServer, clients.Compute, searchServers, true},
+		{ResourceTypePort, clients.Network, searchPorts, true},
+		{ResourceTypeVolume, clients.BlockStorage, searchVolumes, false},
+		{ResourceTypeLoadBalancer, clients.LoadBalancer, searchLoadBalancers, true},
+		{ResourceTypeRecordSet, clients.DNS, searchRecordSets, false},
+	}
+
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		inventory Inventory
+		errs      []ServiceError
+	)
+
+	for _, s := range searchers {
+		if s.client == nil || (opts.Tag != "" && !s.tags) {
+			continue
+		}
+
+		wg.Add(1)
+		go func(s searcher) {
+			defer wg.Done()
+
+			resources, err := s.search(ctx, s.client, opts)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, ServiceError{Type: s.resourceType, Err: err})
+				return
+			}
+			for _, r := range resources {
+				if opts.matches(r) {
+					inventory.Resources = append(inventory.Resources, r)
+				}
+			}
+		}(s)
+	}
+	wg.Wait()
+
+	sort.Slice(inventory.Resources, func(i, j int) bool {
+		a, b := inventory.Resources[i], inventory.Resources[j]
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.ID < b.ID
+	})
+
+	if len(errs) > 0 {
+		sort.Slice(errs, func(i, j int) bool {
+			return errs[i].Type < errs[j].Type
+		})
+		return inventory, ErrSearch{Errors: errs}
+	}
+
+	return inventory, nil
+}
+
+// matches reports whether r matches opts. Services apply the filters
+// server-side, but with different semantics, such as the regular
+// expressions used for server names, so the results are checked again.
+func (opts Opts) matches(r Resource) bool {
+	if opts.Name != "" && r.Name != opts.Name {
+		return false
+	}
+	if opts.Tag != "" && !slices.Contains(r.Tags, opts.Tag) {
+		return false
+	}
+	return true
+}
+
+func searchServers(ctx context.Context, client *gophercloud.ServiceClient, opts Opts) ([]Resource, error) {
+	allPages, err := servers.List(client, servers.ListOpts{
+		Name:       opts.Name,
+		Tags:       opts.Tag,
+		AllTenants: opts.AllProjects,
+	}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allServers, err := servers.ExtractServers(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]Resource, len(allServers))
+	for i, s := range allServers {
+		resources[i] = Resource{
+			Type:      ResourceTypeServer,
+			ID:        s.ID,
+			Name:      s.Name,
+			ProjectID: s.TenantID,
+			Status:    s.Status,
+		}
+		if s.Tags != nil {
+			resources[i].Tags = *s.Tags
+		}
+	}
+	return resources, nil
+}
+
+func searchPorts(ctx context.Context, client *gophercloud.ServiceClient, opts Opts) ([]Resource, error) {
+	allPages, err := ports.List(client, ports.ListOpts{
+		Name: opts.Name,
+		Tags: opts.Tag,
+	}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]Resource, len(allPorts))
+	for i, p := range allPorts {
+		resources[i] = Resource{
+			Type:      ResourceTypePort,
+			ID:        p.ID,
+			Name:      p.Name,
+			ProjectID: p.ProjectID,
+			Status:    p.Status,
+			Tags:      p.Tags,
+			ParentID:  p.NetworkID,
+		}
+	}
+	return resources, nil
+}
+
+func searchVolumes(ctx context.Context, client *gophercloud.ServiceClient, opts Opts) ([]Resource, error) {
+	allPages, err := volumes.List(client, volumes.ListOpts{
+		Name:       opts.Name,
+		AllTenants: opts.AllProjects,
+	}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allVolumes, err := volumes.ExtractVolumes(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]Resource, len(allVolumes))
+	for i, v := range allVolumes {
+		resources[i] = Resource{
+			Type:      ResourceTypeVolume,
+			ID:        v.ID,
+			Name:      v.Name,
+			ProjectID: v.TenantID,
+			Status:    v.Status,
+		}
+	}
+	return resources, nil
+}
+
+func searchLoadBalancers(ctx context.Context, client *gophercloud.ServiceClient, opts Opts) ([]Resource, error) {
+	listOpts := loadbalancers.ListOpts{
+		Name: opts.Name,
+	}
+	if opts.Tag != "" {
+		listOpts.Tags = []string{opts.Tag}
+	}
+
+	allPages, err := loadbalancers.List(client, listOpts).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allLoadBalancers, err := loadbalancers.ExtractLoadBalancers(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]Resource, len(allLoadBalancers))
+	for i, lb := range allLoadBalancers {
+		resources[i] = Resource{
+			Type:      ResourceTypeLoadBalancer,
+			ID:        lb.ID,
+			Name:      lb.Name,
+			ProjectID: lb.ProjectID,
+			Status:    lb.ProvisioningStatus,
+			Tags:      lb.Tags,
+		}
+	}
+	return resources, nil
+}
+
+func searchRecordSets(ctx context.Context, client *gophercloud.ServiceClient, opts Opts) ([]Resource, error) {
+	allPages, err := zones.List(client, nil).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allZones, err := zones.ExtractZones(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []Resource
+	for _, zone := range allZones {
+		allPages, err := recordsets.ListByZone(client, zone.ID, recordsets.ListOpts{
+			Name: opts.Name,
+		}).AllPages(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		allRecordSets, err := recordsets.ExtractRecordSets(allPages)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rs := range allRecordSets {
+			resources = append(resources, Resource{
+				Type:      ResourceTypeRecordSet,
+				ID:        rs.ID,
+				Name:      rs.Name,
+				ProjectID: rs.ProjectID,
+				Status:    rs.Status,
+				ParentID:  rs.ZoneID,
+			})
+		}
+	}
+	return resources, nil
+}
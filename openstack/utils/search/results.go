@@ -0,0 +1,56 @@
+package search
+
+// ResourceType is the type of a resource found by Search.
+type ResourceType string
+
+const (
+	ResourceTypeServer       ResourceType = "server"
+	ResourceTypePort         ResourceType = "port"
+	ResourceTypeVolume       ResourceType = "volume"
+	ResourceTypeLoadBalancer ResourceType = "loadbalancer"
+	ResourceTypeRecordSet    ResourceType = "recordset"
+)
+
+// Resource is a resource found by Search.
+type Resource struct {
+	// Type is the type of the resource.
+	Type ResourceType
+
+	// ID is the unique ID of the resource.
+	ID string
+
+	// Name is the name of the resource.
+	Name string
+
+	// ProjectID is the project owning the resource, when reported by the
+	// service.
+	ProjectID string
+
+	// Status is the status of the resource. For load balancers, it is the
+	// provisioning status.
+	Status string
+
+	// Tags are the tags of the resource, when supported by the service.
+	Tags []string
+
+	// ParentID is the ID of the resource the resource belongs to, such as
+	// the zone of a DNS record set.
+	ParentID string
+}
+
+// Inventory holds the resources found by Search, sorted by type, name and
+// ID.
+type Inventory struct {
+	Resources []Resource
+}
+
+// ByType returns the resources of the given type.
+func (i Inventory) ByType(t ResourceType) []Resource {
+	var resources []Resource
+	for _, r := range i.Resources {
+		if r.Type == t {
+			resources = append(resources, r)
+		}
+	}
+	return resources
+}
@@ -0,0 +1,2 @@
+// search unit tests
+package testing
@@ -0,0 +1,148 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+// ServerListOutput is a sample response to a server List request. The
+// second server matches the regular expression used by Nova for the name
+// filter, but not the exact name.
+const ServerListOutput = `
+{
+	"servers": [
+		{
+			"id": "9e5476bd-a4ec-4653-93d6-72c93aa682ba",
+			"name": "ci-runner",
+			"tenant_id": "fcad67a6189847c4aecfa3c81a05783b",
+			"status": "ACTIVE",
+			"tags": ["owner=ci"]
+		},
+		{
+			"id": "ef079b0c-e610-4dfb-b1aa-b49f07ac48e5",
+			"name": "ci-runner-old",
+			"tenant_id": "fcad67a6189847c4aecfa3c81a05783b",
+			"status": "SHUTOFF",
+			"tags": []
+		}
+	]
+}
+`
+
+// PortListOutput is a sample response to a port List request.
+const PortListOutput = `
+{
+	"ports": [
+		{
+			"id": "46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2",
+			"name": "ci-runner",
+			"network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+			"project_id": "fcad67a6189847c4aecfa3c81a05783b",
+			"status": "ACTIVE",
+			"tags": ["owner=ci"]
+		}
+	]
+}
+`
+
+// VolumeListOutput is a sample response to a volume List request.
+const VolumeListOutput = `
+{
+	"volumes": [
+		{
+			"id": "289da7f8-6440-407c-9fb4-7db01ec49164",
+			"name": "ci-runner",
+			"os-vol-tenant-attr:tenant_id": "fcad67a6189847c4aecfa3c81a05783b",
+			"status": "available"
+		}
+	]
+}
+`
+
+// LoadBalancerListOutput is a sample response to a load balancer List
+// request.
+const LoadBalancerListOutput = `
+{
+	"loadbalancers": [
+		{
+			"id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
+			"name": "ci-runner",
+			"project_id": "fcad67a6189847c4aecfa3c81a05783b",
+			"provisioning_status": "ACTIVE",
+			"tags": ["owner=ci"]
+		}
+	]
+}
+`
+
+// ZoneListOutput is a sample response to a zone List request.
+const ZoneListOutput = `
+{
+	"zones": [
+		{
+			"id": "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3",
+			"name": "example.org."
+		}
+	]
+}
+`
+
+// RecordSetListOutput is a sample response to a record set List request.
+const RecordSetListOutput = `
+{
+	"recordsets": [
+		{
+			"id": "f7b10e9b-0cae-4a91-b162-562bc6096648",
+			"name": "ci-runner",
+			"zone_id": "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3",
+			"project_id": "fcad67a6189847c4aecfa3c81a05783b",
+			"status": "ACTIVE"
+		}
+	]
+}
+`
+
+func handleList(t *testing.T, path, output string, query map[string]string) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, query)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, output)
+	})
+}
+
+// HandleSearchByNameSuccessfully configures the test server to respond to
+// the List requests of a search by name.
+func HandleSearchByNameSuccessfully(t *testing.T) {
+	byName := map[string]string{"name": "ci-runner"}
+	handleList(t, "/servers/detail", ServerListOutput, byName)
+	handleList(t, "/ports", PortListOutput, byName)
+	handleList(t, "/volumes/detail", VolumeListOutput, byName)
+	handleList(t, "/lbaas/loadbalancers", LoadBalancerListOutput, byName)
+	handleList(t, "/zones", ZoneListOutput, map[string]string{})
+	handleList(t, "/zones/a86dba58-0043-4cc6-a1bb-69d5e86f3ca3/recordsets", RecordSetListOutput, byName)
+}
+
+// HandleSearchByTagWithFailure configures the test server to respond to the
+// List requests of a search by tag, with the port List request failing.
+func HandleSearchByTagWithFailure(t *testing.T) {
+	handleList(t, "/servers/detail", ServerListOutput, map[string]string{"tags": "owner=ci", "all_tenants": "true"})
+	handleList(t, "/lbaas/loadbalancers", LoadBalancerListOutput, map[string]string{"tags": "owner=ci"})
+
+	th.Mux.HandleFunc("/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	for _, path := range []string{"/volumes/detail", "/zones"} {
+		th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils/search"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func allClients() search.Clients {
+	c := client.ServiceClient()
+	return search.Clients{
+		Compute:      c,
+		Network:      c,
+		BlockStorage: c,
+		LoadBalancer: c,
+		DNS:          c,
+	}
+}
+
+func TestSearchByName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSearchByNameSuccessfully(t)
+
+	inventory, err := search.Search(context.TODO(), allClients(), search.Opts{Name: "ci-runner"})
+	th.AssertNoErr(t, err)
+
+	expected := []search.Resource{
+		{Type: search.ResourceTypeLoadBalancer, ID: "36e08a3e-a78f-4b40-a229-1e7e23eee1ab", Name: "ci-runner", ProjectID: "fcad67a6189847c4aecfa3c81a05783b", Status: "ACTIVE", Tags: []string{"owner=ci"}},
+		{Type: search.ResourceTypePort, ID: "46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2", Name: "ci-runner", ProjectID: "fcad67a6189847c4aecfa3c81a05783b", Status: "ACTIVE", Tags: []string{"owner=ci"}, ParentID: "a87cc70a-3e15-4acf-8205-9b711a3531b7"},
+		{Type: search.ResourceTypeRecordSet, ID: "f7b10e9b-0cae-4a91-b162-562bc6096648", Name: "ci-runner", ProjectID: "fcad67a6189847c4aecfa3c81a05783b", Status: "ACTIVE", ParentID: "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3"},
+		{Type: search.ResourceTypeServer, ID: "9e5476bd-a4ec-4653-93d6-72c93aa682ba", Name: "ci-runner", ProjectID: "fcad67a6189847c4aecfa3c81a05783b", Status: "ACTIVE", Tags: []string{"owner=ci"}},
+		{Type: search.ResourceTypeVolume, ID: "289da7f8-6440-407c-9fb4-7db01ec49164", Name: "ci-runner", ProjectID: "fcad67a6189847c4aecfa3c81a05783b", Status: "available"},
+	}
+	th.AssertDeepEquals(t, expected, inventory.Resources)
+	th.AssertEquals(t, 1, len(inventory.ByType(search.ResourceTypeServer)))
+}
+
+func TestSearchByTagWithFailure(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSearchByTagWithFailure(t)
+
+	inventory, err := search.Search(context.TODO(), allClients(), search.Opts{Tag: "owner=ci", AllProjects: true})
+
+	var searchErr search.ErrSearch
+	if !errors.As(err, &searchErr) {
+		t.Fatalf("ErrSearch was expected to occur, got %v", err)
+	}
+	th.AssertEquals(t, 1, len(searchErr.Errors))
+	th.AssertEquals(t, search.ResourceTypePort, searchErr.Errors[0].Type)
+	if !gophercloud.ResponseCodeIs(err, http.StatusInternalServerError) {
+		t.Fatalf("a 500 error was expected to be wrapped, got %v", err)
+	}
+
+	th.AssertEquals(t, 2, len(inventory.Resources))
+	th.AssertEquals(t, search.ResourceTypeLoadBalancer, inventory.Resources[0].Type)
+	th.AssertEquals(t, search.ResourceTypeServer, inventory.Resources[1].Type)
+	th.AssertEquals(t, "9e5476bd-a4ec-4653-93d6-72c93aa682ba", inventory.Resources[1].ID)
+}
+
+func TestSearchRequiresNameOrTag(t *testing.T) {
+	_, err := search.Search(context.TODO(), allClients(), search.Opts{})
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("ErrMissingInput was expected to occur, got %v", err)
+	}
+}
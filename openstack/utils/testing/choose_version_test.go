@@ -423,3 +423,37 @@ func TestMicroversionSupported(t *testing.T) {
 		}
 	}
 }
+
+func TestMicroversionAtLeast(t *testing.T) {
+	tests := []struct {
+		Microversion string
+		Required     string
+		AtLeast      bool
+		Error        bool
+	}{
+		{Microversion: "2.90", Required: "2.90", AtLeast: true},
+		{Microversion: "2.91", Required: "2.90", AtLeast: true},
+		{Microversion: "2.9", Required: "2.90", AtLeast: false},
+		{Microversion: "3.0", Required: "2.90", AtLeast: true},
+		{Microversion: "1.99", Required: "2.1", AtLeast: false},
+		{Microversion: "latest", Required: "2.90", AtLeast: true},
+		{Microversion: "", Required: "2.1", AtLeast: false},
+		{Microversion: "2", Required: "2.1", Error: true},
+		{Microversion: "2.x", Required: "2.1", Error: true},
+		{Microversion: "2.1", Required: "bogus", Error: true},
+	}
+
+	for _, test := range tests {
+		atLeast, err := utils.MicroversionAtLeast(test.Microversion, test.Required)
+		if test.Error {
+			if err == nil {
+				t.Errorf("Expected error for microversion %q but got none", test.Microversion)
+			}
+			continue
+		}
+		th.AssertNoErr(t, err)
+		if atLeast != test.AtLeast {
+			t.Errorf("Expected %q at least %q to be %t", test.Microversion, test.Required, test.AtLeast)
+		}
+	}
+}
@@ -65,5 +65,32 @@ Delete an execution
 	if res.Err != nil {
 		panic(res.Err)
 	}
+
+List the sub-executions of an execution
+
+	listOpts := executions.ListSubExecutionsOpts{
+		ErrorsOnly: true,
+	}
+
+	allPages, err := executions.ListSubExecutions(mistralClient, "50bb59f1-eb77-4017-a77f-6d575b002667", listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	subExecutions, err := executions.ExtractExecutions(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Get the report of an execution
+
+	report, err := executions.GetReport(context.TODO(), mistralClient, "50bb59f1-eb77-4017-a77f-6d575b002667", executions.GetReportOpts{
+		ErrorsOnly: true,
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%d tasks failed\n", report.Statistics.ErrorTasksCount)
 */
 package executions
@@ -240,3 +240,87 @@ func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pa
 		return ExecutionPage{pagination.LinkedPageBase{PageResult: r}}
 	})
 }
+
+// ListSubExecutionsOptsBuilder allows extension to add additional parameters
+// to the ListSubExecutions request.
+type ListSubExecutionsOptsBuilder interface {
+	ToSubExecutionsListQuery() (string, error)
+}
+
+// ListSubExecutionsOpts filters the sub-executions of an execution.
+type ListSubExecutionsOpts struct {
+	// ErrorsOnly only returns the sub-executions in the ERROR state.
+	ErrorsOnly bool `q:"errors_only"`
+
+	// MaxDepth limits the depth of nested sub-executions which are returned.
+	// 0 only returns the direct sub-executions. By default, all of them are
+	// returned.
+	MaxDepth *int `q:"max_depth"`
+}
+
+// ToSubExecutionsListQuery formats a ListSubExecutionsOpts into a query string.
+func (opts ListSubExecutionsOpts) ToSubExecutionsListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// ListSubExecutions performs a call to list the workflow executions started,
+// directly or not, by the tasks of an execution.
+func ListSubExecutions(client *gophercloud.ServiceClient, id string, opts ListSubExecutionsOptsBuilder) pagination.Pager {
+	url := subExecutionsURL(client, id)
+	if opts != nil {
+		query, err := opts.ToSubExecutionsListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ExecutionPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// GetReportOptsBuilder allows extension to add additional parameters to the
+// GetReport request.
+type GetReportOptsBuilder interface {
+	ToExecutionReportQuery() (string, error)
+}
+
+// GetReportOpts filters the content of an execution report.
+type GetReportOpts struct {
+	// ErrorsOnly only reports the tasks and executions in the ERROR state.
+	ErrorsOnly bool `q:"errors_only"`
+
+	// MaxDepth limits the depth of nested executions in the report.
+	// By default, all of them are reported.
+	MaxDepth *int `q:"max_depth"`
+
+	// StatisticsOnly only returns the task statistics of the report.
+	StatisticsOnly bool `q:"statistics_only"`
+}
+
+// ToExecutionReportQuery formats a GetReportOpts into a query string.
+func (opts GetReportOpts) ToExecutionReportQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// GetReport retrieves the report of an execution, which contains the tree of
+// its task executions and nested workflow executions.
+// Use Extract to convert its result into a Report.
+func GetReport(ctx context.Context, client *gophercloud.ServiceClient, id string, opts GetReportOptsBuilder) (r ReportResult) {
+	url := reportURL(client, id)
+	if opts != nil {
+		query, err := opts.ToExecutionReportQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+
+	resp, err := client.Get(ctx, url, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
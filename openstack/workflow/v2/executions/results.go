@@ -160,3 +160,142 @@ func ExtractExecutions(r pagination.Page) ([]Execution, error) {
 	err := (r.(ExecutionPage)).ExtractInto(&s)
 	return s.Executions, err
 }
+
+// ReportResult is the response of a GetReport call.
+type ReportResult struct {
+	gophercloud.Result
+}
+
+// Extract helps to get a Report struct from a GetReport call.
+func (r ReportResult) Extract() (*Report, error) {
+	var s Report
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// Report is the report of an execution.
+type Report struct {
+	// RootWorkflowExecution is the reported execution. It is nil when the
+	// report was requested with StatisticsOnly.
+	RootWorkflowExecution *WorkflowExecutionReport `json:"root_workflow_execution"`
+
+	// Statistics counts the tasks of the reported executions by state.
+	Statistics ReportStatistics `json:"statistics"`
+}
+
+// ReportStatistics counts the tasks of a report by state.
+type ReportStatistics struct {
+	TotalTasksCount   int `json:"total_tasks_count"`
+	RunningTasksCount int `json:"running_tasks_count"`
+	SuccessTasksCount int `json:"success_tasks_count"`
+	ErrorTasksCount   int `json:"error_tasks_count"`
+	IdleTasksCount    int `json:"idle_tasks_count"`
+	PausedTasksCount  int `json:"paused_tasks_count"`
+}
+
+// WorkflowExecutionReport is a workflow execution entry of a report.
+type WorkflowExecutionReport struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	State     string    `json:"state"`
+	StateInfo *string   `json:"state_info"`
+	CreatedAt time.Time `json:"-"`
+	UpdatedAt time.Time `json:"-"`
+
+	// TaskExecutions are the task executions of the workflow execution.
+	TaskExecutions []TaskExecutionReport `json:"task_executions"`
+}
+
+// UnmarshalJSON implements unmarshalling custom types
+func (r *WorkflowExecutionReport) UnmarshalJSON(b []byte) error {
+	type tmp WorkflowExecutionReport
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339ZNoTNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339ZNoTNoZ `json:"updated_at"`
+	}
+
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+
+	*r = WorkflowExecutionReport(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
+
+// TaskExecutionReport is a task execution entry of a report.
+type TaskExecutionReport struct {
+	ID                  string    `json:"id"`
+	Name                string    `json:"name"`
+	Type                string    `json:"type"`
+	WorkflowExecutionID string    `json:"workflow_execution_id"`
+	State               string    `json:"state"`
+	StateInfo           *string   `json:"state_info"`
+	CreatedAt           time.Time `json:"-"`
+	UpdatedAt           time.Time `json:"-"`
+
+	// ActionExecutions are the action executions run by the task.
+	ActionExecutions []ActionExecutionReport `json:"action_executions"`
+
+	// WorkflowExecutions are the sub-workflow executions started by the task.
+	WorkflowExecutions []WorkflowExecutionReport `json:"workflow_executions"`
+}
+
+// UnmarshalJSON implements unmarshalling custom types
+func (r *TaskExecutionReport) UnmarshalJSON(b []byte) error {
+	type tmp TaskExecutionReport
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339ZNoTNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339ZNoTNoZ `json:"updated_at"`
+	}
+
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+
+	*r = TaskExecutionReport(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
+
+// ActionExecutionReport is an action execution entry of a report.
+type ActionExecutionReport struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	State     string    `json:"state"`
+	StateInfo *string   `json:"state_info"`
+	CreatedAt time.Time `json:"-"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+// UnmarshalJSON implements unmarshalling custom types
+func (r *ActionExecutionReport) UnmarshalJSON(b []byte) error {
+	type tmp ActionExecutionReport
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339ZNoTNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339ZNoTNoZ `json:"updated_at"`
+	}
+
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+
+	*r = ActionExecutionReport(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
@@ -268,3 +268,153 @@ func newValue(param, value string) string {
 
 	return "?" + v.Encode()
 }
+
+func TestListSubExecutions(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	th.Mux.HandleFunc("/executions/50bb59f1-eb77-4017-a77f-6d575b002667/executions", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"errors_only": "true",
+			"max_depth":   "0",
+		})
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"executions": [
+				{
+					"created_at": "2018-09-12 14:48:50",
+					"description": "",
+					"id": "9a1a6c1a-bc0c-4ac5-b8f4-5a1b1b6e1a2c",
+					"input": "{}",
+					"output": "{}",
+					"params": "{\"namespace\": \"\"}",
+					"project_id": "778c0f25df0d492a9a868ee9e2fbb513",
+					"root_execution_id": "50bb59f1-eb77-4017-a77f-6d575b002667",
+					"state": "ERROR",
+					"state_info": "Failed to run action",
+					"task_execution_id": "c0e8ad5a-ac2d-4a96-b4b3-1d2fe1a8cb2a",
+					"updated_at": "2018-09-12 14:48:52",
+					"workflow_id": "6656c143-a009-4bcb-9814-cc100a20bbfb",
+					"workflow_name": "sub_workflow",
+					"workflow_namespace": ""
+				}
+			]
+		}`)
+	})
+
+	maxDepth := 0
+	opts := executions.ListSubExecutionsOpts{
+		ErrorsOnly: true,
+		MaxDepth:   &maxDepth,
+	}
+	allPages, err := executions.ListSubExecutions(fake.ServiceClient(), "50bb59f1-eb77-4017-a77f-6d575b002667", opts).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	actual, err := executions.ExtractExecutions(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(actual))
+	th.AssertEquals(t, "9a1a6c1a-bc0c-4ac5-b8f4-5a1b1b6e1a2c", actual[0].ID)
+	th.AssertEquals(t, "50bb59f1-eb77-4017-a77f-6d575b002667", *actual[0].RootExecutionID)
+	th.AssertEquals(t, "ERROR", actual[0].State)
+}
+
+func TestGetReport(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	th.Mux.HandleFunc("/executions/50bb59f1-eb77-4017-a77f-6d575b002667/report", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"errors_only": "true",
+		})
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"root_workflow_execution": {
+				"id": "50bb59f1-eb77-4017-a77f-6d575b002667",
+				"name": "main_workflow",
+				"state": "ERROR",
+				"state_info": "Failure caused by error in tasks: call_sub",
+				"created_at": "2018-09-12 14:48:49",
+				"updated_at": "2018-09-12 14:48:53",
+				"task_executions": [
+					{
+						"id": "c0e8ad5a-ac2d-4a96-b4b3-1d2fe1a8cb2a",
+						"name": "call_sub",
+						"type": "WORKFLOW",
+						"workflow_execution_id": "50bb59f1-eb77-4017-a77f-6d575b002667",
+						"state": "ERROR",
+						"state_info": "Failure caused by error in tasks: run",
+						"created_at": "2018-09-12 14:48:49",
+						"updated_at": "2018-09-12 14:48:53",
+						"action_executions": [],
+						"workflow_executions": [
+							{
+								"id": "9a1a6c1a-bc0c-4ac5-b8f4-5a1b1b6e1a2c",
+								"name": "sub_workflow",
+								"state": "ERROR",
+								"state_info": "Failure caused by error in tasks: run",
+								"created_at": "2018-09-12 14:48:50",
+								"updated_at": "2018-09-12 14:48:52",
+								"task_executions": [
+									{
+										"id": "0bd8a7c7-3b2e-4e0b-9d1f-7a1f1e0a6f3d",
+										"name": "run",
+										"type": "ACTION",
+										"workflow_execution_id": "9a1a6c1a-bc0c-4ac5-b8f4-5a1b1b6e1a2c",
+										"state": "ERROR",
+										"state_info": "Failed to run action",
+										"created_at": "2018-09-12 14:48:50",
+										"updated_at": "2018-09-12 14:48:52",
+										"action_executions": [
+											{
+												"id": "e1d5f6a1-4f5b-4c3e-8b8e-2f4d0c1b9a7e",
+												"name": "std.fail",
+												"state": "ERROR",
+												"state_info": "Fail action expected exception.",
+												"created_at": "2018-09-12 14:48:51",
+												"updated_at": "2018-09-12 14:48:52"
+											}
+										],
+										"workflow_executions": []
+									}
+								]
+							}
+						]
+					}
+				]
+			},
+			"statistics": {
+				"total_tasks_count": 2,
+				"running_tasks_count": 0,
+				"success_tasks_count": 0,
+				"error_tasks_count": 2,
+				"idle_tasks_count": 0,
+				"paused_tasks_count": 0
+			}
+		}`)
+	})
+
+	report, err := executions.GetReport(context.TODO(), fake.ServiceClient(), "50bb59f1-eb77-4017-a77f-6d575b002667", executions.GetReportOpts{
+		ErrorsOnly: true,
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, executions.ReportStatistics{
+		TotalTasksCount: 2,
+		ErrorTasksCount: 2,
+	}, report.Statistics)
+
+	root := report.RootWorkflowExecution
+	th.AssertEquals(t, "main_workflow", root.Name)
+	th.AssertEquals(t, time.Date(2018, time.September, 12, 14, 48, 49, 0, time.UTC), root.CreatedAt)
+	th.AssertEquals(t, 1, len(root.TaskExecutions))
+
+	sub := root.TaskExecutions[0].WorkflowExecutions[0]
+	th.AssertEquals(t, "sub_workflow", sub.Name)
+
+	action := sub.TaskExecutions[0].ActionExecutions[0]
+	th.AssertEquals(t, "std.fail", action.Name)
+	th.AssertEquals(t, "Fail action expected exception.", *action.StateInfo)
+	th.AssertEquals(t, time.Date(2018, time.September, 12, 14, 48, 52, 0, time.UTC), action.UpdatedAt)
+}
@@ -17,3 +17,11 @@ func deleteURL(client *gophercloud.ServiceClient, id string) string {
 func listURL(client *gophercloud.ServiceClient) string {
 	return client.ServiceURL("executions")
 }
+
+func subExecutionsURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("executions", id, "executions")
+}
+
+func reportURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("executions", id, "report")
+}
@@ -63,6 +63,11 @@ type ProviderClient struct {
 	// than querying versions first.
 	IdentityEndpoint string
 
+	// IdentityFailover, when set, lists the identity endpoints which
+	// authentication fails over to when the current one is unreachable.
+	// IdentityBase and IdentityEndpoint are updated to the endpoint in use.
+	IdentityFailover *IdentityFailover
+
 	// TokenID is the ID of the most recently issued valid token.
 	// NOTE: Aside from within a custom ReauthFunc, this field shouldn't be set by an application.
 	// To safely read or write this value, call `Token` or `SetToken`, respectively
@@ -48,6 +48,29 @@ func (client *ServiceClient) ServiceURL(parts ...string) string {
 	return client.ResourceBaseURL() + strings.Join(parts, "/")
 }
 
+// With returns a shallow copy of the service client with the given headers
+// added to MoreHeaders and, if microversion is not empty, the given
+// microversion. The copy shares the ProviderClient, and therefore the token,
+// but not the MoreHeaders map, so it can be modified and used concurrently
+// with the original client.
+func (client *ServiceClient) With(moreHeaders map[string]string, microversion string) *ServiceClient {
+	c := *client
+
+	c.MoreHeaders = make(map[string]string, len(client.MoreHeaders)+len(moreHeaders))
+	for k, v := range client.MoreHeaders {
+		c.MoreHeaders[k] = v
+	}
+	for k, v := range moreHeaders {
+		c.MoreHeaders[k] = v
+	}
+
+	if microversion != "" {
+		c.Microversion = microversion
+	}
+
+	return &c
+}
+
 func (client *ServiceClient) initReqOpts(JSONBody any, JSONResponse any, opts *RequestOpts) {
 	if v, ok := (JSONBody).(io.Reader); ok {
 		opts.RawBody = v
@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"reflect"
+	"sort"
 	"strings"
 	"testing"
 )
@@ -134,3 +135,87 @@ func TestJSONRequest(t *testing.T, r *http.Request, expected string) {
 
 	CheckJSONEquals(t, expected, actualJSON)
 }
+
+// TestJSONContains verifies that the JSON payload of a request contains an expected structure.
+// Fields of the payload which are absent from the expected structure are ignored, so that fields
+// added by newer microversions don't break existing fixtures. Arrays must have the same length,
+// and each of their elements must contain the expected element.
+func TestJSONContains(t testing.TB, r *http.Request, expected string) {
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		t.Errorf("Unable to read request body: %v", err)
+	}
+
+	var actualJSON, expectedJSON any
+	if err := json.Unmarshal(b, &actualJSON); err != nil {
+		t.Errorf("Unable to parse request body as JSON: %v", err)
+		return
+	}
+	if err := json.Unmarshal([]byte(expected), &expectedJSON); err != nil {
+		t.Errorf("Unable to parse expected value as JSON: %v", err)
+		return
+	}
+
+	if path, ok := isJSONSubset(expectedJSON, actualJSON, "$"); !ok {
+		t.Errorf("Body = %s, expected it to contain %s (mismatch at %s)", b, expected, path)
+	}
+}
+
+// isJSONSubset reports whether actual contains expected, along with the path of the first
+// mismatch.
+func isJSONSubset(expected, actual any, path string) (string, bool) {
+	switch e := expected.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			return path, false
+		}
+		for k, v := range e {
+			av, ok := a[k]
+			if !ok {
+				return path + "." + k, false
+			}
+			if p, ok := isJSONSubset(v, av, path+"."+k); !ok {
+				return p, false
+			}
+		}
+		return path, true
+	case []any:
+		a, ok := actual.([]any)
+		if !ok || len(a) != len(e) {
+			return path, false
+		}
+		for i := range e {
+			if p, ok := isJSONSubset(e[i], a[i], fmt.Sprintf("%s[%d]", path, i)); !ok {
+				return p, false
+			}
+		}
+		return path, true
+	default:
+		return path, reflect.DeepEqual(expected, actual)
+	}
+}
+
+// TestQueryParams ensures that the URL parameters of the http.Request are the same as values,
+// regardless of their order. Repeated keys are supported, and comma-separated lists are split so
+// that "a,b" is equivalent to the "a" and "b" values being given separately.
+func TestQueryParams(t testing.TB, r *http.Request, values map[string][]string) {
+	normalize := func(values map[string][]string) map[string][]string {
+		normalized := make(map[string][]string, len(values))
+		for k, vs := range values {
+			var all []string
+			for _, v := range vs {
+				all = append(all, strings.Split(v, ",")...)
+			}
+			sort.Strings(all)
+			normalized[k] = all
+		}
+		return normalized
+	}
+
+	want := normalize(values)
+	got := normalize(r.URL.Query())
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Request query parameters = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,71 @@
+package recorder
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// Request is the recorded part of an HTTP request.
+type Request struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Query  string      `json:"query,omitempty"`
+	Header http.Header `json:"headers,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// Response is the recorded part of an HTTP response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Interaction is a single recorded request along with its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Cassette is the content of a fixture file.
+type Cassette struct {
+	// ScrubbedFields is the list of JSON fields which were scrubbed while
+	// recording. Replayed request bodies are scrubbed the same way before
+	// being compared to the recorded ones.
+	ScrubbedFields []string `json:"scrubbed_fields,omitempty"`
+
+	// Interactions are the recorded interactions, in order.
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Load reads a Cassette from the fixture file at path.
+func Load(path string) (*Cassette, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var c Cassette
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+// Save writes the Cassette to the fixture file at path, creating its parent
+// directories if needed.
+func (c *Cassette) Save(path string) error {
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(b, '\n'), 0644)
+}
@@ -0,0 +1,48 @@
+/*
+Package recorder records live API interactions into fixture files and replays
+them against th.Mux in unit tests.
+
+A Recorder is an http.RoundTripper which wraps the transport of a
+ProviderClient. Every request and response passing through it is stored as an
+Interaction. Authentication headers and sensitive JSON fields are scrubbed
+before being recorded, and the origin of the live endpoint is replaced by a
+placeholder so that links in response bodies keep working once replayed.
+
+Requests made with a service client added with AddServiceClient are recorded
+relative to its resource base URL, so that the live service prefix, for
+example /compute/v2.1/, does not have to match the fake service client used
+in unit tests.
+
+Example to Record Interactions
+
+	rec := recorder.New(nil)
+	providerClient.HTTPClient = http.Client{
+		Transport: rec,
+	}
+	rec.AddServiceClient(networkClient)
+
+	// Use providerClient as usual...
+
+	err := rec.Save("testdata/networks.json")
+	if err != nil {
+		panic(err)
+	}
+
+Example to Replay Interactions in a Unit Test
+
+	func TestList(t *testing.T) {
+		th.SetupHTTP()
+		defer th.TeardownHTTP()
+
+		client := fake.ServiceClient()
+		recorder.Replay(t, client, "testdata/networks.json")
+
+		allPages, err := networks.List(client, nil).AllPages(context.TODO())
+		th.AssertNoErr(t, err)
+	}
+
+Interactions are matched by method, path and query string, and served in the
+order they were recorded, so that a path may be requested several times. When a recorded request has a JSON body, the body of
+the replayed request is checked against it.
+*/
+package recorder
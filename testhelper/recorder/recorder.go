@@ -0,0 +1,270 @@
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// EndpointPlaceholder replaces the origin (scheme and host) of the live
+// endpoint in recorded headers and bodies. It is substituted back with the
+// URL of th.Server when replaying.
+const EndpointPlaceholder = "http://recorder.invalid"
+
+// ServicePlaceholder replaces the resource base URL of the service client a
+// request was made with, in recorded headers and bodies. It is substituted
+// back with the resource base URL of the replaying service client.
+const ServicePlaceholder = "http://service.recorder.invalid/"
+
+// ScrubbedValue replaces the value of scrubbed headers and JSON fields.
+const ScrubbedValue = "***"
+
+// DefaultScrubHeaders is the list of headers which are always scrubbed.
+var DefaultScrubHeaders = []string{
+	"X-Auth-Token", "X-Subject-Token", "X-Service-Token", "X-Auth-Key",
+	"X-Storage-Token", "X-Account-Meta-Temp-Url-Key", "X-Account-Meta-Temp-Url-Key-2",
+	"X-Container-Meta-Temp-Url-Key", "X-Container-Meta-Temp-Url-Key-2",
+	"Authorization", "Set-Cookie",
+}
+
+// DefaultScrubFields is the list of JSON fields which are always scrubbed,
+// at any depth of a request or response body.
+var DefaultScrubFields = []string{"password", "adminPass", "secret"}
+
+// Recorder is an http.RoundTripper which records every interaction it
+// forwards to its underlying transport.
+type Recorder struct {
+	// Transport is the underlying RoundTripper. If nil,
+	// http.DefaultTransport is used.
+	Transport http.RoundTripper
+
+	// ScrubHeaders is a list of additional headers to scrub.
+	ScrubHeaders []string
+
+	// ScrubFields is a list of additional JSON fields to scrub.
+	ScrubFields []string
+
+	mu           sync.Mutex
+	interactions []Interaction
+	services     []string
+}
+
+// New returns a Recorder forwarding requests to the given transport.
+func New(transport http.RoundTripper) *Recorder {
+	return &Recorder{Transport: transport}
+}
+
+// AddServiceClient makes the Recorder store the requests made with the
+// service client relative to its resource base URL, for example "servers"
+// rather than "/compute/v2.1/servers", so that they can be replayed against
+// a fake service client with a different base URL.
+func (r *Recorder) AddServiceClient(client *gophercloud.ServiceClient) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.services = append(r.services, client.ResourceBaseURL())
+}
+
+// serviceBase returns the longest resource base URL of the service clients
+// added to the Recorder matching the request URL, or an empty string.
+func (r *Recorder) serviceBase(u string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	base := ""
+	for _, s := range r.services {
+		if strings.HasPrefix(u, s) && len(s) > len(base) {
+			base = s
+		}
+	}
+	return base
+}
+
+// RoundTrip performs the request with the underlying transport and records
+// the interaction.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	origin := req.URL.Scheme + "://" + req.URL.Host
+	path := req.URL.Path
+	base := r.serviceBase(origin + req.URL.Path)
+	if base != "" {
+		path = strings.TrimPrefix(origin+req.URL.Path, base)
+	}
+
+	u := urlScrubber{origin: origin, base: base}
+	interaction := Interaction{
+		Request: Request{
+			Method: req.Method,
+			Path:   path,
+			Query:  req.URL.RawQuery,
+			Header: r.scrubHeader(req.Header, u),
+			Body:   r.scrubBody(reqBody, u),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     r.scrubHeader(resp.Header, u),
+			Body:       r.scrubBody(respBody, u),
+		},
+	}
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, interaction)
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// Cassette returns a Cassette holding the interactions recorded so far.
+func (r *Recorder) Cassette() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	interactions := make([]Interaction, len(r.interactions))
+	copy(interactions, r.interactions)
+
+	return &Cassette{
+		ScrubbedFields: r.scrubFields(),
+		Interactions:   interactions,
+	}
+}
+
+// Save writes the interactions recorded so far to the fixture file at path.
+func (r *Recorder) Save(path string) error {
+	return r.Cassette().Save(path)
+}
+
+func (r *Recorder) scrubFields() []string {
+	return append(append([]string{}, DefaultScrubFields...), r.ScrubFields...)
+}
+
+// urlScrubber replaces the resource base URL of the service client, then the
+// origin of the live endpoint, with their placeholders.
+type urlScrubber struct {
+	origin string
+	base   string
+}
+
+func (u urlScrubber) scrub(s string) string {
+	if u.base != "" {
+		s = strings.ReplaceAll(s, u.base, ServicePlaceholder)
+	}
+	return strings.ReplaceAll(s, u.origin, EndpointPlaceholder)
+}
+
+func (r *Recorder) scrubHeader(header http.Header, u urlScrubber) http.Header {
+	scrubbed := make(http.Header, len(header))
+	for k, v := range header {
+		if r.isScrubbedHeader(k) {
+			scrubbed[k] = []string{ScrubbedValue}
+			continue
+		}
+		values := make([]string, len(v))
+		for i := range v {
+			values[i] = u.scrub(v[i])
+		}
+		scrubbed[k] = values
+	}
+	return scrubbed
+}
+
+func (r *Recorder) isScrubbedHeader(name string) bool {
+	for _, h := range DefaultScrubHeaders {
+		if strings.EqualFold(h, name) {
+			return true
+		}
+	}
+	for _, h := range r.ScrubHeaders {
+		if strings.EqualFold(h, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Recorder) scrubBody(body []byte, u urlScrubber) string {
+	if len(body) == 0 {
+		return ""
+	}
+	body = scrubJSON(body, r.scrubFields())
+	return u.scrub(string(body))
+}
+
+// scrubJSON replaces the value of the given fields at any depth of a JSON
+// document. Documents which are not valid JSON are returned unchanged.
+func scrubJSON(body []byte, fields []string) []byte {
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return body
+	}
+
+	if !scrubValue(v, fields) {
+		return body
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return b
+}
+
+func scrubValue(v any, fields []string) (changed bool) {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, child := range t {
+			scrub := false
+			for _, f := range fields {
+				if k == f {
+					scrub = true
+					break
+				}
+			}
+			if scrub {
+				t[k] = ScrubbedValue
+				changed = true
+				continue
+			}
+			if scrubValue(child, fields) {
+				changed = true
+			}
+		}
+	case []any:
+		for _, child := range t {
+			if scrubValue(child, fields) {
+				changed = true
+			}
+		}
+	}
+	return changed
+}
@@ -0,0 +1,121 @@
+package recorder
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+// Replay loads the fixture file at path and registers handlers on th.Mux
+// serving its interactions to the given service client.
+// th.SetupHTTP must have been called beforehand.
+func Replay(t *testing.T, client *gophercloud.ServiceClient, path string) {
+	c, err := Load(path)
+	th.AssertNoErr(t, err)
+
+	c.Replay(t, client)
+}
+
+// Replay registers handlers on th.Mux serving the interactions of the
+// Cassette to the given service client. Paths recorded relative to the
+// resource base URL of a service client are resolved against the resource
+// base URL of client.
+//
+// A single handler is registered per path. Each interaction is served at most
+// once, in recorded order, to the first request matching its method, path and
+// query string, so that a path may be requested several times, for example
+// when polling or when a resource is retrieved then deleted.
+func (c *Cassette) Replay(t *testing.T, client *gophercloud.ServiceClient) {
+	base := client.ResourceBaseURL()
+	u, err := url.Parse(base)
+	th.AssertNoErr(t, err)
+
+	resolve := func(path string) string {
+		if strings.HasPrefix(path, "/") {
+			return path
+		}
+		return u.Path + path
+	}
+
+	var mu sync.Mutex
+	used := make([]bool, len(c.Interactions))
+
+	var paths []string
+	seen := make(map[string]bool)
+	for _, i := range c.Interactions {
+		path := resolve(i.Request.Path)
+		if !seen[path] {
+			seen[path] = true
+			paths = append(paths, path)
+		}
+	}
+
+	endpoint := strings.TrimSuffix(th.Endpoint(), "/")
+	unscrub := strings.NewReplacer(ServicePlaceholder, base, EndpointPlaceholder, endpoint)
+
+	for _, path := range paths {
+		th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			idx := -1
+			for n, i := range c.Interactions {
+				if !used[n] && i.Request.Method == r.Method && resolve(i.Request.Path) == r.URL.Path && sameQuery(i.Request.Query, r.URL.RawQuery) {
+					idx = n
+					used[n] = true
+					break
+				}
+			}
+			mu.Unlock()
+
+			if idx < 0 {
+				t.Errorf("No recorded interaction left for %s %s", r.Method, r.URL.RequestURI())
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			i := c.Interactions[idx]
+
+			if i.Request.Body != "" && strings.HasPrefix(i.Request.Header.Get("Content-Type"), "application/json") {
+				b, err := io.ReadAll(r.Body)
+				th.AssertNoErr(t, err)
+
+				var actual any
+				if err := json.Unmarshal(scrubJSON(b, c.ScrubbedFields), &actual); err != nil {
+					t.Errorf("Unable to parse request body as JSON: %v", err)
+				}
+				th.CheckJSONEquals(t, unscrub.Replace(i.Request.Body), actual)
+			}
+
+			for k, v := range i.Response.Header {
+				// The body may change length once the endpoint is substituted.
+				if http.CanonicalHeaderKey(k) == "Content-Length" {
+					continue
+				}
+				for _, value := range v {
+					w.Header().Add(k, unscrub.Replace(value))
+				}
+			}
+			w.WriteHeader(i.Response.StatusCode)
+			fmt.Fprint(w, unscrub.Replace(i.Response.Body))
+		})
+	}
+}
+
+func sameQuery(recorded, actual string) bool {
+	r, err := url.ParseQuery(recorded)
+	if err != nil {
+		return recorded == actual
+	}
+	a, err := url.ParseQuery(actual)
+	if err != nil {
+		return recorded == actual
+	}
+	return reflect.DeepEqual(r, a)
+}
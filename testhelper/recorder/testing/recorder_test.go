@@ -0,0 +1,155 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/recorder"
+)
+
+const serverResponse = `
+{
+	"server": {
+		"id": "5d4a2f4c-4f2b-4a15-8b6d-0e8d4e2c1d3a",
+		"name": "web-1",
+		"adminPass": "s3cr3t",
+		"links": [
+			{"href": "%s/servers/5d4a2f4c-4f2b-4a15-8b6d-0e8d4e2c1d3a", "rel": "self"}
+		]
+	}
+}`
+
+func TestRecordAndReplay(t *testing.T) {
+	fixture := filepath.Join(t.TempDir(), "servers.json")
+
+	// Record against a "live" endpoint, behind a service prefix.
+	th.SetupHTTP()
+	th.Mux.HandleFunc("/compute/v2.1/servers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"a": "1", "b": "2"})
+		th.TestJSONRequest(t, r, `{"server": {"name": "web-1", "adminPass": "s3cr3t"}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.Header().Add("X-Subject-Token", "live-token")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, serverResponse, th.Endpoint()+"compute/v2.1")
+	})
+
+	rec := recorder.New(nil)
+	c := client.ServiceClient()
+	c.ResourceBase = th.Endpoint() + "compute/v2.1/"
+	c.HTTPClient = http.Client{Transport: rec}
+	rec.AddServiceClient(c)
+
+	reqBody := map[string]any{"server": map[string]any{"name": "web-1", "adminPass": "s3cr3t"}}
+
+	var recorded map[string]any
+	_, err := c.Post(context.TODO(), c.ServiceURL("servers")+"?a=1&b=2", reqBody, &recorded, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, rec.Save(fixture))
+	th.TeardownHTTP()
+
+	raw, err := os.ReadFile(fixture)
+	th.AssertNoErr(t, err)
+	for _, secret := range []string{client.TokenID, "live-token", "s3cr3t"} {
+		if strings.Contains(string(raw), secret) {
+			t.Errorf("Fixture contains unscrubbed value %q", secret)
+		}
+	}
+
+	cassette, err := recorder.Load(fixture)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(cassette.Interactions))
+	th.AssertEquals(t, "servers", cassette.Interactions[0].Request.Path)
+	th.AssertEquals(t, recorder.ScrubbedValue, cassette.Interactions[0].Response.Header.Get("X-Subject-Token"))
+
+	// Replay against a new th.Mux with a different endpoint.
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	c = client.ServiceClient()
+	recorder.Replay(t, c, fixture)
+
+	var replayed struct {
+		Server struct {
+			ID    string             `json:"id"`
+			Name  string             `json:"name"`
+			Links []gophercloud.Link `json:"links"`
+		} `json:"server"`
+	}
+	resp, err := c.Post(context.TODO(), c.ServiceURL("servers")+"?b=2&a=1", reqBody, &replayed, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, http.StatusAccepted, resp.StatusCode)
+	th.AssertEquals(t, "web-1", replayed.Server.Name)
+	th.AssertEquals(t, c.ServiceURL("servers", replayed.Server.ID), replayed.Server.Links[0].Href)
+}
+
+func TestReplaySamePathSeveralTimes(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	server := func(status string) recorder.Interaction {
+		return recorder.Interaction{
+			Request: recorder.Request{Method: "GET", Path: "servers/1234"},
+			Response: recorder.Response{
+				StatusCode: http.StatusOK,
+				Body:       fmt.Sprintf(`{"server": {"status": "%s"}}`, status),
+			},
+		}
+	}
+
+	cassette := recorder.Cassette{
+		Interactions: []recorder.Interaction{
+			server("ACTIVE"),
+			{
+				Request:  recorder.Request{Method: "DELETE", Path: "servers/1234"},
+				Response: recorder.Response{StatusCode: http.StatusNoContent},
+			},
+			server("DELETING"),
+			{
+				Request:  recorder.Request{Method: "GET", Path: "servers/1234"},
+				Response: recorder.Response{StatusCode: http.StatusNotFound},
+			},
+		},
+	}
+
+	c := client.ServiceClient()
+	cassette.Replay(t, c)
+
+	getStatus := func() (string, error) {
+		var s struct {
+			Server struct {
+				Status string `json:"status"`
+			} `json:"server"`
+		}
+		_, err := c.Get(context.TODO(), c.ServiceURL("servers", "1234"), &s, nil)
+		return s.Server.Status, err
+	}
+
+	status, err := getStatus()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "ACTIVE", status)
+
+	_, err = c.Delete(context.TODO(), c.ServiceURL("servers", "1234"), nil)
+	th.AssertNoErr(t, err)
+
+	status, err = getStatus()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "DELETING", status)
+
+	_, err = getStatus()
+	th.AssertEquals(t, true, gophercloud.ResponseCodeIs(err, http.StatusNotFound))
+}
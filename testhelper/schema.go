@@ -0,0 +1,250 @@
+package testhelper
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// schemas holds the OpenStack API schemas bundled with the testhelper
+// package. They are looked up by their path relative to the schemas
+// directory, e.g. "identity/v3/credential.json".
+//
+//go:embed schemas
+var schemas embed.FS
+
+// loadSchema reads a schema from the bundled schemas, or from the filesystem
+// if no bundled schema matches schemaPath.
+func loadSchema(schemaPath string) (map[string]any, error) {
+	b, err := schemas.ReadFile("schemas/" + schemaPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		b, err = os.ReadFile(schemaPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var schema map[string]any
+	if err := json.Unmarshal(b, &schema); err != nil {
+		return nil, fmt.Errorf("unable to parse schema %s: %w", schemaPath, err)
+	}
+	return schema, nil
+}
+
+// ValidateJSONSchema validates a JSON document against a JSON schema. The
+// schema is looked up in the bundled OpenStack API schemas first and then on
+// the filesystem.
+//
+// Only a subset of JSON Schema draft 4 is supported: type, enum, properties,
+// required, additionalProperties, items, anyOf, minimum, maximum, minItems
+// and maxItems. Other keywords are ignored.
+func ValidateJSONSchema(body, schemaPath string) error {
+	schema, err := loadSchema(schemaPath)
+	if err != nil {
+		return err
+	}
+
+	var document any
+	if err := json.Unmarshal([]byte(body), &document); err != nil {
+		return fmt.Errorf("unable to parse body as JSON: %w", err)
+	}
+
+	violations := validateSchema(schema, document, "$")
+	if len(violations) > 0 {
+		return fmt.Errorf("document does not match schema %s:\n%s", schemaPath, strings.Join(violations, "\n"))
+	}
+	return nil
+}
+
+// AssertJSONSchema fails the test if body does not match the schema found at
+// schemaPath. See ValidateJSONSchema for the supported schemas.
+func AssertJSONSchema(t *testing.T, body, schemaPath string) {
+	t.Helper()
+
+	if err := ValidateJSONSchema(body, schemaPath); err != nil {
+		logFatal(t, err.Error())
+	}
+}
+
+// CheckJSONSchema is similar to AssertJSONSchema, but nonfatal.
+func CheckJSONSchema(t *testing.T, body, schemaPath string) {
+	t.Helper()
+
+	if err := ValidateJSONSchema(body, schemaPath); err != nil {
+		logError(t, err.Error())
+	}
+}
+
+// validateSchema returns a description of every violation of schema by
+// value, found under path.
+func validateSchema(schema map[string]any, value any, path string) []string {
+	var violations []string
+
+	if t, ok := schema["type"]; ok {
+		var types []string
+		switch v := t.(type) {
+		case string:
+			types = []string{v}
+		case []any:
+			for _, s := range v {
+				if s, ok := s.(string); ok {
+					types = append(types, s)
+				}
+			}
+		}
+
+		matched := false
+		for _, typ := range types {
+			if matchesType(typ, value) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return append(violations, fmt.Sprintf("%s: expected type %s, got %s", path, strings.Join(types, " or "), jsonType(value)))
+		}
+	}
+
+	if enum, ok := schema["enum"].([]any); ok {
+		found := false
+		for _, e := range enum {
+			if reflect.DeepEqual(e, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			violations = append(violations, fmt.Sprintf("%s: value %v is not one of %v", path, value, enum))
+		}
+	}
+
+	if anyOf, ok := schema["anyOf"].([]any); ok {
+		matched := false
+		for _, s := range anyOf {
+			if s, ok := s.(map[string]any); ok && len(validateSchema(s, value, path)) == 0 {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			violations = append(violations, fmt.Sprintf("%s: value does not match any of the allowed schemas", path))
+		}
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		violations = append(violations, validateObject(schema, v, path)...)
+	case []any:
+		violations = append(violations, validateArray(schema, v, path)...)
+	case float64:
+		if minimum, ok := schema["minimum"].(float64); ok && v < minimum {
+			violations = append(violations, fmt.Sprintf("%s: %v is lower than the minimum %v", path, v, minimum))
+		}
+		if maximum, ok := schema["maximum"].(float64); ok && v > maximum {
+			violations = append(violations, fmt.Sprintf("%s: %v is greater than the maximum %v", path, v, maximum))
+		}
+	}
+
+	return violations
+}
+
+func validateObject(schema map[string]any, object map[string]any, path string) []string {
+	var violations []string
+
+	if required, ok := schema["required"].([]any); ok {
+		for _, r := range required {
+			if name, ok := r.(string); ok {
+				if _, ok := object[name]; !ok {
+					violations = append(violations, fmt.Sprintf("%s: missing required property %q", path, name))
+				}
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]any)
+
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		propertyPath := path + "." + name
+		if propertySchema, ok := properties[name].(map[string]any); ok {
+			violations = append(violations, validateSchema(propertySchema, object[name], propertyPath)...)
+			continue
+		}
+
+		switch additional := schema["additionalProperties"].(type) {
+		case bool:
+			if !additional {
+				violations = append(violations, fmt.Sprintf("%s: additional property is not allowed", propertyPath))
+			}
+		case map[string]any:
+			violations = append(violations, validateSchema(additional, object[name], propertyPath)...)
+		}
+	}
+
+	return violations
+}
+
+func validateArray(schema map[string]any, array []any, path string) []string {
+	var violations []string
+
+	if minItems, ok := schema["minItems"].(float64); ok && float64(len(array)) < minItems {
+		violations = append(violations, fmt.Sprintf("%s: expected at least %v items, got %d", path, minItems, len(array)))
+	}
+	if maxItems, ok := schema["maxItems"].(float64); ok && float64(len(array)) > maxItems {
+		violations = append(violations, fmt.Sprintf("%s: expected at most %v items, got %d", path, maxItems, len(array)))
+	}
+
+	if items, ok := schema["items"].(map[string]any); ok {
+		for i, item := range array {
+			violations = append(violations, validateSchema(items, item, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	}
+
+	return violations
+}
+
+// matchesType reports whether value is of the given JSON schema type.
+func matchesType(typ string, value any) bool {
+	switch typ {
+	case "integer":
+		v, ok := value.(float64)
+		return ok && v == math.Trunc(v)
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	default:
+		return jsonType(value) == typ
+	}
+}
+
+// jsonType returns the JSON schema type name of a decoded JSON value.
+func jsonType(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
@@ -0,0 +1,2 @@
+// testhelper unit tests
+package testing
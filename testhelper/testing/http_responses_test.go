@@ -0,0 +1,90 @@
+package testing
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+// recordingT records failures reported by a helper instead of failing the
+// test running it.
+type recordingT struct {
+	testing.TB
+	failed bool
+}
+
+func (t *recordingT) Errorf(format string, args ...any) {
+	t.failed = true
+}
+
+func TestJSONContains(t *testing.T) {
+	body := `{
+		"server": {
+			"name": "derp",
+			"hostname": "derp.example.com",
+			"networks": [{"uuid": "8a5fe506", "tag": "nic1"}]
+		}
+	}`
+
+	r, err := http.NewRequest("POST", "http://example.com/servers", strings.NewReader(body))
+	th.AssertNoErr(t, err)
+
+	th.TestJSONContains(t, r, `{"server": {"name": "derp", "networks": [{"uuid": "8a5fe506"}]}}`)
+}
+
+func TestQueryParams(t *testing.T) {
+	r, err := http.NewRequest("GET", "http://example.com/servers?tags=b,a&name=derp&status=ACTIVE&status=ERROR", nil)
+	th.AssertNoErr(t, err)
+
+	th.TestQueryParams(t, r, map[string][]string{
+		"name":   {"derp"},
+		"status": {"ERROR", "ACTIVE"},
+		"tags":   {"a", "b"},
+	})
+}
+
+func TestJSONContainsMismatch(t *testing.T) {
+	body := `{"server": {"name": "derp", "networks": [{"uuid": "8a5fe506"}]}}`
+
+	testCases := map[string]string{
+		"different value":  `{"server": {"name": "herp"}}`,
+		"missing field":    `{"server": {"hostname": "derp.example.com"}}`,
+		"different type":   `{"server": {"networks": {"uuid": "8a5fe506"}}}`,
+		"array length":     `{"server": {"networks": [{"uuid": "8a5fe506"}, {"uuid": "1b2c3d4e"}]}}`,
+		"array element":    `{"server": {"networks": [{"uuid": "1b2c3d4e"}]}}`,
+		"invalid expected": `{"server":`,
+	}
+
+	for name, expected := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r, err := http.NewRequest("POST", "http://example.com/servers", strings.NewReader(body))
+			th.AssertNoErr(t, err)
+
+			fake := &recordingT{TB: t}
+			th.TestJSONContains(fake, r, expected)
+			th.AssertEquals(t, true, fake.failed)
+		})
+	}
+}
+
+func TestQueryParamsMismatch(t *testing.T) {
+	testCases := map[string]map[string][]string{
+		"different value": {"name": {"herp"}, "status": {"ACTIVE", "ERROR"}},
+		"missing value":   {"name": {"derp"}, "status": {"ACTIVE"}},
+		"missing key":     {"status": {"ACTIVE", "ERROR"}},
+		"extra key":       {"name": {"derp"}, "status": {"ACTIVE", "ERROR"}, "tags": {"a"}},
+	}
+
+	for name, values := range testCases {
+		t.Run(name, func(t *testing.T) {
+			r, err := http.NewRequest("GET", "http://example.com/servers?name=derp&status=ACTIVE,ERROR", nil)
+			th.AssertNoErr(t, err)
+
+			fake := &recordingT{TB: t}
+			th.TestQueryParams(fake, r, values)
+			th.AssertEquals(t, true, fake.failed)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package testing
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+const credentialBody = `
+{
+    "credential": {
+        "id": "3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510",
+        "blob": "{\"access\":\"181920\",\"secret\":\"secretKey\"}",
+        "type": "ec2",
+        "user_id": "bb5476fd12884539b41d5a88f838d773",
+        "project_id": null,
+        "links": {
+            "self": "http://identity/v3/credentials/3d3367228f9c7665266604462ec60029bcd83ad89614021a80b2eb879c572510"
+        }
+    }
+}
+`
+
+func TestJSONSchema(t *testing.T) {
+	th.AssertJSONSchema(t, credentialBody, "identity/v3/credential.json")
+}
+
+func TestJSONSchemaViolations(t *testing.T) {
+	body := strings.Replace(credentialBody, `"type": "ec2"`, `"type": 1, "extra": true`, 1)
+	body = strings.Replace(body, `"user_id": "bb5476fd12884539b41d5a88f838d773",`, "", 1)
+
+	err := th.ValidateJSONSchema(body, "identity/v3/credential.json")
+	th.AssertErr(t, err)
+
+	for _, violation := range []string{
+		`$.credential: missing required property "user_id"`,
+		`$.credential.extra: additional property is not allowed`,
+		`$.credential.type: expected type string, got number`,
+	} {
+		if !strings.Contains(err.Error(), violation) {
+			t.Errorf("expected violation %q in %q", violation, err)
+		}
+	}
+}
+
+func TestJSONSchemaFromFile(t *testing.T) {
+	schemaPath := filepath.Join(t.TempDir(), "schema.json")
+	err := os.WriteFile(schemaPath, []byte(`{
+		"type": "object",
+		"properties": {
+			"sizes": {
+				"type": "array",
+				"minItems": 1,
+				"items": {"type": "integer", "minimum": 0}
+			},
+			"status": {"enum": ["ACTIVE", "ERROR"]}
+		}
+	}`), 0600)
+	th.AssertNoErr(t, err)
+
+	th.AssertJSONSchema(t, `{"sizes": [1, 2], "status": "ACTIVE"}`, schemaPath)
+
+	err = th.ValidateJSONSchema(`{"sizes": [1.5, -1], "status": "BUILD"}`, schemaPath)
+	th.AssertErr(t, err)
+	for _, violation := range []string{
+		`$.sizes[0]: expected type integer, got number`,
+		`$.sizes[1]: -1 is lower than the minimum 0`,
+		`$.status: value BUILD is not one of [ACTIVE ERROR]`,
+	} {
+		if !strings.Contains(err.Error(), violation) {
+			t.Errorf("expected violation %q in %q", violation, err)
+		}
+	}
+
+	err = th.ValidateJSONSchema(`{"sizes": []}`, schemaPath)
+	th.AssertErr(t, err)
+}
+
+func TestJSONSchemaNotFound(t *testing.T) {
+	err := th.ValidateJSONSchema(`{}`, "does/not/exist.json")
+	th.AssertErr(t, err)
+}
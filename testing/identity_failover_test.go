@@ -0,0 +1,44 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+const (
+	primaryIdentity   = "https://keystone.region-one.example.com:5000/"
+	secondaryIdentity = "https://keystone.region-two.example.com:5000/"
+	tertiaryIdentity  = "https://keystone.region-three.example.com:5000/"
+)
+
+func TestIdentityFailoverOrder(t *testing.T) {
+	f := gophercloud.NewIdentityFailover(primaryIdentity, secondaryIdentity, "https://keystone.region-three.example.com:5000")
+	f.Cooldown = time.Hour
+
+	th.CheckDeepEquals(t, []string{primaryIdentity, secondaryIdentity, tertiaryIdentity}, f.Endpoints())
+	th.CheckEquals(t, "", f.Current())
+
+	f.MarkUnhealthy(primaryIdentity)
+	f.MarkHealthy(secondaryIdentity)
+	th.CheckEquals(t, secondaryIdentity, f.Current())
+	th.CheckDeepEquals(t, []string{secondaryIdentity, tertiaryIdentity, primaryIdentity}, f.Endpoints())
+
+	f.MarkUnhealthy(secondaryIdentity)
+	th.CheckEquals(t, "", f.Current())
+	th.CheckDeepEquals(t, []string{tertiaryIdentity, primaryIdentity, secondaryIdentity}, f.Endpoints())
+}
+
+func TestIdentityFailoverStickiness(t *testing.T) {
+	f := gophercloud.NewIdentityFailover(primaryIdentity, secondaryIdentity)
+	f.Cooldown = time.Millisecond
+
+	f.MarkUnhealthy(primaryIdentity)
+	f.MarkHealthy(secondaryIdentity)
+	time.Sleep(2 * time.Millisecond)
+
+	// the primary endpoint is healthy again, but the current one is kept
+	th.CheckDeepEquals(t, []string{secondaryIdentity, primaryIdentity}, f.Endpoints())
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
@@ -33,3 +35,44 @@ func TestMoreHeaders(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.AssertEquals(t, resp.Request.Header.Get("custom"), "header")
 }
+
+func TestWith(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c := &gophercloud.ServiceClient{
+		ProviderClient: new(gophercloud.ProviderClient),
+		Type:           "compute",
+		Microversion:   "2.1",
+		MoreHeaders: map[string]string{
+			"custom": "header",
+		},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			clone := c.With(map[string]string{"X-Tenant": strconv.Itoa(i)}, "2.79")
+			clone.MoreHeaders["X-Other"] = "value"
+
+			resp, err := clone.Get(context.TODO(), fmt.Sprintf("%s/route", th.Endpoint()), nil, nil)
+			th.AssertNoErr(t, err)
+			th.AssertEquals(t, "header", resp.Request.Header.Get("custom"))
+			th.AssertEquals(t, strconv.Itoa(i), resp.Request.Header.Get("X-Tenant"))
+			th.AssertEquals(t, "2.79", resp.Request.Header.Get("X-OpenStack-Nova-API-Version"))
+		}(i)
+	}
+	wg.Wait()
+
+	th.AssertEquals(t, "2.1", c.Microversion)
+	th.AssertDeepEquals(t, map[string]string{"custom": "header"}, c.MoreHeaders)
+
+	clone := c.With(nil, "")
+	th.AssertEquals(t, "2.1", clone.Microversion)
+	th.AssertEquals(t, c.ProviderClient, clone.ProviderClient)
+}
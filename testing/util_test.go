@@ -173,3 +173,49 @@ func TestRemainingKeys(t *testing.T) {
 		t.Fatalf("expected %s but got %s", expected, actual)
 	}
 }
+
+func TestIDFromName(t *testing.T) {
+	type resource struct {
+		Name string
+		ID   string
+	}
+	all := []resource{
+		{Name: "one", ID: "1"},
+		{Name: "two", ID: "2a"},
+		{Name: "two", ID: "2b"},
+	}
+	fields := func(r resource) (string, string) {
+		return r.Name, r.ID
+	}
+
+	tests := []struct {
+		name     string
+		id       string
+		notFound bool
+		multiple int
+	}{
+		{name: "one", id: "1"},
+		{name: "three", notFound: true},
+		{name: "two", multiple: 2},
+		{name: "", notFound: true},
+	}
+
+	for _, test := range tests {
+		id, err := gophercloud.IDFromName(all, test.name, "resource", fields)
+		switch {
+		case test.notFound:
+			var notFound gophercloud.ErrResourceNotFound
+			th.AssertEquals(t, true, errors.As(err, &notFound))
+			th.AssertEquals(t, test.name, notFound.Name)
+			th.AssertEquals(t, "resource", notFound.ResourceType)
+		case test.multiple > 0:
+			var multiple gophercloud.ErrMultipleResourcesFound
+			th.AssertEquals(t, true, errors.As(err, &multiple))
+			th.AssertEquals(t, test.multiple, multiple.Count)
+			th.AssertEquals(t, "resource", multiple.ResourceType)
+		default:
+			th.AssertNoErr(t, err)
+			th.AssertEquals(t, test.id, id)
+		}
+	}
+}
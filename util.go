@@ -83,6 +83,33 @@ func RemainingKeys(s any, m map[string]any) (extras map[string]any) {
 	return
 }
 
+// IDFromName returns the ID of the only resource in all with the given name.
+// The fields function returns the name and the ID of a resource. If no
+// resource has the name, an ErrResourceNotFound is returned, and if more than
+// one does, an ErrMultipleResourcesFound; both report resourceType.
+//
+// Resource packages use it to implement their IDFromName function.
+func IDFromName[T any](all []T, name, resourceType string, fields func(T) (name, id string)) (string, error) {
+	count := 0
+	id := ""
+
+	for _, resource := range all {
+		if n, i := fields(resource); n == name {
+			count++
+			id = i
+		}
+	}
+
+	switch count {
+	case 0:
+		return "", ErrResourceNotFound{Name: name, ResourceType: resourceType}
+	case 1:
+		return id, nil
+	default:
+		return "", ErrMultipleResourcesFound{Name: name, Count: count, ResourceType: resourceType}
+	}
+}
+
 // WaitFor polls a predicate function, once per second, up to a context cancellation.
 // This is useful to wait for a resource to transition to a certain state.
 // Resource packages will wrap this in a more convenient function that's